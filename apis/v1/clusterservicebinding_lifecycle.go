@@ -0,0 +1,166 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"reconciler.io/runtime/apis"
+)
+
+// These are valid conditions of ClusterServiceBinding.
+const (
+	// ClusterServiceBindingConditionReady means the ClusterServiceBinding is ready in
+	// every selected namespace.
+	ClusterServiceBindingConditionReady = apis.ConditionReady
+	// ClusterServiceBindingConditionNamespacesReady means the binding is ready in every
+	// selected namespace. The per namespace conditions are reported in
+	// .status.namespaces.
+	//
+	// Not a standardized condition.
+	ClusterServiceBindingConditionNamespacesReady = "NamespacesReady"
+)
+
+var clusterservicebindingCondSet = apis.NewLivingConditionSetWithHappyReason(
+	"ServiceBound",
+	ClusterServiceBindingConditionNamespacesReady,
+)
+
+func (s *ClusterServiceBinding) GetConditionsAccessor() apis.ConditionsAccessor {
+	return &s.Status
+}
+
+func (s *ClusterServiceBinding) GetConditionSet() apis.ConditionSet {
+	return clusterservicebindingCondSet
+}
+
+func (s *ClusterServiceBinding) GetConditionManager() apis.ConditionManager {
+	return clusterservicebindingCondSet.Manage(&s.Status)
+}
+
+// ServiceBindingFor returns an in memory ServiceBinding equivalent to this ClusterServiceBinding within the namespace.
+// The status of the ServiceBinding is restored from the namespace's summary.
+func (s *ClusterServiceBinding) ServiceBindingFor(namespace string) *ServiceBinding {
+	serviceBinding := &ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         namespace,
+			Name:              s.Name,
+			UID:               s.UID,
+			Generation:        s.Generation,
+			DeletionTimestamp: s.DeletionTimestamp.DeepCopy(),
+		},
+		Spec: *s.Spec.ServiceBindingSpec.DeepCopy(),
+	}
+	if serviceBinding.Spec.Name == "" {
+		serviceBinding.Spec.Name = s.Name
+	}
+	if ns := s.Status.GetNamespace(namespace); ns != nil {
+		serviceBinding.Status.ObservedGeneration = s.Status.ObservedGeneration
+		serviceBinding.Status.Conditions = ns.Conditions
+		serviceBinding.Status.Binding = ns.Binding
+	}
+	return serviceBinding
+}
+
+func (s *ClusterServiceBindingStatus) InitializeConditions() {
+	conditionManager := clusterservicebindingCondSet.Manage(s)
+	conditionManager.InitializeConditions()
+	// reset existing managed conditions
+	conditionManager.MarkUnknown(ClusterServiceBindingConditionNamespacesReady, "Initializing", "")
+}
+
+// GetNamespace returns a copy of the summary for the namespace, or nil if the namespace is not bound.
+func (s *ClusterServiceBindingStatus) GetNamespace(namespace string) *ClusterServiceBindingNamespaceStatus {
+	for i := range s.Namespaces {
+		if s.Namespaces[i].Namespace == namespace {
+			return s.Namespaces[i].DeepCopy()
+		}
+	}
+	return nil
+}
+
+// MarkNamespaces replaces the namespace summaries and aggregates their Ready conditions into the
+// NamespacesReady condition.
+func (s *ClusterServiceBindingStatus) MarkNamespaces(namespaces []ClusterServiceBindingNamespaceStatus) {
+	s.Namespaces = namespaces
+
+	conditionManager := clusterservicebindingCondSet.Manage(s)
+	var notReady *metav1.Condition
+	notReadyNamespace := ""
+	notReadyCount := 0
+	for i := range namespaces {
+		cond := namespaces[i].GetCondition(ServiceBindingConditionReady)
+		if apis.ConditionIsTrue(cond) {
+			continue
+		}
+		notReadyCount++
+		// report a False condition over an Unknown condition
+		if notReady == nil || (apis.ConditionIsUnknown(notReady) && apis.ConditionIsFalse(cond)) {
+			notReady = cond
+			notReadyNamespace = namespaces[i].Namespace
+		}
+	}
+	if notReadyCount == 0 {
+		conditionManager.MarkTrue(ClusterServiceBindingConditionNamespacesReady, "NamespacesReady", "")
+		return
+	}
+
+	reason, message := "Initializing", "Initializing"
+	if notReady != nil {
+		reason, message = notReady.Reason, notReady.Message
+		if message == "" {
+			message = notReady.Reason
+		}
+	}
+	messageFormat := "%d of %d namespaces are not ready, namespace %q: %s"
+	if apis.ConditionIsFalse(notReady) {
+		conditionManager.MarkFalse(ClusterServiceBindingConditionNamespacesReady, reason, messageFormat, notReadyCount, len(namespaces), notReadyNamespace, message)
+	} else {
+		conditionManager.MarkUnknown(ClusterServiceBindingConditionNamespacesReady, reason, messageFormat, notReadyCount, len(namespaces), notReadyNamespace, message)
+	}
+}
+
+var _ apis.ConditionsAccessor = (*ClusterServiceBindingStatus)(nil)
+
+// GetConditions implements ConditionsAccessor
+func (s *ClusterServiceBindingStatus) GetConditions() []metav1.Condition {
+	return s.Conditions
+}
+
+// SetConditions implements ConditionsAccessor
+func (s *ClusterServiceBindingStatus) SetConditions(c []metav1.Condition) {
+	s.Conditions = c
+}
+
+// GetCondition fetches the condition of the specified type.
+func (s *ClusterServiceBindingStatus) GetCondition(t string) *metav1.Condition {
+	for _, cond := range s.Conditions {
+		if cond.Type == t {
+			return &cond
+		}
+	}
+	return nil
+}
+
+// GetCondition fetches the condition of the specified type.
+func (s *ClusterServiceBindingNamespaceStatus) GetCondition(t string) *metav1.Condition {
+	for _, cond := range s.Conditions {
+		if cond.Type == t {
+			return &cond
+		}
+	}
+	return nil
+}
@@ -0,0 +1,382 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestClusterServiceBindingDefault(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed     *ClusterServiceBinding
+		expected *ClusterServiceBinding
+	}{
+		{
+			name: "default name",
+			seed: &ClusterServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-binding",
+				},
+			},
+			expected: &ClusterServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-binding",
+				},
+				Spec: ClusterServiceBindingSpec{
+					ServiceBindingSpec: ServiceBindingSpec{
+						Name: "my-binding",
+					},
+				},
+			},
+		},
+		{
+			name: "preserve name",
+			seed: &ClusterServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-binding",
+				},
+				Spec: ClusterServiceBindingSpec{
+					ServiceBindingSpec: ServiceBindingSpec{
+						Name: "preserved-name",
+					},
+				},
+			},
+			expected: &ClusterServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-binding",
+				},
+				Spec: ClusterServiceBindingSpec{
+					ServiceBindingSpec: ServiceBindingSpec{
+						Name: "preserved-name",
+					},
+				},
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.seed.DeepCopy()
+			(&ClusterServiceBinding{}).Default(t.Context(), actual)
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("(-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestClusterServiceBindingValidate(t *testing.T) {
+	validSpec := ServiceBindingSpec{
+		Name: "my-binding",
+		Service: ServiceBindingServiceReference{
+			APIVersion: "v1",
+			Kind:       "Secret",
+			Name:       "my-service",
+		},
+		Workload: ServiceBindingWorkloadReference{
+			APIVersion: "apps/v1",
+			Kind:       "Deloyment",
+			Name:       "my-workload",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		seed     *ClusterServiceBinding
+		expected field.ErrorList
+	}{
+		{
+			name: "valid",
+			seed: &ClusterServiceBinding{
+				Spec: ClusterServiceBindingSpec{
+					ServiceBindingSpec: validSpec,
+					NamespaceSelector:  &metav1.LabelSelector{},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "namespace selector required",
+			seed: &ClusterServiceBinding{
+				Spec: ClusterServiceBindingSpec{
+					ServiceBindingSpec: validSpec,
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "namespaceSelector"), ""),
+			},
+		},
+		{
+			name: "invalid namespace selector",
+			seed: &ClusterServiceBinding{
+				Spec: ClusterServiceBindingSpec{
+					ServiceBindingSpec: validSpec,
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{{
+							Key:      "foo",
+							Operator: "NotAnOperator",
+							Values:   []string{"bar"},
+						}},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "namespaceSelector"), &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{
+						Key:      "foo",
+						Operator: "NotAnOperator",
+						Values:   []string{"bar"},
+					}},
+				}, `"NotAnOperator" is not a valid label selector operator`),
+			},
+		},
+		{
+			name: "inlined spec",
+			seed: &ClusterServiceBinding{
+				Spec: ClusterServiceBindingSpec{
+					ServiceBindingSpec: ServiceBindingSpec{
+						Name: "my-binding",
+					},
+					NamespaceSelector: &metav1.LabelSelector{},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "service", "apiVersion"), ""),
+				field.Required(field.NewPath("spec", "service", "kind"), ""),
+				field.Required(field.NewPath("spec", "service", "name"), ""),
+				field.Required(field.NewPath("spec", "workload", "apiVersion"), ""),
+				field.Required(field.NewPath("spec", "workload", "kind"), ""),
+				field.Required(field.NewPath("spec", "workload", "[name, selector]"), "expected exactly one, got neither"),
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			if diff := cmp.Diff(c.expected, c.seed.validate()); diff != "" {
+				t.Errorf("validate (-expected, +actual): %s", diff)
+			}
+
+			expectedErr := c.expected.ToAggregate()
+
+			_, actualCreateErr := (&ClusterServiceBinding{}).ValidateCreate(t.Context(), c.seed.DeepCopy())
+			if diff := cmp.Diff(expectedErr, actualCreateErr); diff != "" {
+				t.Errorf("ValidateCreate (-expected, +actual): %s", diff)
+			}
+
+			_, actualUpdateErr := (&ClusterServiceBinding{}).ValidateUpdate(t.Context(), c.seed.DeepCopy(), c.seed.DeepCopy())
+			if diff := cmp.Diff(expectedErr, actualUpdateErr); diff != "" {
+				t.Errorf("ValidateUpdate (-expected, +actual): %s", diff)
+			}
+
+			_, actualDeleteErr := (&ClusterServiceBinding{}).ValidateDelete(t.Context(), c.seed.DeepCopy())
+			if diff := cmp.Diff(nil, actualDeleteErr); diff != "" {
+				t.Errorf("ValidateDelete (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestClusterServiceBindingValidate_Immutable(t *testing.T) {
+	seed := &ClusterServiceBinding{
+		Spec: ClusterServiceBindingSpec{
+			ServiceBindingSpec: ServiceBindingSpec{
+				Name: "my-binding",
+				Service: ServiceBindingServiceReference{
+					APIVersion: "v1",
+					Kind:       "Secret",
+					Name:       "my-service",
+				},
+				Workload: ServiceBindingWorkloadReference{
+					APIVersion: "apps/v1",
+					Kind:       "Deloyment",
+					Name:       "my-workload",
+				},
+			},
+			NamespaceSelector: &metav1.LabelSelector{},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		seed     *ClusterServiceBinding
+		old      *ClusterServiceBinding
+		expected field.ErrorList
+	}{
+		{
+			name: "allow update namespace selector",
+			seed: seed.DeepCopy(),
+			old: func() *ClusterServiceBinding {
+				old := seed.DeepCopy()
+				old.Spec.NamespaceSelector = &metav1.LabelSelector{
+					MatchLabels: map[string]string{"bind": "true"},
+				}
+				return old
+			}(),
+			expected: field.ErrorList{},
+		},
+		{
+			name: "reject update workload apiVersion",
+			seed: seed.DeepCopy(),
+			old: func() *ClusterServiceBinding {
+				old := seed.DeepCopy()
+				old.Spec.Workload.APIVersion = "extensions/v1beta1"
+				return old
+			}(),
+			expected: field.ErrorList{
+				{
+					Type:     field.ErrorTypeForbidden,
+					Field:    "spec.workload.apiVersion",
+					Detail:   "Workload apiVersion is immutable. Delete and recreate the ClusterServiceBinding to update.",
+					BadValue: "",
+				},
+			},
+		},
+		{
+			name: "reject update workload kind",
+			seed: seed.DeepCopy(),
+			old: func() *ClusterServiceBinding {
+				old := seed.DeepCopy()
+				old.Spec.Workload.Kind = "StatefulSet"
+				return old
+			}(),
+			expected: field.ErrorList{
+				{
+					Type:     field.ErrorTypeForbidden,
+					Field:    "spec.workload.kind",
+					Detail:   "Workload kind is immutable. Delete and recreate the ClusterServiceBinding to update.",
+					BadValue: "",
+				},
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			expectedErr := c.expected.ToAggregate()
+
+			_, actualUpdateErr := (&ClusterServiceBinding{}).ValidateUpdate(t.Context(), c.old, c.seed)
+			if diff := cmp.Diff(expectedErr, actualUpdateErr); diff != "" {
+				t.Errorf("ValidateUpdate (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestClusterServiceBindingServiceBindingFor(t *testing.T) {
+	now := metav1.Now()
+
+	tests := []struct {
+		name      string
+		seed      *ClusterServiceBinding
+		namespace string
+		expected  *ServiceBinding
+	}{
+		{
+			name: "defaults binding name",
+			seed: &ClusterServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "my-binding",
+					UID:        "dde10100-d7b3-4cba-9430-51d60a8612a6",
+					Generation: 2,
+				},
+				Spec: ClusterServiceBindingSpec{
+					NamespaceSelector: &metav1.LabelSelector{},
+				},
+			},
+			namespace: "my-namespace",
+			expected: &ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  "my-namespace",
+					Name:       "my-binding",
+					UID:        "dde10100-d7b3-4cba-9430-51d60a8612a6",
+					Generation: 2,
+				},
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+				},
+			},
+		},
+		{
+			name: "restores namespace status",
+			seed: &ClusterServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "my-binding",
+					Generation:        2,
+					DeletionTimestamp: &now,
+				},
+				Spec: ClusterServiceBindingSpec{
+					ServiceBindingSpec: ServiceBindingSpec{
+						Name: "preserved-name",
+					},
+				},
+				Status: ClusterServiceBindingStatus{
+					ObservedGeneration: 1,
+					Namespaces: []ClusterServiceBindingNamespaceStatus{
+						{
+							Namespace: "other-namespace",
+						},
+						{
+							Namespace: "my-namespace",
+							Conditions: []metav1.Condition{
+								{Type: ServiceBindingConditionReady, Status: metav1.ConditionTrue},
+							},
+							Binding: &ServiceBindingSecretReference{
+								Name: "my-secret",
+							},
+						},
+					},
+				},
+			},
+			namespace: "my-namespace",
+			expected: &ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:         "my-namespace",
+					Name:              "my-binding",
+					Generation:        2,
+					DeletionTimestamp: &now,
+				},
+				Spec: ServiceBindingSpec{
+					Name: "preserved-name",
+				},
+				Status: ServiceBindingStatus{
+					ObservedGeneration: 1,
+					Conditions: []metav1.Condition{
+						{Type: ServiceBindingConditionReady, Status: metav1.ConditionTrue},
+					},
+					Binding: &ServiceBindingSecretReference{
+						Name: "my-secret",
+					},
+				},
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.seed.ServiceBindingFor(c.namespace)
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("(-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterServiceBindingSpec defines the desired state of ClusterServiceBinding
+type ClusterServiceBindingSpec struct {
+	// ServiceBindingSpec is applied in each selected namespace. The service and workloads are resolved within that
+	// namespace.
+	ServiceBindingSpec `json:",inline"`
+	// NamespaceSelector is a query that selects the namespaces to bind the service into
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector"`
+}
+
+// ClusterServiceBindingNamespaceStatus summarizes the binding within a single namespace
+type ClusterServiceBindingNamespaceStatus struct {
+	// Namespace the binding is projected into
+	Namespace string `json:"namespace"`
+
+	// Conditions are the conditions of the binding within the namespace
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Binding exposes the projected secret for the binding within the namespace
+	Binding *ServiceBindingSecretReference `json:"binding,omitempty"`
+}
+
+// ClusterServiceBindingStatus defines the observed state of ClusterServiceBinding
+type ClusterServiceBindingStatus struct {
+	// ObservedGeneration is the 'Generation' of the ClusterServiceBinding that
+	// was last processed by the controller.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions are the conditions of this ClusterServiceBinding
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Namespaces summarizes the binding within each selected namespace
+	// +listType=map
+	// +listMapKey=namespace
+	Namespaces []ClusterServiceBindingNamespaceStatus `json:"namespaces,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ClusterServiceBinding is the Schema for the clusterservicebindings API
+type ClusterServiceBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterServiceBindingSpec   `json:"spec,omitempty"`
+	Status ClusterServiceBindingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterServiceBindingList contains a list of ClusterServiceBinding
+type ClusterServiceBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ClusterServiceBinding `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterServiceBinding{}, &ClusterServiceBindingList{})
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func (r *ClusterServiceBinding) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, r).
+		WithDefaulter(r).
+		WithValidator(r).
+		Complete()
+}
+
+var _ admission.Defaulter[*ClusterServiceBinding] = &ClusterServiceBinding{}
+
+// Default implements webhook.CustomDefaulter so a webhook will be registered for the type
+func (*ClusterServiceBinding) Default(ctx context.Context, obj *ClusterServiceBinding) error {
+	if obj.Spec.Name == "" {
+		obj.Spec.Name = obj.Name
+	}
+
+	return nil
+}
+
+//+kubebuilder:webhook:path=/validate-servicebinding-io-v1-clusterservicebinding,mutating=false,failurePolicy=fail,sideEffects=None,groups=servicebinding.io,resources=clusterservicebindings,verbs=create;update,versions=v1,name=v1.clusterservicebindings.servicebinding.io,admissionReviewVersions={v1,v1beta1}
+
+var _ admission.Validator[*ClusterServiceBinding] = &ClusterServiceBinding{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type
+func (*ClusterServiceBinding) ValidateCreate(ctx context.Context, obj *ClusterServiceBinding) (admission.Warnings, error) {
+	log := logr.FromContextOrDiscard(ctx)
+	log.V(1).Info("Validating Create")
+
+	(&ClusterServiceBinding{}).Default(ctx, obj)
+
+	errs := obj.Spec.validateName(field.NewPath("spec", "name"))
+	errs = append(errs, obj.validate()...)
+
+	return nil, errs.ToAggregate()
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type
+func (*ClusterServiceBinding) ValidateUpdate(ctx context.Context, old, obj *ClusterServiceBinding) (admission.Warnings, error) {
+	log := logr.FromContextOrDiscard(ctx)
+	log.V(1).Info("Validating Update")
+
+	(&ClusterServiceBinding{}).Default(ctx, old)
+	(&ClusterServiceBinding{}).Default(ctx, obj)
+	errs := field.ErrorList{}
+
+	// check immutable fields
+	if obj.Spec.Workload.APIVersion != old.Spec.Workload.APIVersion {
+		errs = append(errs,
+			field.Forbidden(field.NewPath("spec", "workload", "apiVersion"), "Workload apiVersion is immutable. Delete and recreate the ClusterServiceBinding to update."),
+		)
+	}
+	if obj.Spec.Workload.Kind != old.Spec.Workload.Kind {
+		errs = append(errs,
+			field.Forbidden(field.NewPath("spec", "workload", "kind"), "Workload kind is immutable. Delete and recreate the ClusterServiceBinding to update."),
+		)
+	}
+	if obj.Spec.Name != old.Spec.Name {
+		errs = append(errs, obj.Spec.validateName(field.NewPath("spec", "name"))...)
+	}
+
+	// validate new object
+	errs = append(errs, obj.validate()...)
+
+	return nil, errs.ToAggregate()
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type
+func (*ClusterServiceBinding) ValidateDelete(ctx context.Context, obj *ClusterServiceBinding) (admission.Warnings, error) {
+	log := logr.FromContextOrDiscard(ctx)
+	log.V(1).Info("Validating Delete")
+
+	return nil, nil
+}
+
+func (r *ClusterServiceBinding) validate() field.ErrorList {
+	errs := field.ErrorList{}
+
+	errs = append(errs, r.Spec.validate(field.NewPath("spec"))...)
+
+	return errs
+}
+
+func (r *ClusterServiceBindingSpec) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	// the embedded spec is inlined, its fields are siblings of the namespace selector
+	errs = append(errs, r.ServiceBindingSpec.validate(fldPath)...)
+	if r.NamespaceSelector == nil {
+		errs = append(errs, field.Required(fldPath.Child("namespaceSelector"), ""))
+	} else if _, err := metav1.LabelSelectorAsSelector(r.NamespaceSelector); err != nil {
+		errs = append(errs, field.Invalid(fldPath.Child("namespaceSelector"), r.NamespaceSelector, err.Error()))
+	}
+
+	return errs
+}
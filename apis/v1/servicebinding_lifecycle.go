@@ -20,7 +20,10 @@ limitations under the License.
 package v1
 
 import (
+	"sort"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"reconciler.io/runtime/apis"
 )
 
@@ -34,18 +37,50 @@ const (
 	// reference resolved to a ProvisionedService and found a secret. It does not
 	// indicate the condition of the Service.
 	ServiceBindingConditionServiceAvailable = "ServiceAvailable"
+	// ServiceBindingConditionSecretValid means the binding secret exists and
+	// contains the keys the ServiceBinding projects into the workload.
+	//
+	// Not a standardized condition.
+	ServiceBindingConditionSecretValid = "SecretValid"
 	// ServiceBindingConditionWorkloadProjected means the ServiceBinding has projected
 	// the ProvisionedService secret and the Workload is ready to start. It does not
 	// indicate the condition of the Workload resources referenced.
 	//
 	// Not a standardized condition.
 	ServiceBindingConditionWorkloadProjected = "WorkloadProjected"
+	// ServiceBindingConditionWorkloadReady means every workload the ServiceBinding
+	// is projected into has rolled out with the binding. Only reported, and
+	// included in Ready, when .spec.waitForWorkloads is set.
+	//
+	// Not a standardized condition.
+	ServiceBindingConditionWorkloadReady = "WorkloadReady"
+	// ServiceBindingConditionSuspended means the ServiceBinding is suspended and
+	// does not change its workloads. Only reported while .spec.suspend is set.
+	//
+	// Not a standardized condition.
+	ServiceBindingConditionSuspended = "Suspended"
+	// ServiceBindingConditionTypeResolved means the type of the service is known,
+	// from .spec.type or the type entry of the binding secret. Not included in
+	// Ready, a binding secret without a type entry is reported without breaking
+	// bindings created before the entry was checked.
+	//
+	// Not a standardized condition.
+	ServiceBindingConditionTypeResolved = "TypeResolved"
 )
 
 var servicebindingCondSet = apis.NewLivingConditionSetWithHappyReason(
 	"ServiceBound",
 	ServiceBindingConditionServiceAvailable,
+	ServiceBindingConditionSecretValid,
+	ServiceBindingConditionWorkloadProjected,
+)
+
+var servicebindingWaitForWorkloadsCondSet = apis.NewLivingConditionSetWithHappyReason(
+	"ServiceBound",
+	ServiceBindingConditionServiceAvailable,
+	ServiceBindingConditionSecretValid,
 	ServiceBindingConditionWorkloadProjected,
+	ServiceBindingConditionWorkloadReady,
 )
 
 func (s *ServiceBinding) GetConditionsAccessor() apis.ConditionsAccessor {
@@ -53,11 +88,14 @@ func (s *ServiceBinding) GetConditionsAccessor() apis.ConditionsAccessor {
 }
 
 func (s *ServiceBinding) GetConditionSet() apis.ConditionSet {
+	if s.Spec.WaitForWorkloads {
+		return servicebindingWaitForWorkloadsCondSet
+	}
 	return servicebindingCondSet
 }
 
 func (s *ServiceBinding) GetConditionManager() apis.ConditionManager {
-	return servicebindingCondSet.Manage(&s.Status)
+	return s.GetConditionSet().Manage(&s.Status)
 }
 
 func (s *ServiceBindingStatus) InitializeConditions() {
@@ -65,7 +103,53 @@ func (s *ServiceBindingStatus) InitializeConditions() {
 	conditionManager.InitializeConditions()
 	// reset existing managed conditions
 	conditionManager.MarkUnknown(ServiceBindingConditionServiceAvailable, "Initializing", "")
+	conditionManager.MarkUnknown(ServiceBindingConditionSecretValid, "Initializing", "")
 	conditionManager.MarkUnknown(ServiceBindingConditionWorkloadProjected, "Initializing", "")
+	if s.GetCondition(ServiceBindingConditionWorkloadReady) != nil {
+		// the status does not know if the binding waits for its workloads, only reset the condition once reported
+		servicebindingWaitForWorkloadsCondSet.Manage(s).MarkUnknown(ServiceBindingConditionWorkloadReady, "Initializing", "")
+	}
+	// reported again on each reconcile while the binding is suspended
+	_ = conditionManager.ClearCondition(ServiceBindingConditionSuspended)
+	// reported again on each reconcile once the binding secret is read
+	_ = conditionManager.ClearCondition(ServiceBindingConditionTypeResolved)
+}
+
+// SetWorkloads records the result of projecting the binding into each workload and counts the bound workloads. At
+// most limit workloads are recorded, workloads that were not projected are kept before workloads that were. A limit
+// of zero or less records every workload.
+func (s *ServiceBindingStatus) SetWorkloads(workloads []ServiceBindingWorkloadStatus, limit int) {
+	workloads = append([]ServiceBindingWorkloadStatus(nil), workloads...)
+
+	bound := int32(0)
+	for i := range workloads {
+		if workloads[i].Result == ServiceBindingWorkloadProjected {
+			bound++
+		}
+	}
+	sort.SliceStable(workloads, func(i, j int) bool {
+		return workloads[i].Result != ServiceBindingWorkloadProjected && workloads[j].Result == ServiceBindingWorkloadProjected
+	})
+	if limit > 0 && len(workloads) > limit {
+		workloads = workloads[:limit]
+	}
+
+	s.Workloads = workloads
+	s.BoundWorkloads = bound
+}
+
+// GetMaxUnavailable resolves MaxUnavailable to the number of workloads, out of total, that may be rolling out the
+// binding at the same time. At least one workload is always allowed so the rollout makes progress.
+func (r *ServiceBindingRollout) GetMaxUnavailable(total int) int {
+	maxUnavailable := 1
+	if r.MaxUnavailable != nil {
+		// invalid values are rejected by the webhook
+		maxUnavailable, _ = intstr.GetScaledValueFromIntOrPercent(r.MaxUnavailable, total, true)
+	}
+	if maxUnavailable < 1 {
+		maxUnavailable = 1
+	}
+	return maxUnavailable
 }
 
 var _ apis.ConditionsAccessor = (*ServiceBindingStatus)(nil)
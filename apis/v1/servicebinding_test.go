@@ -22,7 +22,9 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 )
 
 func TestServiceBindingDefault(t *testing.T) {
@@ -211,6 +213,102 @@ func TestServiceBindingValidate(t *testing.T) {
 				field.Required(field.NewPath("spec", "workload", "[name, selector]"), "expected exactly one, got both"),
 			},
 		},
+		{
+			name: "workload valid owner",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-service",
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Owner: &ServiceBindingWorkloadOwnerReference{
+							APIVersion: "app.local/v1",
+							Kind:       "MyApp",
+							Name:       "my-app",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "workload valid owner with selector",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-service",
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Selector:   &metav1.LabelSelector{},
+						Owner: &ServiceBindingWorkloadOwnerReference{
+							APIVersion: "app.local/v1",
+							Kind:       "MyApp",
+							Name:       "my-app",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "workload invalid owner",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-service",
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Owner:      &ServiceBindingWorkloadOwnerReference{},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "workload", "owner", "apiVersion"), ""),
+				field.Required(field.NewPath("spec", "workload", "owner", "kind"), ""),
+				field.Required(field.NewPath("spec", "workload", "owner", "name"), ""),
+			},
+		},
+		{
+			name: "workload invalid owner with name",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-service",
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+						Owner: &ServiceBindingWorkloadOwnerReference{
+							APIVersion: "app.local/v1",
+							Kind:       "MyApp",
+							Name:       "my-app",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "workload", "owner"), "owner may not be combined with name"),
+			},
+		},
 		{
 			name: "workload valid env",
 			seed: &ServiceBinding{
@@ -267,6 +365,122 @@ func TestServiceBindingValidate(t *testing.T) {
 				field.Required(field.NewPath("spec", "env[1]", "key"), ""),
 			},
 		},
+		{
+			name: "rollout valid",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-service",
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Selector:   &metav1.LabelSelector{},
+					},
+					Rollout: &ServiceBindingRollout{
+						MaxUnavailable: ptr.To(intstr.FromInt32(2)),
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "rollout valid percentage",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-service",
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Selector:   &metav1.LabelSelector{},
+					},
+					Rollout: &ServiceBindingRollout{
+						MaxUnavailable: ptr.To(intstr.FromString("25%")),
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "rollout invalid",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-service",
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Selector:   &metav1.LabelSelector{},
+					},
+					Rollout: &ServiceBindingRollout{
+						MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "rollout", "maxUnavailable"), int32(0), "must be greater than 0"),
+			},
+		},
+		{
+			name: "rollout invalid percentage",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-service",
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Selector:   &metav1.LabelSelector{},
+					},
+					Rollout: &ServiceBindingRollout{
+						MaxUnavailable: ptr.To(intstr.FromString("150%")),
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "rollout", "maxUnavailable"), "150%", "must be a percentage between 1% and 100%"),
+			},
+		},
+		{
+			name: "rollout not a percentage",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-service",
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Selector:   &metav1.LabelSelector{},
+					},
+					Rollout: &ServiceBindingRollout{
+						MaxUnavailable: ptr.To(intstr.FromString("two")),
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "rollout", "maxUnavailable"), "two", "must be a percentage between 1% and 100%"),
+			},
+		},
 	}
 
 	for _, c := range tests {
@@ -620,3 +834,103 @@ func TestServiceBindingValidate_Immutable(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceBindingStatusSetWorkloads(t *testing.T) {
+	projected := func(name string) ServiceBindingWorkloadStatus {
+		return ServiceBindingWorkloadStatus{APIVersion: "apps/v1", Kind: "Deployment", Name: name, Result: ServiceBindingWorkloadProjected}
+	}
+	failed := func(name string) ServiceBindingWorkloadStatus {
+		return ServiceBindingWorkloadStatus{APIVersion: "apps/v1", Kind: "Deployment", Name: name, Result: ServiceBindingWorkloadFailed, Reason: "WorkloadUpdateFailed"}
+	}
+
+	tests := []struct {
+		name      string
+		workloads []ServiceBindingWorkloadStatus
+		limit     int
+		expected  ServiceBindingStatus
+	}{
+		{
+			name: "empty",
+			expected: ServiceBindingStatus{
+				Workloads: []ServiceBindingWorkloadStatus(nil),
+			},
+		},
+		{
+			name:      "count bound workloads",
+			workloads: []ServiceBindingWorkloadStatus{projected("a"), projected("b")},
+			expected: ServiceBindingStatus{
+				Workloads:      []ServiceBindingWorkloadStatus{projected("a"), projected("b")},
+				BoundWorkloads: 2,
+			},
+		},
+		{
+			name:      "order workloads that were not projected first",
+			workloads: []ServiceBindingWorkloadStatus{projected("a"), failed("b"), projected("c"), failed("d")},
+			expected: ServiceBindingStatus{
+				Workloads:      []ServiceBindingWorkloadStatus{failed("b"), failed("d"), projected("a"), projected("c")},
+				BoundWorkloads: 2,
+			},
+		},
+		{
+			name:      "cap recorded workloads",
+			workloads: []ServiceBindingWorkloadStatus{projected("a"), failed("b"), projected("c")},
+			limit:     2,
+			expected: ServiceBindingStatus{
+				Workloads:      []ServiceBindingWorkloadStatus{failed("b"), projected("a")},
+				BoundWorkloads: 2,
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual := ServiceBindingStatus{}
+			actual.SetWorkloads(c.workloads, c.limit)
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("(-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestServiceBindingRolloutGetMaxUnavailable(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed     *ServiceBindingRollout
+		total    int
+		expected int
+	}{
+		{
+			name:     "default",
+			seed:     &ServiceBindingRollout{},
+			total:    10,
+			expected: 1,
+		},
+		{
+			name:     "number",
+			seed:     &ServiceBindingRollout{MaxUnavailable: ptr.To(intstr.FromInt32(3))},
+			total:    10,
+			expected: 3,
+		},
+		{
+			name:     "percentage rounded up",
+			seed:     &ServiceBindingRollout{MaxUnavailable: ptr.To(intstr.FromString("25%"))},
+			total:    10,
+			expected: 3,
+		},
+		{
+			name:     "at least one",
+			seed:     &ServiceBindingRollout{MaxUnavailable: ptr.To(intstr.FromString("25%"))},
+			total:    0,
+			expected: 1,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			if actual := c.seed.GetMaxUnavailable(c.total); actual != c.expected {
+				t.Errorf("expected %d, got %d", c.expected, actual)
+			}
+		})
+	}
+}
@@ -18,6 +18,9 @@ package v1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // ServiceBindingWorkloadReference defines a subset of corev1.ObjectReference with extensions
@@ -32,10 +35,37 @@ type ServiceBindingWorkloadReference struct {
 	Name string `json:"name,omitempty"`
 	// Selector is a query that selects the workload or workloads to bind the service to
 	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+	// Owner selects the workload or workloads controlled by the referenced object. May be combined with Selector to
+	// further restrict the workloads bound.
+	Owner *ServiceBindingWorkloadOwnerReference `json:"owner,omitempty"`
 	// Containers describes which containers in a Pod should be bound to
 	Containers []string `json:"containers,omitempty"`
 }
 
+// ServiceBindingWorkloadOwnerReference defines a subset of metav1.OwnerReference
+type ServiceBindingWorkloadOwnerReference struct {
+	// API version of the referent. The version is ignored when matching owner references.
+	APIVersion string `json:"apiVersion"`
+	// Kind of the referent.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	Kind string `json:"kind"`
+	// Name of the referent.
+	// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+	Name string `json:"name"`
+}
+
+// Controls returns true when the referent is the controller of the object. Only the controller owner reference is
+// considered, the version of the owner is ignored.
+func (r *ServiceBindingWorkloadOwnerReference) Controls(obj metav1.Object) bool {
+	owner := metav1.GetControllerOfNoCopy(obj)
+	if owner == nil {
+		return false
+	}
+	return owner.Kind == r.Kind &&
+		owner.Name == r.Name &&
+		schema.FromAPIVersionAndKind(owner.APIVersion, owner.Kind).Group == schema.FromAPIVersionAndKind(r.APIVersion, r.Kind).Group
+}
+
 // ServiceBindingServiceReference defines a subset of corev1.ObjectReference
 type ServiceBindingServiceReference struct {
 	// API version of the referent.
@@ -77,6 +107,42 @@ type ServiceBindingSpec struct {
 	Service ServiceBindingServiceReference `json:"service"`
 	// Env is the collection of mappings from Secret entries to environment variables
 	Env []EnvMapping `json:"env,omitempty"`
+	// Rollout controls how a change to the binding is rolled out across the workloads. By default every workload is
+	// updated at once.
+	Rollout *ServiceBindingRollout `json:"rollout,omitempty"`
+	// WaitForWorkloads reports the rollout of the bound workloads with the WorkloadReady condition and includes it in
+	// the Ready condition, the binding is only Ready once every bound workload is running with the binding.
+	WaitForWorkloads bool `json:"waitForWorkloads,omitempty"`
+	// Suspend stops the binding from changing its workloads while the status continues to be reported. Deleting a
+	// suspended binding still removes it from the workloads.
+	Suspend bool `json:"suspend,omitempty"`
+	// DeletionPolicy controls what happens to the workloads when the binding is deleted, one of Unproject or Orphan.
+	// Unproject, the default, removes the binding from the workloads. Orphan leaves the projection in place for a
+	// future binding of the same Secret to adopt.
+	// +kubebuilder:validation:Enum=Unproject;Orphan
+	DeletionPolicy ServiceBindingDeletionPolicy `json:"deletionPolicy,omitempty"`
+	// AdoptManualProjections converts volumes and environment variables of the workloads that reference the binding
+	// Secret directly into the projected binding, rather than projecting the binding alongside them. Adopted volumes
+	// are replaced by the projected volume, adopted environment variables are projected with the binding from then on.
+	AdoptManualProjections bool `json:"adoptManualProjections,omitempty"`
+}
+
+// ServiceBindingDeletionPolicy controls what happens to the workloads when the binding is deleted
+type ServiceBindingDeletionPolicy string
+
+const (
+	// ServiceBindingDeletionPolicyUnproject removes the binding from the workloads
+	ServiceBindingDeletionPolicyUnproject ServiceBindingDeletionPolicy = "Unproject"
+	// ServiceBindingDeletionPolicyOrphan leaves the projection in the workloads
+	ServiceBindingDeletionPolicyOrphan ServiceBindingDeletionPolicy = "Orphan"
+)
+
+// ServiceBindingRollout rolls a change to the binding out to the workloads in batches
+type ServiceBindingRollout struct {
+	// MaxUnavailable is the number, or percentage, of workloads that may be rolling out a change to the binding at
+	// the same time. Further workloads are updated once the updated workloads complete their rollout. Percentages are
+	// rounded up. Defaults to 1.
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
 }
 
 // ServiceBindingStatus defines the observed state of ServiceBinding
@@ -90,12 +156,79 @@ type ServiceBindingStatus struct {
 
 	// Binding exposes the projected secret for this ServiceBinding
 	Binding *ServiceBindingSecretReference `json:"binding,omitempty"`
+
+	// Type is the effective type of the service, .spec.type when set, otherwise the type entry of the binding Secret
+	Type string `json:"type,omitempty"`
+
+	// Provider is the effective provider of the service, .spec.provider when set, otherwise the provider entry of the
+	// binding Secret
+	Provider string `json:"provider,omitempty"`
+
+	// Workloads reports the result of projecting the binding into each resolved workload. The list is capped, workloads
+	// that were not projected are reported before workloads that were.
+	// +listType=atomic
+	Workloads []ServiceBindingWorkloadStatus `json:"workloads,omitempty"`
+
+	// BoundWorkloads is the number of workloads the binding is projected into, including workloads omitted from
+	// Workloads
+	BoundWorkloads int32 `json:"boundWorkloads,omitempty"`
+
+	// Rollout reports the progress of rolling out the binding when a rollout strategy is set
+	Rollout *ServiceBindingRolloutStatus `json:"rollout,omitempty"`
+}
+
+// ServiceBindingRolloutStatus reports the progress of rolling out the binding to the workloads
+type ServiceBindingRolloutStatus struct {
+	// UpdatedWorkloads is the number of workloads the binding is rolled out to
+	UpdatedWorkloads int32 `json:"updatedWorkloads"`
+	// ReadyWorkloads is the number of updated workloads that completed their rollout
+	ReadyWorkloads int32 `json:"readyWorkloads"`
+	// PendingWorkloads is the number of workloads waiting for the binding to be rolled out to them
+	PendingWorkloads int32 `json:"pendingWorkloads"`
+}
+
+// ServiceBindingWorkloadResult is the result of projecting a binding into a workload
+type ServiceBindingWorkloadResult string
+
+const (
+	// ServiceBindingWorkloadProjected means the binding is projected into the workload
+	ServiceBindingWorkloadProjected ServiceBindingWorkloadResult = "Projected"
+	// ServiceBindingWorkloadUnprojected means the binding is removed from the workload
+	ServiceBindingWorkloadUnprojected ServiceBindingWorkloadResult = "Unprojected"
+	// ServiceBindingWorkloadSkipped means the workload was resolved, but not updated
+	ServiceBindingWorkloadSkipped ServiceBindingWorkloadResult = "Skipped"
+	// ServiceBindingWorkloadFailed means the workload could not be updated
+	ServiceBindingWorkloadFailed ServiceBindingWorkloadResult = "Failed"
+	// ServiceBindingWorkloadPending means the workload is waiting for the binding to be rolled out to it
+	ServiceBindingWorkloadPending ServiceBindingWorkloadResult = "Pending"
+)
+
+// ServiceBindingWorkloadStatus reports the projection of the binding into a single workload
+type ServiceBindingWorkloadStatus struct {
+	// API version of the workload.
+	APIVersion string `json:"apiVersion"`
+	// Kind of the workload.
+	Kind string `json:"kind"`
+	// Name of the workload.
+	Name string `json:"name"`
+	// UID of the workload.
+	UID types.UID `json:"uid,omitempty"`
+	// ObservedGeneration is the 'Generation' of the workload the binding was projected into.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Result of projecting the binding into the workload, one of Projected, Unprojected, Skipped, Failed or Pending.
+	// +kubebuilder:validation:Enum=Projected;Unprojected;Skipped;Failed;Pending
+	Result ServiceBindingWorkloadResult `json:"result"`
+	// Reason is a machine readable explanation of the result.
+	Reason string `json:"reason,omitempty"`
+	// Message is a human readable explanation of the result.
+	Message string `json:"message,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Secret",type=string,JSONPath=`.status.binding.name`
+// +kubebuilder:printcolumn:name="Workloads",type=integer,JSONPath=`.status.boundWorkloads`
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
 // +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
@@ -22,6 +22,7 @@ import (
 
 	"github.com/go-logr/logr"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -153,6 +154,9 @@ func (r *ServiceBindingSpec) validate(fldPath *field.Path) field.ErrorList {
 	for i := range r.Env {
 		errs = append(errs, r.Env[i].validate(fldPath.Child("env").Index(i))...)
 	}
+	if r.Rollout != nil {
+		errs = append(errs, r.Rollout.validate(fldPath.Child("rollout"))...)
+	}
 
 	return errs
 }
@@ -182,17 +186,55 @@ func (r *ServiceBindingWorkloadReference) validate(fldPath *field.Path) field.Er
 	if r.Kind == "" {
 		errs = append(errs, field.Required(fldPath.Child("kind"), ""))
 	}
-	if r.Name == "" && r.Selector == nil {
+	if r.Name == "" && r.Selector == nil && r.Owner == nil {
 		errs = append(errs, field.Required(fldPath.Child("[name, selector]"), "expected exactly one, got neither"))
 	}
 	if r.Name != "" && r.Selector != nil {
 		errs = append(errs, field.Required(fldPath.Child("[name, selector]"), "expected exactly one, got both"))
 	}
+	if r.Name != "" && r.Owner != nil {
+		errs = append(errs, field.Forbidden(fldPath.Child("owner"), "owner may not be combined with name"))
+	}
 	if r.Selector != nil {
 		if _, err := metav1.LabelSelectorAsSelector(r.Selector); err != nil {
 			errs = append(errs, field.Invalid(fldPath.Child("selector"), r.Selector, err.Error()))
 		}
 	}
+	if r.Owner != nil {
+		errs = append(errs, r.Owner.validate(fldPath.Child("owner"))...)
+	}
+
+	return errs
+}
+
+func (r *ServiceBindingWorkloadOwnerReference) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.APIVersion == "" {
+		errs = append(errs, field.Required(fldPath.Child("apiVersion"), ""))
+	}
+	if r.Kind == "" {
+		errs = append(errs, field.Required(fldPath.Child("kind"), ""))
+	}
+	if r.Name == "" {
+		errs = append(errs, field.Required(fldPath.Child("name"), ""))
+	}
+
+	return errs
+}
+
+func (r *ServiceBindingRollout) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.MaxUnavailable != nil {
+		if r.MaxUnavailable.Type == intstr.Int {
+			if r.MaxUnavailable.IntVal < 1 {
+				errs = append(errs, field.Invalid(fldPath.Child("maxUnavailable"), r.MaxUnavailable.IntVal, "must be greater than 0"))
+			}
+		} else if percent, err := intstr.GetScaledValueFromIntOrPercent(r.MaxUnavailable, 100, true); err != nil || percent < 1 || percent > 100 {
+			errs = append(errs, field.Invalid(fldPath.Child("maxUnavailable"), r.MaxUnavailable.StrVal, "must be a percentage between 1% and 100%"))
+		}
+	}
 
 	return errs
 }
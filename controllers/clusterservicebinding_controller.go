@@ -0,0 +1,242 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"reconciler.io/runtime/apis"
+	"reconciler.io/runtime/reconcilers"
+	rtime "reconciler.io/runtime/time"
+	"reconciler.io/runtime/tracker"
+	ctlr "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	servicebindingv1 "github.com/servicebinding/runtime/apis/v1"
+	"github.com/servicebinding/runtime/lifecycle"
+)
+
+//+kubebuilder:rbac:groups=servicebinding.io,resources=clusterservicebindings,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=servicebinding.io,resources=clusterservicebindings/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=servicebinding.io,resources=clusterservicebindings/finalizers,verbs=update
+//+kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core;events.k8s.io,resources=events,verbs=get;list;watch;create;update;patch;delete
+
+// ClusterServiceBindingReconciler reconciles a ClusterServiceBinding object
+func ClusterServiceBindingReconciler(c reconcilers.Config, hooks lifecycle.ServiceBindingHooks) *reconcilers.ResourceReconciler[*servicebindingv1.ClusterServiceBinding] {
+	return &reconcilers.ResourceReconciler[*servicebindingv1.ClusterServiceBinding]{
+		Reconciler: &reconcilers.WithFinalizer[*servicebindingv1.ClusterServiceBinding]{
+			Finalizer: servicebindingv1.GroupVersion.Group + "/finalizer",
+			Reconciler: reconcilers.Sequence[*servicebindingv1.ClusterServiceBinding]{
+				ResolveNamespaces(),
+				BindNamespaces(hooks),
+			},
+		},
+
+		Config: c,
+	}
+}
+
+func ResolveNamespaces() reconcilers.SubReconciler[*servicebindingv1.ClusterServiceBinding] {
+	return &reconcilers.SyncReconciler[*servicebindingv1.ClusterServiceBinding]{
+		Name:                   "ResolveNamespaces",
+		SyncDuringFinalization: true,
+		Sync: func(ctx context.Context, resource *servicebindingv1.ClusterServiceBinding) error {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+
+			selector, err := metav1.LabelSelectorAsSelector(resource.Spec.NamespaceSelector)
+			if err != nil {
+				// should never get here
+				return err
+			}
+			namespaces := &corev1.NamespaceList{}
+			if err := c.List(ctx, namespaces, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+				return err
+			}
+
+			names := make([]string, len(namespaces.Items))
+			for i := range namespaces.Items {
+				names[i] = namespaces.Items[i].Name
+			}
+			StashNamespaces(ctx, names)
+
+			return nil
+		},
+
+		Setup: func(ctx context.Context, mgr ctlr.Manager, bldr *builder.Builder) error {
+			// a namespace may start or stop matching any selector when its labels change
+			bldr.Watches(&corev1.Namespace{}, handler.EnqueueRequestsFromMapFunc(
+				func(ctx context.Context, o client.Object) []reconcile.Request {
+					clusterServiceBindings := &servicebindingv1.ClusterServiceBindingList{}
+					if err := mgr.GetClient().List(ctx, clusterServiceBindings); err != nil {
+						return nil
+					}
+					requests := make([]reconcile.Request, len(clusterServiceBindings.Items))
+					for i := range clusterServiceBindings.Items {
+						requests[i] = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&clusterServiceBindings.Items[i])}
+					}
+					return requests
+				},
+			))
+			return nil
+		},
+	}
+}
+
+// BindNamespaces reconciles an in memory ServiceBinding for each selected namespace with the same sub reconcilers used
+// by the ServiceBinding controller. Namespaces that are no longer selected are unbound, and reported until the binding
+// was removed from all of their workloads.
+func BindNamespaces(hooks lifecycle.ServiceBindingHooks) reconcilers.SubReconciler[*servicebindingv1.ClusterServiceBinding] {
+	serviceBindingReconciler := reconcilers.Sequence[*servicebindingv1.ServiceBinding]{
+		ResolveBindingSecret(hooks),
+		ValidateBindingSecret(),
+		ResolveWorkloads(hooks),
+		&reconcilers.IfThen[*servicebindingv1.ServiceBinding]{
+			Name: "IfSuspended",
+			If: func(ctx context.Context, resource *servicebindingv1.ServiceBinding) bool {
+				return isSuspended(resource)
+			},
+			Then: SuspendBinding(hooks),
+			Else: reconcilers.Sequence[*servicebindingv1.ServiceBinding]{
+				ProjectBinding(hooks),
+				RolloutWorkloads(),
+				PatchWorkloads(hooks),
+			},
+		},
+		ReflectWorkloadReadiness(hooks),
+	}
+
+	return &reconcilers.SyncReconciler[*servicebindingv1.ClusterServiceBinding]{
+		Name:                   "BindNamespaces",
+		SyncDuringFinalization: true,
+		SyncWithResult: func(ctx context.Context, resource *servicebindingv1.ClusterServiceBinding) (reconcile.Result, error) {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+
+			selected := sets.New(RetrieveNamespaces(ctx)...)
+			namespaces := selected.Clone()
+			for _, status := range resource.Status.Namespaces {
+				namespaces.Insert(status.Namespace)
+			}
+
+			result := reconcile.Result{}
+			summaries := []servicebindingv1.ClusterServiceBindingNamespaceStatus{}
+			errs := []error{}
+			for _, namespace := range sets.List(namespaces) {
+				serviceBinding := resource.ServiceBindingFor(namespace)
+				if !selected.Has(namespace) && serviceBinding.DeletionTimestamp.IsZero() {
+					// the namespace is no longer selected, remove the binding from its workloads
+					now := metav1.NewTime(rtime.RetrieveNow(ctx))
+					serviceBinding.DeletionTimestamp = &now
+				}
+				if resource.DeletionTimestamp.IsZero() {
+					serviceBinding.Status.InitializeConditions()
+				}
+
+				// each namespace gets a clean stash, references are tracked for the ClusterServiceBinding
+				namespaceCtx := reconcilers.StashConfig(reconcilers.WithStash(ctx), clusterServiceBindingConfig(c, resource))
+				namespaceResult, err := serviceBindingReconciler.Reconcile(namespaceCtx, serviceBinding)
+				if err != nil && !errors.Is(err, reconcilers.ErrHaltSubReconcilers) {
+					errs = append(errs, fmt.Errorf("namespace %q: %w", namespace, err))
+				}
+				result = reconcilers.AggregateResults(result, namespaceResult)
+
+				if !selected.Has(namespace) {
+					failure := unbindFailure(serviceBinding, err)
+					if failure == "" {
+						// the binding is removed from the namespace's workloads, forget the namespace
+						continue
+					}
+					// keep the namespace until the binding is removed from its workloads, the projections would
+					// otherwise leak
+					serviceBinding.GetConditionManager().MarkFalse(servicebindingv1.ServiceBindingConditionWorkloadProjected, "NamespaceNotSelected", "the namespace is no longer selected, failed to remove the binding from its workloads: %s", failure)
+				}
+				summaries = append(summaries, servicebindingv1.ClusterServiceBindingNamespaceStatus{
+					Namespace:  namespace,
+					Conditions: serviceBinding.Status.Conditions,
+					Binding:    serviceBinding.Status.Binding,
+				})
+			}
+
+			if resource.DeletionTimestamp.IsZero() {
+				resource.Status.MarkNamespaces(summaries)
+			}
+
+			return result, errors.Join(errs...)
+		},
+
+		Setup: func(ctx context.Context, mgr ctlr.Manager, bldr *builder.Builder) error {
+			return serviceBindingReconciler.SetupWithManager(ctx, mgr, bldr)
+		},
+	}
+}
+
+// unbindFailure describes why the binding may still be projected into the workloads of a namespace that is no longer
+// selected. An empty string means the binding was removed from every workload.
+func unbindFailure(serviceBinding *servicebindingv1.ServiceBinding, err error) string {
+	if err != nil {
+		return err.Error()
+	}
+	if cond := serviceBinding.Status.GetCondition(servicebindingv1.ServiceBindingConditionWorkloadProjected); apis.ConditionIsFalse(cond) {
+		return cond.Message
+	}
+	return ""
+}
+
+// clusterServiceBindingConfig returns a copy of the config whose tracker attributes tracked references to the
+// ClusterServiceBinding instead of the in memory ServiceBinding.
+func clusterServiceBindingConfig(c reconcilers.Config, resource *servicebindingv1.ClusterServiceBinding) reconcilers.Config {
+	c.Tracker = &clusterServiceBindingTracker{
+		Tracker:               c.Tracker,
+		clusterServiceBinding: resource,
+	}
+	return c
+}
+
+type clusterServiceBindingTracker struct {
+	tracker.Tracker
+	clusterServiceBinding client.Object
+}
+
+func (t *clusterServiceBindingTracker) TrackReference(ref tracker.Reference, _ client.Object) error {
+	return t.Tracker.TrackReference(ref, t.clusterServiceBinding)
+}
+
+func (t *clusterServiceBindingTracker) TrackObject(ref client.Object, _ client.Object) error {
+	return t.Tracker.TrackObject(ref, t.clusterServiceBinding)
+}
+
+const NamespacesStashKey reconcilers.StashKey = "servicebinding.io:namespaces"
+
+func StashNamespaces(ctx context.Context, namespaces []string) {
+	reconcilers.StashValue(ctx, NamespacesStashKey, namespaces)
+}
+
+func RetrieveNamespaces(ctx context.Context) []string {
+	value := reconcilers.RetrieveValue(ctx, NamespacesStashKey)
+	if namespaces, ok := value.([]string); ok {
+		return namespaces
+	}
+	return nil
+}
@@ -0,0 +1,486 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	dieappsv1 "reconciler.io/dies/apis/apps/v1"
+	diecorev1 "reconciler.io/dies/apis/core/v1"
+	diemetav1 "reconciler.io/dies/apis/meta/v1"
+	"reconciler.io/runtime/reconcilers"
+	rtesting "reconciler.io/runtime/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	servicebindingv1 "github.com/servicebinding/runtime/apis/v1"
+	"github.com/servicebinding/runtime/controllers"
+	dieservicebindingv1 "github.com/servicebinding/runtime/dies/v1"
+	"github.com/servicebinding/runtime/lifecycle"
+	"github.com/servicebinding/runtime/projector"
+)
+
+func TestClusterServiceBindingReconciler(t *testing.T) {
+	namespace := "test-namespace"
+	name := "my-binding"
+	uid := types.UID("0c4b4a3c-6a4f-4e8e-bb2c-9d4f6a0c0b51")
+	secretName := "my-secret"
+	request := reconcilers.Request{NamespacedName: types.NamespacedName{Name: name}}
+
+	podSpecableMapping := `{"versions":[{"version":"*","annotations":".spec.template.metadata.annotations","containers":[{"path":".spec.template.spec.initContainers[*]","name":".name","env":".env","volumeMounts":".volumeMounts"},{"path":".spec.template.spec.containers[*]","name":".name","env":".env","volumeMounts":".volumeMounts"}],"volumes":".spec.template.spec.volumes"}]}`
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1.AddToScheme(scheme))
+
+	now := metav1.Now().Rfc3339Copy()
+
+	selectedNamespace := diecorev1.NamespaceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(namespace)
+			d.AddLabel("bind", "true")
+		})
+	unselectedNamespace := selectedNamespace.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Labels(nil)
+		})
+
+	clusterServiceBinding := dieservicebindingv1.ClusterServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(name)
+			d.UID(uid)
+		}).
+		SpecDie(func(d *dieservicebindingv1.ClusterServiceBindingSpecDie) {
+			d.ServiceBindingSpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+				d.ServiceDie(func(d *dieservicebindingv1.ServiceBindingServiceReferenceDie) {
+					d.APIVersion("v1")
+					d.Kind("Secret")
+					d.Name(secretName)
+				})
+				d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+					d.APIVersion("apps/v1")
+					d.Kind("Deployment")
+					d.Name("my-workload")
+				})
+			})
+			d.NamespaceSelectorDie(func(d *diemetav1.LabelSelectorDie) {
+				d.AddMatchLabel("bind", "true")
+			})
+		})
+	// events for a namespace are recorded against the in memory service binding
+	serviceBinding := dieservicebindingv1.ServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(name)
+			d.UID(uid)
+		})
+
+	secret := diecorev1.SecretBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(secretName)
+		}).
+		Data(map[string][]byte{
+			"type": []byte("mysql"),
+		})
+
+	workloadMapping := dieservicebindingv1.ClusterWorkloadResourceMappingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("deployments.apps")
+		})
+
+	workload := dieappsv1.DeploymentBlank.
+		APIVersion("apps/v1").
+		Kind("Deployment").
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name("my-workload")
+			d.UID(uuid.NewUUID())
+		}).
+		SpecDie(func(d *dieappsv1.DeploymentSpecDie) {
+			d.TemplateDie(func(d *diecorev1.PodTemplateSpecDie) {
+				d.SpecDie(func(d *diecorev1.PodSpecDie) {
+					d.ContainerDie("my-container", func(d *diecorev1.ContainerDie) {
+						d.Image("scratch")
+					})
+				})
+			})
+		})
+	projectedWorkload := workload.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.AddAnnotation(fmt.Sprintf("projector.servicebinding.io/mapping-%s", uid), podSpecableMapping)
+		}).
+		SpecDie(func(d *dieappsv1.DeploymentSpecDie) {
+			d.TemplateDie(func(d *diecorev1.PodTemplateSpecDie) {
+				d.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.AddAnnotation(fmt.Sprintf("projector.servicebinding.io/secret-%s", uid), secretName)
+				})
+				d.SpecDie(func(d *diecorev1.PodSpecDie) {
+					d.ContainerDie("my-container", func(d *diecorev1.ContainerDie) {
+						d.EnvDie("SERVICE_BINDING_ROOT", func(d *diecorev1.EnvVarDie) {
+							d.Value("/bindings")
+						})
+						d.VolumeMountDie(fmt.Sprintf("servicebinding-%s", uid), func(d *diecorev1.VolumeMountDie) {
+							d.MountPath(fmt.Sprintf("/bindings/%s", name))
+							d.ReadOnly(true)
+						})
+					})
+					d.VolumeDie(fmt.Sprintf("servicebinding-%s", uid), func(d *diecorev1.VolumeDie) {
+						d.ProjectedDie(func(d *diecorev1.ProjectedVolumeSourceDie) {
+							d.DefaultMode(ptr.To(projector.VolumeDefaultMode))
+							d.SourcesDie(
+								diecorev1.VolumeProjectionBlank.
+									SecretDie(func(d *diecorev1.SecretProjectionDie) {
+										d.Name(secretName)
+									}),
+							)
+						})
+					})
+				})
+			})
+		})
+	// TODO find a better way to avoid empty vs nil objects that are lost in the unstructured conversion
+	unprojectedWorkload := workload.
+		SpecDie(func(d *dieappsv1.DeploymentSpecDie) {
+			d.TemplateDie(func(d *diecorev1.PodTemplateSpecDie) {
+				d.SpecDie(func(d *diecorev1.PodSpecDie) {
+					d.ContainerDie("my-container", func(d *diecorev1.ContainerDie) {
+						d.EnvDie("SERVICE_BINDING_ROOT", func(d *diecorev1.EnvVarDie) {
+							d.Value("/bindings")
+						})
+					})
+				})
+			})
+		}).DieReleaseUnstructured()
+	unstructured.SetNestedMap(unprojectedWorkload.UnstructuredContent(), map[string]interface{}{}, "metadata", "annotations")
+	unstructured.SetNestedMap(unprojectedWorkload.UnstructuredContent(), map[string]interface{}{}, "spec", "template", "metadata", "annotations")
+	containers, _, _ := unstructured.NestedSlice(unprojectedWorkload.UnstructuredContent(), "spec", "template", "spec", "containers")
+	unstructured.SetNestedSlice(containers[0].(map[string]interface{}), []interface{}{}, "volumeMounts")
+	unstructured.SetNestedSlice(unprojectedWorkload.UnstructuredContent(), containers, "spec", "template", "spec", "containers")
+	unstructured.SetNestedSlice(unprojectedWorkload.UnstructuredContent(), []interface{}{}, "spec", "template", "spec", "volumes")
+
+	boundNamespace := func(d *dieservicebindingv1.ClusterServiceBindingNamespaceStatusDie) {
+		d.ConditionsDie(
+			dieservicebindingv1.ServiceBindingConditionReady.True().Reason("ServiceBound"),
+			dieservicebindingv1.ServiceBindingConditionSecretValid.True().Reason("SecretValid"),
+			dieservicebindingv1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+			dieservicebindingv1.ServiceBindingConditionTypeResolved.True().Reason("TypeResolved"),
+			dieservicebindingv1.ServiceBindingConditionWorkloadProjected.True().Reason("WorkloadProjected"),
+		)
+		d.BindingDie(func(d *dieservicebindingv1.ServiceBindingSecretReferenceDie) {
+			d.Name(secretName)
+		})
+	}
+
+	rts := rtesting.ReconcilerTests{
+		"in sync": {
+			Request: request,
+			StatusSubResourceTypes: []client.Object{
+				&servicebindingv1.ClusterServiceBinding{},
+			},
+			GivenObjects: []client.Object{
+				clusterServiceBinding.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Finalizers("servicebinding.io/finalizer")
+					}).
+					StatusDie(func(d *dieservicebindingv1.ClusterServiceBindingStatusDie) {
+						d.ConditionsDie(
+							dieservicebindingv1.ClusterServiceBindingConditionReady.True().Reason("ServiceBound"),
+							dieservicebindingv1.ClusterServiceBindingConditionNamespacesReady.True().Reason("NamespacesReady"),
+						)
+						d.NamespaceDie(namespace, boundNamespace)
+					}),
+				selectedNamespace,
+				secret,
+				projectedWorkload,
+			},
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, clusterServiceBinding, scheme),
+				rtesting.NewTrackRequest(projectedWorkload, clusterServiceBinding, scheme),
+				rtesting.NewTrackRequest(workloadMapping, clusterServiceBinding, scheme),
+			},
+		},
+		"newly created, resolves secret in selected namespace": {
+			Request: request,
+			StatusSubResourceTypes: []client.Object{
+				&servicebindingv1.ClusterServiceBinding{},
+			},
+			GivenObjects: []client.Object{
+				clusterServiceBinding,
+				selectedNamespace,
+				workload,
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(clusterServiceBinding, scheme, corev1.EventTypeNormal, "FinalizerPatched", "Patched finalizer %q", "servicebinding.io/finalizer"),
+				rtesting.NewEvent(clusterServiceBinding, scheme, corev1.EventTypeNormal, "StatusUpdated", "Updated status"),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "servicebinding.io",
+					Kind:      "ClusterServiceBinding",
+					Name:      clusterServiceBinding.GetName(),
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"metadata":{"finalizers":["servicebinding.io/finalizer"],"resourceVersion":"999"}}`),
+				},
+			},
+			ExpectStatusUpdates: []client.Object{
+				clusterServiceBinding.
+					StatusDie(func(d *dieservicebindingv1.ClusterServiceBindingStatusDie) {
+						d.ConditionsDie(
+							dieservicebindingv1.ClusterServiceBindingConditionReady.Unknown().Reason("Initializing").
+								Message(`1 of 1 namespaces are not ready, namespace "test-namespace": Initializing`),
+							dieservicebindingv1.ClusterServiceBindingConditionNamespacesReady.Unknown().Reason("Initializing").
+								Message(`1 of 1 namespaces are not ready, namespace "test-namespace": Initializing`),
+						)
+						d.NamespaceDie(namespace, func(d *dieservicebindingv1.ClusterServiceBindingNamespaceStatusDie) {
+							d.ConditionsDie(
+								dieservicebindingv1.ServiceBindingConditionReady.Unknown().Reason("Initializing"),
+								dieservicebindingv1.ServiceBindingConditionSecretValid.Unknown().Reason("Initializing"),
+								dieservicebindingv1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+								dieservicebindingv1.ServiceBindingConditionWorkloadProjected.Unknown().Reason("Initializing"),
+							)
+							d.BindingDie(func(d *dieservicebindingv1.ServiceBindingSecretReferenceDie) {
+								d.Name(secretName)
+							})
+						})
+					}),
+			},
+		},
+		"has resolved secret, project into workload in selected namespace": {
+			Request: request,
+			StatusSubResourceTypes: []client.Object{
+				&servicebindingv1.ClusterServiceBinding{},
+			},
+			GivenObjects: []client.Object{
+				clusterServiceBinding.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Finalizers("servicebinding.io/finalizer")
+					}).
+					StatusDie(func(d *dieservicebindingv1.ClusterServiceBindingStatusDie) {
+						d.NamespaceDie(namespace, func(d *dieservicebindingv1.ClusterServiceBindingNamespaceStatusDie) {
+							d.BindingDie(func(d *dieservicebindingv1.ServiceBindingSecretReferenceDie) {
+								d.Name(secretName)
+							})
+						})
+					}),
+				selectedNamespace,
+				secret,
+				workload,
+			},
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, clusterServiceBinding, scheme),
+				rtesting.NewTrackRequest(projectedWorkload, clusterServiceBinding, scheme),
+				rtesting.NewTrackRequest(workloadMapping, clusterServiceBinding, scheme),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(serviceBinding, scheme, corev1.EventTypeNormal, "Updated", "Updated Deployment %q", "my-workload"),
+				rtesting.NewEvent(clusterServiceBinding, scheme, corev1.EventTypeNormal, "StatusUpdated", "Updated status"),
+			},
+			ExpectUpdates: []client.Object{
+				projectedWorkload.DieReleaseUnstructured(),
+			},
+			ExpectStatusUpdates: []client.Object{
+				clusterServiceBinding.
+					StatusDie(func(d *dieservicebindingv1.ClusterServiceBindingStatusDie) {
+						d.ConditionsDie(
+							dieservicebindingv1.ClusterServiceBindingConditionReady.True().Reason("ServiceBound"),
+							dieservicebindingv1.ClusterServiceBindingConditionNamespacesReady.True().Reason("NamespacesReady"),
+						)
+						d.NamespaceDie(namespace, boundNamespace)
+					}),
+			},
+		},
+		"unbind namespace that is no longer selected": {
+			Request: request,
+			StatusSubResourceTypes: []client.Object{
+				&servicebindingv1.ClusterServiceBinding{},
+			},
+			GivenObjects: []client.Object{
+				clusterServiceBinding.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Finalizers("servicebinding.io/finalizer")
+					}).
+					StatusDie(func(d *dieservicebindingv1.ClusterServiceBindingStatusDie) {
+						d.ConditionsDie(
+							dieservicebindingv1.ClusterServiceBindingConditionReady.True().Reason("ServiceBound"),
+							dieservicebindingv1.ClusterServiceBindingConditionNamespacesReady.True().Reason("NamespacesReady"),
+						)
+						d.NamespaceDie(namespace, boundNamespace)
+					}),
+				unselectedNamespace,
+				projectedWorkload,
+			},
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(projectedWorkload, clusterServiceBinding, scheme),
+				rtesting.NewTrackRequest(workloadMapping, clusterServiceBinding, scheme),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(serviceBinding, scheme, corev1.EventTypeNormal, "Updated", "Updated Deployment %q", "my-workload"),
+				rtesting.NewEvent(clusterServiceBinding, scheme, corev1.EventTypeNormal, "StatusUpdated", "Updated status"),
+			},
+			ExpectUpdates: []client.Object{
+				unprojectedWorkload,
+			},
+			ExpectStatusUpdates: []client.Object{
+				clusterServiceBinding.
+					StatusDie(func(d *dieservicebindingv1.ClusterServiceBindingStatusDie) {
+						d.ConditionsDie(
+							dieservicebindingv1.ClusterServiceBindingConditionReady.True().Reason("ServiceBound"),
+							dieservicebindingv1.ClusterServiceBindingConditionNamespacesReady.True().Reason("NamespacesReady"),
+						)
+					}),
+			},
+		},
+		"keep namespace that is no longer selected until unbound": {
+			Request: request,
+			StatusSubResourceTypes: []client.Object{
+				&servicebindingv1.ClusterServiceBinding{},
+			},
+			GivenObjects: []client.Object{
+				clusterServiceBinding.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Finalizers("servicebinding.io/finalizer")
+					}).
+					StatusDie(func(d *dieservicebindingv1.ClusterServiceBindingStatusDie) {
+						d.ConditionsDie(
+							dieservicebindingv1.ClusterServiceBindingConditionReady.True().Reason("ServiceBound"),
+							dieservicebindingv1.ClusterServiceBindingConditionNamespacesReady.True().Reason("NamespacesReady"),
+						)
+						d.NamespaceDie(namespace, boundNamespace)
+					}),
+				unselectedNamespace,
+				projectedWorkload,
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("update", "Deployment", rtesting.InduceFailureOpts{
+					Error: apierrs.NewBadRequest("admission webhook denied the request"),
+				}),
+			},
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(projectedWorkload, clusterServiceBinding, scheme),
+				rtesting.NewTrackRequest(workloadMapping, clusterServiceBinding, scheme),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(serviceBinding, scheme, corev1.EventTypeWarning, "UpdateFailed", "Failed to update Deployment %q: admission webhook denied the request", "my-workload"),
+				rtesting.NewEvent(clusterServiceBinding, scheme, corev1.EventTypeNormal, "StatusUpdated", "Updated status"),
+			},
+			ExpectUpdates: []client.Object{
+				unprojectedWorkload,
+			},
+			ExpectStatusUpdates: []client.Object{
+				clusterServiceBinding.
+					StatusDie(func(d *dieservicebindingv1.ClusterServiceBindingStatusDie) {
+						d.ConditionsDie(
+							dieservicebindingv1.ClusterServiceBindingConditionReady.False().Reason("NamespaceNotSelected").
+								Message(`1 of 1 namespaces are not ready, namespace "test-namespace": the namespace is no longer selected, failed to remove the binding from its workloads: failed to update 1 of 1 workloads, see .status.workloads for details`),
+							dieservicebindingv1.ClusterServiceBindingConditionNamespacesReady.False().Reason("NamespaceNotSelected").
+								Message(`1 of 1 namespaces are not ready, namespace "test-namespace": the namespace is no longer selected, failed to remove the binding from its workloads: failed to update 1 of 1 workloads, see .status.workloads for details`),
+						)
+						d.NamespaceDie(namespace, func(d *dieservicebindingv1.ClusterServiceBindingNamespaceStatusDie) {
+							d.ConditionsDie(
+								dieservicebindingv1.ServiceBindingConditionReady.False().Reason("NamespaceNotSelected").
+									Message("the namespace is no longer selected, failed to remove the binding from its workloads: failed to update 1 of 1 workloads, see .status.workloads for details"),
+								dieservicebindingv1.ServiceBindingConditionSecretValid.Unknown().Reason("Initializing"),
+								dieservicebindingv1.ServiceBindingConditionServiceAvailable.Unknown().Reason("Initializing"),
+								dieservicebindingv1.ServiceBindingConditionWorkloadProjected.False().Reason("NamespaceNotSelected").
+									Message("the namespace is no longer selected, failed to remove the binding from its workloads: failed to update 1 of 1 workloads, see .status.workloads for details"),
+							)
+							d.BindingDie(func(d *dieservicebindingv1.ServiceBindingSecretReferenceDie) {
+								d.Name(secretName)
+							})
+						})
+					}),
+			},
+			ExpectedResult: reconcile.Result{RequeueAfter: time.Second},
+		},
+		"terminating": {
+			Request: request,
+			StatusSubResourceTypes: []client.Object{
+				&servicebindingv1.ClusterServiceBinding{},
+			},
+			GivenObjects: []client.Object{
+				clusterServiceBinding.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.DeletionTimestamp(&now)
+						d.Finalizers("servicebinding.io/finalizer")
+					}).
+					StatusDie(func(d *dieservicebindingv1.ClusterServiceBindingStatusDie) {
+						d.NamespaceDie(namespace, boundNamespace)
+					}),
+				selectedNamespace,
+				projectedWorkload,
+			},
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(projectedWorkload, clusterServiceBinding, scheme),
+				rtesting.NewTrackRequest(workloadMapping, clusterServiceBinding, scheme),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(serviceBinding, scheme, corev1.EventTypeNormal, "Updated", "Updated Deployment %q", "my-workload"),
+				rtesting.NewEvent(clusterServiceBinding, scheme, corev1.EventTypeNormal, "FinalizerPatched", "Patched finalizer %q", "servicebinding.io/finalizer"),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "servicebinding.io",
+					Kind:      "ClusterServiceBinding",
+					Name:      clusterServiceBinding.GetName(),
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"metadata":{"finalizers":null,"resourceVersion":"999"}}`),
+				},
+			},
+			ExpectUpdates: []client.Object{
+				unprojectedWorkload,
+			},
+		},
+		"error listing namespaces": {
+			Request: request,
+			StatusSubResourceTypes: []client.Object{
+				&servicebindingv1.ClusterServiceBinding{},
+			},
+			GivenObjects: []client.Object{
+				clusterServiceBinding.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Finalizers("servicebinding.io/finalizer")
+					}),
+				selectedNamespace,
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("list", "NamespaceList"),
+			},
+			ShouldErr: true,
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, tc *rtesting.ReconcilerTestCase, c reconcilers.Config) reconcile.Reconciler {
+		restMapper := c.RESTMapper().(*meta.DefaultRESTMapper)
+		restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+		return controllers.ClusterServiceBindingReconciler(c, lifecycle.ServiceBindingHooks{})
+	})
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"reconciler.io/runtime/reconcilers"
+	rtime "reconciler.io/runtime/time"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	servicebindingv1 "github.com/servicebinding/runtime/apis/v1"
+	"github.com/servicebinding/runtime/projector"
+)
+
+// ReadinessGateConditionType is the readiness gate of bound pods. The condition is True when every binding projected
+// into the pod is bound and still binds the Secret the pod references.
+const ReadinessGateConditionType corev1.PodConditionType = "servicebinding.io/bound"
+
+// projectedSecrets returns the Secret the pod references for each binding projected into it, from the annotations the
+// projector adds to the pod template
+func projectedSecrets(annotations map[string]string) map[types.UID]string {
+	secrets := map[types.UID]string{}
+	for key, value := range annotations {
+		if uid, ok := strings.CutPrefix(key, projector.SecretAnnotationPrefix); ok {
+			secrets[types.UID(uid)] = value
+		}
+	}
+	return secrets
+}
+
+// addReadinessGate adds the readiness gate to a pod that bindings are projected into. The readiness gates of a pod are
+// immutable, so the gate is added as the pod is created.
+func addReadinessGate(pod *unstructured.Unstructured) {
+	if len(projectedSecrets(pod.GetAnnotations())) == 0 {
+		return
+	}
+
+	gates, _, _ := unstructured.NestedSlice(pod.Object, "spec", "readinessGates")
+	for _, gate := range gates {
+		if g, ok := gate.(map[string]interface{}); ok && g["conditionType"] == string(ReadinessGateConditionType) {
+			return
+		}
+	}
+	gates = append(gates, map[string]interface{}{"conditionType": string(ReadinessGateConditionType)})
+	_ = unstructured.SetNestedSlice(pod.Object, gates, "spec", "readinessGates")
+	labelGatedPod(pod)
+}
+
+func hasReadinessGate(pod *corev1.Pod) bool {
+	return slices.ContainsFunc(pod.Spec.ReadinessGates, func(gate corev1.PodReadinessGate) bool {
+		return gate.ConditionType == ReadinessGateConditionType
+	})
+}
+
+//+kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=core,resources=pods/status,verbs=get;update;patch
+
+// ReadinessGateReconciler reflects the health of the bindings projected into pods on the readiness gate of the pod. Only
+// pods labeled with GatedPodLabelKey are expected to be cached.
+func ReadinessGateReconciler(c reconcilers.Config) *reconcilers.ResourceReconciler[*corev1.Pod] {
+	return &reconcilers.ResourceReconciler[*corev1.Pod]{
+		Name:       "ReadinessGate",
+		Type:       &corev1.Pod{},
+		Reconciler: ReflectBindingHealth(),
+		// only the readiness gate condition is updated, the rest of the pod's status is owned by the kubelet
+		SkipStatusUpdate: true,
+
+		Setup: func(ctx context.Context, mgr controllerruntime.Manager, bldr *builder.Builder) error {
+			// a binding that is no longer bound or binds a rotated Secret changes the health of its pods
+			bldr.Watches(&servicebindingv1.ServiceBinding{}, handler.EnqueueRequestsFromMapFunc(
+				func(ctx context.Context, o client.Object) []reconcile.Request {
+					return gatedPodRequests(ctx, mgr.GetClient(), hasReadinessGate, client.InNamespace(o.GetNamespace()))
+				},
+			))
+			bldr.Watches(&servicebindingv1.ClusterServiceBinding{}, handler.EnqueueRequestsFromMapFunc(
+				func(ctx context.Context, o client.Object) []reconcile.Request {
+					return gatedPodRequests(ctx, mgr.GetClient(), hasReadinessGate)
+				},
+			))
+			return nil
+		},
+		Config: c,
+	}
+}
+
+// ReflectBindingHealth sets the readiness gate condition of the pod. The condition is False when a binding projected
+// into the pod is not bound, or binds a Secret other than the one the pod references, for example after the service
+// rotated its credentials. The Ready condition is not used, with .spec.waitForWorkloads it waits for the pods to become
+// ready. Deleted bindings no longer count towards the gate, as the pods of orphaned workloads keep referencing their
+// Secret.
+func ReflectBindingHealth() reconcilers.SubReconciler[*corev1.Pod] {
+	return &reconcilers.SyncReconciler[*corev1.Pod]{
+		Name: "ReflectBindingHealth",
+		Sync: func(ctx context.Context, resource *corev1.Pod) error {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+
+			if !hasReadinessGate(resource) {
+				return nil
+			}
+
+			bindings, err := namespaceServiceBindings(ctx, c, resource.Namespace)
+			if err != nil {
+				return err
+			}
+			secrets := projectedSecrets(resource.Annotations)
+			uids := make([]types.UID, 0, len(secrets))
+			for uid := range secrets {
+				uids = append(uids, uid)
+			}
+			sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+
+			desired := corev1.PodCondition{
+				Type:   ReadinessGateConditionType,
+				Status: corev1.ConditionTrue,
+				Reason: "Bound",
+			}
+			for _, uid := range uids {
+				sb, ok := bindings[uid]
+				switch {
+				case !ok || !sb.DeletionTimestamp.IsZero():
+					continue
+				case !isBound(sb):
+					desired.Status, desired.Reason = corev1.ConditionFalse, "BindingNotBound"
+					desired.Message = fmt.Sprintf("ServiceBinding %q is not bound", sb.Name)
+				case sb.Status.Binding == nil || sb.Status.Binding.Name != secrets[uid]:
+					desired.Status, desired.Reason = corev1.ConditionFalse, "SecretChanged"
+					desired.Message = fmt.Sprintf("ServiceBinding %q no longer binds Secret %q referenced by the pod", sb.Name, secrets[uid])
+				}
+				if desired.Status == corev1.ConditionFalse {
+					break
+				}
+			}
+
+			i := slices.IndexFunc(resource.Status.Conditions, func(cond corev1.PodCondition) bool {
+				return cond.Type == ReadinessGateConditionType
+			})
+			if i >= 0 {
+				current := resource.Status.Conditions[i]
+				if current.Status == desired.Status && current.Reason == desired.Reason && current.Message == desired.Message {
+					return nil
+				}
+			}
+
+			desired.LastTransitionTime = metav1.NewTime(rtime.RetrieveNow(ctx))
+			pod := resource.DeepCopy()
+			if i >= 0 {
+				if pod.Status.Conditions[i].Status == desired.Status {
+					desired.LastTransitionTime = pod.Status.Conditions[i].LastTransitionTime
+				}
+				pod.Status.Conditions[i] = desired
+			} else {
+				pod.Status.Conditions = append(pod.Status.Conditions, desired)
+			}
+			// patch the condition alone, the other conditions are owned by the kubelet
+			return c.Status().Patch(ctx, pod, client.StrategicMergeFrom(resource))
+		},
+	}
+}
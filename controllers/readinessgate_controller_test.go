@@ -0,0 +1,250 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	diecorev1 "reconciler.io/dies/apis/core/v1"
+	diemetav1 "reconciler.io/dies/apis/meta/v1"
+	"reconciler.io/runtime/reconcilers"
+	rtesting "reconciler.io/runtime/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	servicebindingv1 "github.com/servicebinding/runtime/apis/v1"
+	"github.com/servicebinding/runtime/controllers"
+	dieservicebindingv1 "github.com/servicebinding/runtime/dies/v1"
+)
+
+func TestReadinessGateReconciler(t *testing.T) {
+	namespace := "test-namespace"
+	name := "my-pod"
+	secret := "my-secret"
+	bindingUID := types.UID("3f8a1d2c-6b4e-4c7a-9d05-e1f2a3b4c5d6")
+	request := reconcilers.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}}
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1.AddToScheme(scheme))
+
+	now := metav1.Now().Rfc3339Copy()
+	earlier := metav1.NewTime(now.Add(-time.Hour))
+
+	pod := diecorev1.PodBlank.
+		APIVersion("v1").
+		Kind("Pod").
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(name)
+			d.AddLabel(controllers.GatedPodLabelKey, "true")
+			d.AddAnnotation(fmt.Sprintf("projector.servicebinding.io/secret-%s", bindingUID), secret)
+		}).
+		SpecDie(func(d *diecorev1.PodSpecDie) {
+			d.ContainerDie("workload", func(d *diecorev1.ContainerDie) {
+				d.Image("scratch")
+			})
+			d.ReadinessGates(corev1.PodReadinessGate{ConditionType: controllers.ReadinessGateConditionType})
+		})
+	boundCondition := corev1.PodCondition{
+		Type:               controllers.ReadinessGateConditionType,
+		Status:             corev1.ConditionTrue,
+		Reason:             "Bound",
+		LastTransitionTime: now,
+	}
+	statusPatch := func(patch string) rtesting.PatchRef {
+		return rtesting.PatchRef{
+			Kind:        "Pod",
+			Namespace:   namespace,
+			Name:        name,
+			SubResource: "status",
+			PatchType:   types.StrategicMergePatchType,
+			Patch:       []byte(patch),
+		}
+	}
+	boundPatch := statusPatch(fmt.Sprintf(`{"status":{"conditions":[{"lastProbeTime":null,"lastTransitionTime":%q,"reason":"Bound","status":"True","type":"servicebinding.io/bound"}]}}`, now.UTC().Format(time.RFC3339)))
+
+	serviceBinding := dieservicebindingv1.ServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name("my-binding")
+			d.UID(bindingUID)
+		}).
+		StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+			d.ConditionsDie(
+				dieservicebindingv1.ServiceBindingConditionReady.True(),
+				dieservicebindingv1.ServiceBindingConditionServiceAvailable.True(),
+				dieservicebindingv1.ServiceBindingConditionSecretValid.True(),
+				dieservicebindingv1.ServiceBindingConditionWorkloadProjected.True(),
+			)
+			d.BindingDie(func(d *dieservicebindingv1.ServiceBindingSecretReferenceDie) {
+				d.Name(secret)
+			})
+		})
+
+	rts := rtesting.ReconcilerTests{
+		"no readiness gate": {
+			Request: request,
+			Now:     now.Time,
+			GivenObjects: []client.Object{
+				pod.
+					SpecDie(func(d *diecorev1.PodSpecDie) {
+						d.ReadinessGates()
+					}),
+				serviceBinding,
+			},
+		},
+		"bound": {
+			Request: request,
+			Now:     now.Time,
+			GivenObjects: []client.Object{
+				pod,
+				serviceBinding,
+			},
+			ExpectStatusPatches: []rtesting.PatchRef{
+				boundPatch,
+			},
+		},
+		"bound while waiting for workloads": {
+			Request: request,
+			Now:     now.Time,
+			GivenObjects: []client.Object{
+				pod,
+				serviceBinding.
+					SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+						d.WaitForWorkloads(true)
+					}).
+					StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+						d.ConditionsDie(
+							dieservicebindingv1.ServiceBindingConditionReady.False().Reason("WorkloadNotReady"),
+							dieservicebindingv1.ServiceBindingConditionServiceAvailable.True(),
+							dieservicebindingv1.ServiceBindingConditionSecretValid.True(),
+							dieservicebindingv1.ServiceBindingConditionWorkloadProjected.True(),
+							dieservicebindingv1.ServiceBindingConditionWorkloadReady.False().Reason("WorkloadNotReady"),
+						)
+					}),
+			},
+			ExpectStatusPatches: []rtesting.PatchRef{
+				boundPatch,
+			},
+		},
+		"in sync": {
+			Request: request,
+			Now:     now.Time,
+			GivenObjects: []client.Object{
+				pod.
+					StatusDie(func(d *diecorev1.PodStatusDie) {
+						d.Conditions(boundCondition)
+					}),
+				serviceBinding,
+			},
+		},
+		"binding not bound": {
+			Request: request,
+			Now:     now.Time,
+			GivenObjects: []client.Object{
+				pod.
+					StatusDie(func(d *diecorev1.PodStatusDie) {
+						d.Conditions(corev1.PodCondition{
+							Type:               controllers.ReadinessGateConditionType,
+							Status:             corev1.ConditionTrue,
+							Reason:             "Bound",
+							LastTransitionTime: earlier,
+						})
+					}),
+				serviceBinding.
+					StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+						d.ConditionsDie(
+							dieservicebindingv1.ServiceBindingConditionReady.False(),
+							dieservicebindingv1.ServiceBindingConditionServiceAvailable.True(),
+							dieservicebindingv1.ServiceBindingConditionSecretValid.False(),
+							dieservicebindingv1.ServiceBindingConditionWorkloadProjected.True(),
+						)
+					}),
+			},
+			ExpectStatusPatches: []rtesting.PatchRef{
+				statusPatch(fmt.Sprintf(`{"status":{"$setElementOrder/conditions":[{"type":"servicebinding.io/bound"}],"conditions":[{"lastTransitionTime":%q,"message":"ServiceBinding \"my-binding\" is not bound","reason":"BindingNotBound","status":"False","type":"servicebinding.io/bound"}]}}`, now.UTC().Format(time.RFC3339))),
+			},
+		},
+		"secret rotated": {
+			Request: request,
+			Now:     now.Time,
+			GivenObjects: []client.Object{
+				pod,
+				serviceBinding.
+					StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+						d.BindingDie(func(d *dieservicebindingv1.ServiceBindingSecretReferenceDie) {
+							d.Name("my-rotated-secret")
+						})
+					}),
+			},
+			ExpectStatusPatches: []rtesting.PatchRef{
+				statusPatch(fmt.Sprintf(`{"status":{"conditions":[{"lastProbeTime":null,"lastTransitionTime":%q,"message":"ServiceBinding \"my-binding\" no longer binds Secret \"my-secret\" referenced by the pod","reason":"SecretChanged","status":"False","type":"servicebinding.io/bound"}]}}`, now.UTC().Format(time.RFC3339))),
+			},
+		},
+		"binding deleted": {
+			Request: request,
+			Now:     now.Time,
+			GivenObjects: []client.Object{
+				pod,
+			},
+			ExpectStatusPatches: []rtesting.PatchRef{
+				boundPatch,
+			},
+		},
+		"error listing bindings": {
+			Request: request,
+			Now:     now.Time,
+			GivenObjects: []client.Object{
+				pod,
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("list", "ServiceBindingList"),
+			},
+			ShouldErr: true,
+		},
+		"error patching status": {
+			Request: request,
+			Now:     now.Time,
+			GivenObjects: []client.Object{
+				pod,
+				serviceBinding,
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("patch", "Pod", rtesting.InduceFailureOpts{
+					SubResource: "status",
+				}),
+			},
+			ExpectStatusPatches: []rtesting.PatchRef{
+				boundPatch,
+			},
+			ShouldErr: true,
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, tc *rtesting.ReconcilerTestCase, c reconcilers.Config) reconcile.Reconciler {
+		return controllers.ReadinessGateReconciler(c)
+	})
+}
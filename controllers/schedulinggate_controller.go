@@ -0,0 +1,236 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"reconciler.io/runtime/apis"
+	"reconciler.io/runtime/reconcilers"
+	rtime "reconciler.io/runtime/time"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	servicebindingv1 "github.com/servicebinding/runtime/apis/v1"
+)
+
+// SchedulingGateName is the scheduling gate holding pods until their bindings are ready
+const SchedulingGateName = "servicebinding.io/bindings-ready"
+
+// SchedulingGateBindingsAnnotationKey lists the UIDs of the bindings a gated pod waits for, comma separated
+const SchedulingGateBindingsAnnotationKey = "servicebinding.io/scheduling-gate-bindings"
+
+// GatedPodLabelKey marks pods gated by the admission projector, with a scheduling gate or a readiness gate, so the pods
+// affected by a binding are listed without inspecting every pod in the namespace
+const GatedPodLabelKey = "servicebinding.io/gated"
+
+// gateScheduling adds the scheduling gate to the pod when any of the active, unsuspended bindings is not bound. The
+// bindings waited for are recorded on the pod for the SchedulingGate controller.
+func gateScheduling(pod *unstructured.Unstructured, serviceBindings []servicebindingv1.ServiceBinding) {
+	uids := []string{}
+	for i := range serviceBindings {
+		sb := &serviceBindings[i]
+		if sb.Spec.Suspend || isBound(sb) || slices.Contains(uids, string(sb.UID)) {
+			continue
+		}
+		uids = append(uids, string(sb.UID))
+	}
+	if len(uids) == 0 {
+		return
+	}
+
+	gates, _, _ := unstructured.NestedSlice(pod.Object, "spec", "schedulingGates")
+	gates = append(gates, map[string]interface{}{"name": SchedulingGateName})
+	_ = unstructured.SetNestedSlice(pod.Object, gates, "spec", "schedulingGates")
+
+	labelGatedPod(pod)
+	annotations := pod.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[SchedulingGateBindingsAnnotationKey] = strings.Join(uids, ",")
+	pod.SetAnnotations(annotations)
+}
+
+func labelGatedPod(pod *unstructured.Unstructured) {
+	labels := pod.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[GatedPodLabelKey] = "true"
+	pod.SetLabels(labels)
+}
+
+// isBound returns true when the binding's service is available, its secret is valid and it is projected into its
+// workloads. Unlike Ready, the WorkloadReady condition is not considered: with .spec.waitForWorkloads the workloads only
+// become ready once their pods are, so the pods the gates hold would never be released.
+func isBound(serviceBinding *servicebindingv1.ServiceBinding) bool {
+	for _, conditionType := range []string{
+		servicebindingv1.ServiceBindingConditionServiceAvailable,
+		servicebindingv1.ServiceBindingConditionSecretValid,
+		servicebindingv1.ServiceBindingConditionWorkloadProjected,
+	} {
+		if !apis.ConditionIsTrue(serviceBinding.Status.GetCondition(conditionType)) {
+			return false
+		}
+	}
+	return true
+}
+
+//+kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=core;events.k8s.io,resources=events,verbs=get;list;watch;create;update;patch;delete
+
+// SchedulingGateReconciler removes the scheduling gate from pods once the bindings the pod waits for are bound, or the
+// timeout since the pod was created passed. Only pods labeled with GatedPodLabelKey are expected to be cached.
+func SchedulingGateReconciler(c reconcilers.Config, timeout time.Duration) *reconcilers.ResourceReconciler[*corev1.Pod] {
+	return &reconcilers.ResourceReconciler[*corev1.Pod]{
+		Name:       "SchedulingGate",
+		Type:       &corev1.Pod{},
+		Reconciler: RemoveSchedulingGate(timeout),
+		// the pod's status is owned by the kubelet
+		SkipStatusUpdate: true,
+
+		Setup: func(ctx context.Context, mgr controllerruntime.Manager, bldr *builder.Builder) error {
+			// a binding becoming ready releases the gated pods waiting for it
+			bldr.Watches(&servicebindingv1.ServiceBinding{}, handler.EnqueueRequestsFromMapFunc(
+				func(ctx context.Context, o client.Object) []reconcile.Request {
+					return gatedPodRequests(ctx, mgr.GetClient(), hasSchedulingGate, client.InNamespace(o.GetNamespace()))
+				},
+			))
+			bldr.Watches(&servicebindingv1.ClusterServiceBinding{}, handler.EnqueueRequestsFromMapFunc(
+				func(ctx context.Context, o client.Object) []reconcile.Request {
+					return gatedPodRequests(ctx, mgr.GetClient(), hasSchedulingGate)
+				},
+			))
+			return nil
+		},
+		Config: c,
+	}
+}
+
+// gatedPodRequests lists the labeled pods that carry the gate
+func gatedPodRequests(ctx context.Context, c client.Client, gated func(*corev1.Pod) bool, opts ...client.ListOption) []reconcile.Request {
+	pods := &corev1.PodList{}
+	if err := c.List(ctx, pods, append(opts, client.MatchingLabels{GatedPodLabelKey: "true"})...); err != nil {
+		return nil
+	}
+	requests := []reconcile.Request{}
+	for i := range pods.Items {
+		if gated(&pods.Items[i]) {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&pods.Items[i])})
+		}
+	}
+	return requests
+}
+
+func hasSchedulingGate(pod *corev1.Pod) bool {
+	return slices.ContainsFunc(pod.Spec.SchedulingGates, func(gate corev1.PodSchedulingGate) bool {
+		return gate.Name == SchedulingGateName
+	})
+}
+
+// RemoveSchedulingGate removes the scheduling gate once every binding recorded on the pod is bound, suspended or
+// deleted. The gate is removed regardless once the timeout passed, pods are not held forever by a binding that never
+// becomes ready.
+func RemoveSchedulingGate(timeout time.Duration) reconcilers.SubReconciler[*corev1.Pod] {
+	return &reconcilers.SyncReconciler[*corev1.Pod]{
+		Name: "RemoveSchedulingGate",
+		SyncWithResult: func(ctx context.Context, resource *corev1.Pod) (reconcile.Result, error) {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+
+			if !hasSchedulingGate(resource) {
+				return reconcile.Result{}, nil
+			}
+
+			remaining := resource.CreationTimestamp.Add(timeout).Sub(rtime.RetrieveNow(ctx))
+			if remaining > 0 {
+				pending, err := pendingServiceBindings(ctx, c, resource)
+				if err != nil {
+					return reconcile.Result{}, err
+				}
+				if len(pending) != 0 {
+					// bindings becoming ready trigger the pod, requeue to enforce the timeout
+					return reconcile.Result{RequeueAfter: remaining}, nil
+				}
+			}
+
+			pod := resource.DeepCopy()
+			pod.Spec.SchedulingGates = slices.DeleteFunc(pod.Spec.SchedulingGates, func(gate corev1.PodSchedulingGate) bool {
+				return gate.Name == SchedulingGateName
+			})
+			if err := c.Patch(ctx, pod, client.MergeFrom(resource)); err != nil {
+				return reconcile.Result{}, err
+			}
+			if remaining > 0 {
+				c.Recorder.Event(resource, corev1.EventTypeNormal, "SchedulingGateRemoved", "Bindings are ready")
+			} else {
+				c.Recorder.Eventf(resource, corev1.EventTypeWarning, "SchedulingGateTimeout", "Bindings are not ready after %s, scheduling the pod anyway", timeout)
+			}
+
+			return reconcile.Result{}, nil
+		},
+	}
+}
+
+// pendingServiceBindings returns the UIDs of the bindings recorded on the pod that are neither bound, suspended nor
+// deleted. ClusterServiceBindings are bound when they are bound within the pod's namespace.
+func pendingServiceBindings(ctx context.Context, c reconcilers.Config, pod *corev1.Pod) ([]types.UID, error) {
+	bindings, err := namespaceServiceBindings(ctx, c, pod.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := []types.UID{}
+	for _, uid := range strings.Split(pod.Annotations[SchedulingGateBindingsAnnotationKey], ",") {
+		sb, ok := bindings[types.UID(uid)]
+		if !ok || !sb.DeletionTimestamp.IsZero() || sb.Spec.Suspend || isBound(sb) {
+			continue
+		}
+		pending = append(pending, sb.UID)
+	}
+	return pending, nil
+}
+
+// namespaceServiceBindings returns the bindings in the namespace by UID. ClusterServiceBindings are resolved as a
+// ServiceBinding in the namespace, with the status of the binding within the namespace.
+func namespaceServiceBindings(ctx context.Context, c reconcilers.Config, namespace string) (map[types.UID]*servicebindingv1.ServiceBinding, error) {
+	serviceBindings := &servicebindingv1.ServiceBindingList{}
+	if err := c.List(ctx, serviceBindings, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	clusterServiceBindings := &servicebindingv1.ClusterServiceBindingList{}
+	if err := c.List(ctx, clusterServiceBindings); err != nil {
+		return nil, err
+	}
+	bindings := map[types.UID]*servicebindingv1.ServiceBinding{}
+	for i := range serviceBindings.Items {
+		bindings[serviceBindings.Items[i].UID] = &serviceBindings.Items[i]
+	}
+	for i := range clusterServiceBindings.Items {
+		bindings[clusterServiceBindings.Items[i].UID] = clusterServiceBindings.Items[i].ServiceBindingFor(namespace)
+	}
+	return bindings, nil
+}
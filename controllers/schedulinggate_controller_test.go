@@ -0,0 +1,311 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers_test
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	diecorev1 "reconciler.io/dies/apis/core/v1"
+	diemetav1 "reconciler.io/dies/apis/meta/v1"
+	"reconciler.io/runtime/reconcilers"
+	rtesting "reconciler.io/runtime/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	servicebindingv1 "github.com/servicebinding/runtime/apis/v1"
+	"github.com/servicebinding/runtime/controllers"
+	dieservicebindingv1 "github.com/servicebinding/runtime/dies/v1"
+)
+
+func TestSchedulingGateReconciler(t *testing.T) {
+	namespace := "test-namespace"
+	name := "my-pod"
+	bindingUID := types.UID("0b7f4f6e-3c1d-4a9b-8e2f-6d5c4b3a2918")
+	clusterBindingUID := types.UID("7c2e9a41-5b8d-4f3e-a1c6-2d9e8f7b6a54")
+	request := reconcilers.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}}
+	timeout := 5 * time.Minute
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1.AddToScheme(scheme))
+
+	now := metav1.Now().Rfc3339Copy()
+	created := metav1.NewTime(now.Add(-time.Minute))
+
+	pod := diecorev1.PodBlank.
+		APIVersion("v1").
+		Kind("Pod").
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(name)
+			d.CreationTimestamp(created)
+			d.AddLabel(controllers.GatedPodLabelKey, "true")
+			d.AddAnnotation(controllers.SchedulingGateBindingsAnnotationKey, string(bindingUID))
+		}).
+		SpecDie(func(d *diecorev1.PodSpecDie) {
+			d.ContainerDie("workload", func(d *diecorev1.ContainerDie) {
+				d.Image("scratch")
+			})
+		})
+	gatedPod := pod.
+		SpecDie(func(d *diecorev1.PodSpecDie) {
+			d.SchedulingGates(corev1.PodSchedulingGate{Name: controllers.SchedulingGateName})
+		})
+
+	serviceBinding := dieservicebindingv1.ServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name("my-binding")
+			d.UID(bindingUID)
+		})
+	readyServiceBinding := serviceBinding.
+		StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+			d.ConditionsDie(
+				dieservicebindingv1.ServiceBindingConditionReady.True(),
+				dieservicebindingv1.ServiceBindingConditionServiceAvailable.True(),
+				dieservicebindingv1.ServiceBindingConditionSecretValid.True(),
+				dieservicebindingv1.ServiceBindingConditionWorkloadProjected.True(),
+			)
+		})
+	clusterServiceBinding := dieservicebindingv1.ClusterServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("my-cluster-binding")
+			d.UID(clusterBindingUID)
+		})
+
+	rts := rtesting.ReconcilerTests{
+		"not gated": {
+			Request: request,
+			Now:     now.Time,
+			GivenObjects: []client.Object{
+				pod,
+				serviceBinding,
+			},
+		},
+		"binding not ready": {
+			Request: request,
+			Now:     now.Time,
+			GivenObjects: []client.Object{
+				gatedPod,
+				serviceBinding,
+			},
+			ExpectedResult: reconcile.Result{RequeueAfter: 4 * time.Minute},
+		},
+		"binding ready": {
+			Request: request,
+			Now:     now.Time,
+			GivenObjects: []client.Object{
+				gatedPod,
+				readyServiceBinding,
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(pod, scheme, corev1.EventTypeNormal, "SchedulingGateRemoved", "Bindings are ready"),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Kind:      "Pod",
+					Namespace: namespace,
+					Name:      name,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"spec":{"schedulingGates":null}}`),
+				},
+			},
+		},
+		"binding bound while waiting for its workloads": {
+			Request: request,
+			Now:     now.Time,
+			GivenObjects: []client.Object{
+				gatedPod,
+				serviceBinding.
+					SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+						d.WaitForWorkloads(true)
+					}).
+					StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+						d.ConditionsDie(
+							dieservicebindingv1.ServiceBindingConditionReady.False().Reason("WorkloadNotReady"),
+							dieservicebindingv1.ServiceBindingConditionServiceAvailable.True(),
+							dieservicebindingv1.ServiceBindingConditionSecretValid.True(),
+							dieservicebindingv1.ServiceBindingConditionWorkloadProjected.True(),
+							dieservicebindingv1.ServiceBindingConditionWorkloadReady.False().Reason("WorkloadNotReady"),
+						)
+					}),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(pod, scheme, corev1.EventTypeNormal, "SchedulingGateRemoved", "Bindings are ready"),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Kind:      "Pod",
+					Namespace: namespace,
+					Name:      name,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"spec":{"schedulingGates":null}}`),
+				},
+			},
+		},
+		"binding suspended": {
+			Request: request,
+			Now:     now.Time,
+			GivenObjects: []client.Object{
+				gatedPod,
+				serviceBinding.
+					SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+						d.Suspend(true)
+					}),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(pod, scheme, corev1.EventTypeNormal, "SchedulingGateRemoved", "Bindings are ready"),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Kind:      "Pod",
+					Namespace: namespace,
+					Name:      name,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"spec":{"schedulingGates":null}}`),
+				},
+			},
+		},
+		"binding deleted": {
+			Request: request,
+			Now:     now.Time,
+			GivenObjects: []client.Object{
+				gatedPod,
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(pod, scheme, corev1.EventTypeNormal, "SchedulingGateRemoved", "Bindings are ready"),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Kind:      "Pod",
+					Namespace: namespace,
+					Name:      name,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"spec":{"schedulingGates":null}}`),
+				},
+			},
+		},
+		"cluster binding not ready in namespace": {
+			Request: request,
+			Now:     now.Time,
+			GivenObjects: []client.Object{
+				gatedPod.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddAnnotation(controllers.SchedulingGateBindingsAnnotationKey, string(clusterBindingUID))
+					}),
+				clusterServiceBinding,
+			},
+			ExpectedResult: reconcile.Result{RequeueAfter: 4 * time.Minute},
+		},
+		"cluster binding ready in namespace": {
+			Request: request,
+			Now:     now.Time,
+			GivenObjects: []client.Object{
+				gatedPod.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddAnnotation(controllers.SchedulingGateBindingsAnnotationKey, string(clusterBindingUID))
+					}),
+				clusterServiceBinding.
+					StatusDie(func(d *dieservicebindingv1.ClusterServiceBindingStatusDie) {
+						d.NamespaceDie(namespace, func(d *dieservicebindingv1.ClusterServiceBindingNamespaceStatusDie) {
+							d.ConditionsDie(
+								dieservicebindingv1.ServiceBindingConditionReady.True(),
+								dieservicebindingv1.ServiceBindingConditionServiceAvailable.True(),
+								dieservicebindingv1.ServiceBindingConditionSecretValid.True(),
+								dieservicebindingv1.ServiceBindingConditionWorkloadProjected.True(),
+							)
+						})
+					}),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(pod, scheme, corev1.EventTypeNormal, "SchedulingGateRemoved", "Bindings are ready"),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Kind:      "Pod",
+					Namespace: namespace,
+					Name:      name,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"spec":{"schedulingGates":null}}`),
+				},
+			},
+		},
+		"timeout": {
+			Request: request,
+			Now:     now.Add(timeout),
+			GivenObjects: []client.Object{
+				gatedPod,
+				serviceBinding,
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(pod, scheme, corev1.EventTypeWarning, "SchedulingGateTimeout", "Bindings are not ready after 5m0s, scheduling the pod anyway"),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Kind:      "Pod",
+					Namespace: namespace,
+					Name:      name,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"spec":{"schedulingGates":null}}`),
+				},
+			},
+		},
+		"error listing bindings": {
+			Request: request,
+			Now:     now.Time,
+			GivenObjects: []client.Object{
+				gatedPod,
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("list", "ServiceBindingList"),
+			},
+			ShouldErr: true,
+		},
+		"error patching pod": {
+			Request: request,
+			Now:     now.Time,
+			GivenObjects: []client.Object{
+				gatedPod,
+				readyServiceBinding,
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("patch", "Pod"),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Kind:      "Pod",
+					Namespace: namespace,
+					Name:      name,
+					PatchType: types.MergePatchType,
+					Patch:     []byte(`{"spec":{"schedulingGates":null}}`),
+				},
+			},
+			ShouldErr: true,
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, tc *rtesting.ReconcilerTestCase, c reconcilers.Config) reconcile.Reconciler {
+		return controllers.SchedulingGateReconciler(c, timeout)
+	})
+}
@@ -19,12 +19,23 @@ package controllers
 import (
 	"context"
 	"fmt"
-
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/workqueue"
 	"reconciler.io/runtime/apis"
 	"reconciler.io/runtime/reconcilers"
 	"reconciler.io/runtime/tracker"
@@ -35,6 +46,7 @@ import (
 
 	servicebindingv1 "github.com/servicebinding/runtime/apis/v1"
 	"github.com/servicebinding/runtime/lifecycle"
+	"github.com/servicebinding/runtime/projector"
 )
 
 //+kubebuilder:rbac:groups=servicebinding.io,resources=servicebindings,verbs=get;list;watch;create;update;patch;delete
@@ -49,9 +61,21 @@ func ServiceBindingReconciler(c reconcilers.Config, hooks lifecycle.ServiceBindi
 			Finalizer: servicebindingv1.GroupVersion.Group + "/finalizer",
 			Reconciler: reconcilers.Sequence[*servicebindingv1.ServiceBinding]{
 				ResolveBindingSecret(hooks),
+				ValidateBindingSecret(),
 				ResolveWorkloads(hooks),
-				ProjectBinding(hooks),
-				PatchWorkloads(),
+				&reconcilers.IfThen[*servicebindingv1.ServiceBinding]{
+					Name: "IfSuspended",
+					If: func(ctx context.Context, resource *servicebindingv1.ServiceBinding) bool {
+						return isSuspended(resource)
+					},
+					Then: SuspendBinding(hooks),
+					Else: reconcilers.Sequence[*servicebindingv1.ServiceBinding]{
+						ProjectBinding(hooks),
+						RolloutWorkloads(),
+						PatchWorkloads(hooks),
+					},
+				},
+				ReflectWorkloadReadiness(hooks),
 			},
 		},
 
@@ -111,6 +135,149 @@ func ResolveBindingSecret(hooks lifecycle.ServiceBindingHooks) reconcilers.SubRe
 	}
 }
 
+//+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+
+// ValidateBindingSecret checks that the resolved binding secret exists and contains every key the binding projects
+// into the workload, so that the workload is not left unable to start. The effective type and provider are reflected
+// from the secret unless set on the binding. A secret without a type entry is reported by the TypeResolved condition,
+// which is not part of Ready.
+func ValidateBindingSecret() reconcilers.SubReconciler[*servicebindingv1.ServiceBinding] {
+	secrets := &bindingSecrets{}
+
+	return &reconcilers.SyncReconciler[*servicebindingv1.ServiceBinding]{
+		Name: "ValidateBindingSecret",
+		Sync: func(ctx context.Context, resource *servicebindingv1.ServiceBinding) error {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+
+			// values on the binding override the secret
+			resource.Status.Type = resource.Spec.Type
+			resource.Status.Provider = resource.Spec.Provider
+
+			if resource.Status.Binding == nil {
+				// nothing to validate until the binding secret is resolved
+				return nil
+			}
+			secretName := resource.Status.Binding.Name
+
+			secret, err := secrets.get(ctx, c, types.NamespacedName{Namespace: resource.Namespace, Name: secretName})
+			if err != nil {
+				if apierrs.IsNotFound(err) {
+					resource.GetConditionManager().MarkFalse(servicebindingv1.ServiceBindingConditionSecretValid, "SecretNotFound", "the binding secret %q was not found", secretName)
+					return nil
+				}
+				if apierrs.IsForbidden(err) {
+					resource.GetConditionManager().MarkFalse(servicebindingv1.ServiceBindingConditionSecretValid, "SecretForbidden", "the controller does not have permission to get the binding secret")
+					return nil
+				}
+				return err
+			}
+
+			if resource.Status.Type == "" {
+				resource.Status.Type = secret.typ
+			}
+			if resource.Status.Provider == "" {
+				resource.Status.Provider = secret.provider
+			}
+			if resource.Status.Type == "" {
+				// the type entry is mandated by the spec, but was not checked by earlier releases
+				resource.GetConditionManager().MarkFalse(servicebindingv1.ServiceBindingConditionTypeResolved, "SecretMissingType", "the binding secret %q has no type entry and the binding does not set .spec.type", secretName)
+			} else {
+				resource.GetConditionManager().MarkTrue(servicebindingv1.ServiceBindingConditionTypeResolved, "TypeResolved", "")
+			}
+
+			missing := []string{}
+			for _, e := range resource.Spec.Env {
+				if e.Key == "type" && resource.Spec.Type != "" {
+					continue
+				}
+				if e.Key == "provider" && resource.Spec.Provider != "" {
+					continue
+				}
+				if secret.keys.Has(e.Key) || slices.Contains(missing, e.Key) {
+					continue
+				}
+				missing = append(missing, e.Key)
+			}
+			if len(missing) != 0 {
+				resource.GetConditionManager().MarkFalse(servicebindingv1.ServiceBindingConditionSecretValid, "SecretMissingKeys", "the binding secret %q is missing keys: %s", secretName, strings.Join(missing, ", "))
+				return nil
+			}
+
+			resource.GetConditionManager().MarkTrue(servicebindingv1.ServiceBindingConditionSecretValid, "SecretValid", "")
+			return nil
+		},
+
+		Setup: func(ctx context.Context, mgr ctlr.Manager, bldr *builder.Builder) error {
+			bldr.WatchesMetadata(&corev1.Secret{}, handler.Funcs{})
+			return nil
+		},
+	}
+}
+
+// bindingSecret is what is validated of a binding secret. The values of the secret are not retained, other than its
+// type and provider.
+type bindingSecret struct {
+	resourceVersion string
+	keys            sets.Set[string]
+	typ             string
+	provider        string
+}
+
+// bindingSecrets reads binding secrets. Secrets are tracked through a metadata only informer, so the data of every
+// secret in the cluster is not held in memory. The data is read from the API server only when the secret changed
+// since it was last read.
+type bindingSecrets struct {
+	m       sync.Mutex
+	secrets map[types.NamespacedName]bindingSecret
+}
+
+func (s *bindingSecrets) get(ctx context.Context, c reconcilers.Config, key types.NamespacedName) (bindingSecret, error) {
+	secretMetadata := &metav1.PartialObjectMetadata{}
+	secretMetadata.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Secret"))
+	if err := c.TrackAndGet(ctx, key, secretMetadata); err != nil {
+		if apierrs.IsNotFound(err) {
+			s.forget(key)
+		}
+		return bindingSecret{}, err
+	}
+
+	s.m.Lock()
+	cached, ok := s.secrets[key]
+	s.m.Unlock()
+	if ok && cached.resourceVersion == secretMetadata.ResourceVersion {
+		return cached, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := c.APIReader.Get(ctx, key, secret); err != nil {
+		if apierrs.IsNotFound(err) {
+			s.forget(key)
+		}
+		return bindingSecret{}, err
+	}
+	cached = bindingSecret{
+		// the metadata informer may lag behind the API server, compare with the version it reported
+		resourceVersion: secretMetadata.ResourceVersion,
+		keys:            sets.KeySet(secret.Data),
+		typ:             string(secret.Data["type"]),
+		provider:        string(secret.Data["provider"]),
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.secrets == nil {
+		s.secrets = map[types.NamespacedName]bindingSecret{}
+	}
+	s.secrets[key] = cached
+	return cached, nil
+}
+
+func (s *bindingSecrets) forget(key types.NamespacedName) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	delete(s.secrets, key)
+}
+
 func ResolveWorkloads(hooks lifecycle.ServiceBindingHooks) reconcilers.SubReconciler[*servicebindingv1.ServiceBinding] {
 	return &reconcilers.SyncReconciler[*servicebindingv1.ServiceBinding]{
 		Name:                   "ResolveWorkloads",
@@ -134,6 +301,11 @@ func ResolveWorkloads(hooks lifecycle.ServiceBindingHooks) reconcilers.SubReconc
 				}
 				trackingRef.Selector = selector
 			}
+			if resource.Spec.Workload.Owner != nil && trackingRef.Selector == nil {
+				// owner references are not indexed by the tracker, watch every workload of the kind so that newly owned
+				// workloads trigger the binding
+				trackingRef.Selector = labels.Everything()
+			}
 			c.Tracker.TrackReference(trackingRef, resource)
 
 			workloads, err := hooks.GetResolver(c).LookupWorkloads(ctx, resource)
@@ -172,6 +344,21 @@ func ResolveWorkloads(hooks lifecycle.ServiceBindingHooks) reconcilers.SubReconc
 	}
 }
 
+// ProjectionAnnotationKey on a workload set to ProjectionPod projects bindings into the workload's pods as they are
+// created, in place of the workload. The workload itself is left without a projection.
+const ProjectionAnnotationKey = "servicebinding.io/projection"
+
+// ProjectionPod is the ProjectionAnnotationKey value for workloads whose bindings are projected into their pods
+const ProjectionPod = "pod"
+
+// podProjectionReason is the reason reported in the status of a workload whose binding is projected into its pods
+const podProjectionReason = "PodProjection"
+
+// isPodProjected returns true when the workload opted into projecting its bindings into its pods
+func isPodProjected(workload runtime.Object) bool {
+	return workload.(metav1.Object).GetAnnotations()[ProjectionAnnotationKey] == ProjectionPod
+}
+
 //+kubebuilder:rbac:groups=servicebinding.io,resources=clusterworkloadresourcemappings,verbs=get;list;watch
 
 func ProjectBinding(hooks lifecycle.ServiceBindingHooks) reconcilers.SubReconciler[*servicebindingv1.ServiceBinding] {
@@ -190,30 +377,50 @@ func ProjectBinding(hooks lifecycle.ServiceBindingHooks) reconcilers.SubReconcil
 					return err
 				}
 			}
-			for i := range workloads {
+			// the workload hooks share the stash and are not required to be safe for concurrent use, they are called
+			// one at a time while the workloads are projected concurrently
+			var hooksMu sync.Mutex
+			runHook := func(f func(context.Context, runtime.Object) error, workload runtime.Object) error {
+				hooksMu.Lock()
+				defer hooksMu.Unlock()
+				return f(ctx, workload)
+			}
+			err := forEachWorkload(len(workloads), hooks.GetWorkloadConcurrency(), true, func(i int) error {
 				workload := workloads[i].DeepCopyObject()
 
 				if f := hooks.WorkloadPreProjection; f != nil {
-					if err := f(ctx, workload); err != nil {
+					if err := runHook(f, workload); err != nil {
 						return err
 					}
 				}
 				if !resource.DeletionTimestamp.IsZero() {
-					if err := projector.Unproject(ctx, resource, workload); err != nil {
+					if err := releaseWorkload(ctx, projector, resource, workload); err != nil {
 						return err
 					}
+				} else if isPodProjected(workload) {
+					// the binding is projected into the workload's pods at admission, remove a projection the
+					// workload carries from before it opted in
+					if projector.IsProjected(ctx, resource, workload) {
+						if err := projector.Unproject(ctx, resource, workload); err != nil {
+							return err
+						}
+					}
 				} else {
 					if err := projector.Project(ctx, resource, workload); err != nil {
 						return err
 					}
 				}
 				if f := hooks.WorkloadPostProjection; f != nil {
-					if err := f(ctx, workload); err != nil {
+					if err := runHook(f, workload); err != nil {
 						return err
 					}
 				}
 
 				projectedWorkloads[i] = workload
+				return nil
+			})
+			if err != nil {
+				return err
 			}
 			if f := hooks.ServiceBindingPostProjection; f != nil {
 				if err := f(ctx, resource); err != nil {
@@ -221,6 +428,23 @@ func ProjectBinding(hooks lifecycle.ServiceBindingHooks) reconcilers.SubReconcil
 				}
 			}
 
+			// record every workload, the list is capped once the workloads are patched
+			workloadStatuses := make([]servicebindingv1.ServiceBindingWorkloadStatus, len(projectedWorkloads))
+			for i := range projectedWorkloads {
+				if resource.DeletionTimestamp.IsZero() && isPodProjected(projectedWorkloads[i]) {
+					workloadStatuses[i] = newWorkloadStatus(projectedWorkloads[i], servicebindingv1.ServiceBindingWorkloadSkipped, podProjectionReason, "the binding is projected into the pods of the workload as they are created")
+					continue
+				}
+				// previously bound workloads that no longer match the binding are resolved to be unprojected
+				if !projector.IsProjected(ctx, resource, projectedWorkloads[i]) {
+					workloadStatuses[i] = newWorkloadStatus(projectedWorkloads[i], servicebindingv1.ServiceBindingWorkloadUnprojected, "", "")
+					continue
+				}
+				reason, message := adoptedManualProjections(resource, projectedWorkloads[i])
+				workloadStatuses[i] = newWorkloadStatus(projectedWorkloads[i], servicebindingv1.ServiceBindingWorkloadProjected, reason, message)
+			}
+			resource.Status.Workloads = workloadStatuses
+
 			StashProjectedWorkloads(ctx, projectedWorkloads)
 
 			return nil
@@ -233,18 +457,219 @@ func ProjectBinding(hooks lifecycle.ServiceBindingHooks) reconcilers.SubReconcil
 	}
 }
 
-func PatchWorkloads() reconcilers.SubReconciler[*servicebindingv1.ServiceBinding] {
+// releaseWorkload removes the binding from the workload of a terminating binding, or orphans the workload when the
+// binding's deletion policy asks for it. Projectors that cannot orphan a workload unproject it.
+func releaseWorkload(ctx context.Context, p projector.ServiceBindingProjector, resource *servicebindingv1.ServiceBinding, workload runtime.Object) error {
+	if orphaner, ok := p.(projector.Orphaner); ok && resource.Spec.DeletionPolicy == servicebindingv1.ServiceBindingDeletionPolicyOrphan {
+		return orphaner.Orphan(ctx, resource, workload)
+	}
+	return p.Unproject(ctx, resource, workload)
+}
+
+// SuspendBinding records the state of the workloads in place of projecting the binding while the binding is suspended.
+// The workloads are left untouched.
+func SuspendBinding(hooks lifecycle.ServiceBindingHooks) reconcilers.SubReconciler[*servicebindingv1.ServiceBinding] {
+	return &reconcilers.SyncReconciler[*servicebindingv1.ServiceBinding]{
+		Name: "SuspendBinding",
+		Sync: func(ctx context.Context, resource *servicebindingv1.ServiceBinding) error {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+			projector := hooks.GetProjector(hooks.GetResolver(TrackingClient(c)))
+
+			workloads := RetrieveWorkloads(ctx)
+			projectedWorkloads := make([]runtime.Object, len(workloads))
+			workloadStatuses := make([]servicebindingv1.ServiceBindingWorkloadStatus, len(workloads))
+			for i := range workloads {
+				// the workload is reported as it is, it may carry an outdated projection of the binding
+				projectedWorkloads[i] = workloads[i].DeepCopyObject()
+				result := servicebindingv1.ServiceBindingWorkloadUnprojected
+				if projector.IsProjected(ctx, resource, workloads[i]) {
+					result = servicebindingv1.ServiceBindingWorkloadProjected
+				}
+				workloadStatuses[i] = newWorkloadStatus(workloads[i], result, "", "")
+			}
+			resource.Status.SetWorkloads(workloadStatuses, hooks.GetWorkloadStatusLimit())
+			resource.Status.Rollout = nil
+
+			resource.GetConditionManager().MarkTrue(servicebindingv1.ServiceBindingConditionSuspended, "Suspended", "")
+			resource.GetConditionManager().MarkUnknown(servicebindingv1.ServiceBindingConditionWorkloadProjected, "Suspended", "the binding is suspended, changes are not projected into the workloads")
+
+			StashProjectedWorkloads(ctx, projectedWorkloads)
+
+			return nil
+		},
+	}
+}
+
+// isSuspended returns true when the binding must not change its workloads. A terminating binding is always removed
+// from its workloads.
+func isSuspended(resource *servicebindingv1.ServiceBinding) bool {
+	return resource.Spec.Suspend && resource.DeletionTimestamp.IsZero()
+}
+
+// RolloutWorkloads limits how many workloads roll out a change to the binding at the same time when the binding has a
+// rollout strategy. Changes to workloads beyond the budget are held back until the updated workloads report a
+// completed rollout. Removing the binding from its workloads is never held back.
+func RolloutWorkloads() reconcilers.SubReconciler[*servicebindingv1.ServiceBinding] {
+	return &reconcilers.SyncReconciler[*servicebindingv1.ServiceBinding]{
+		Name:                   "RolloutWorkloads",
+		SyncDuringFinalization: true,
+		Sync: func(ctx context.Context, resource *servicebindingv1.ServiceBinding) error {
+			resource.Status.Rollout = nil
+			if resource.Spec.Rollout == nil || !resource.DeletionTimestamp.IsZero() {
+				return nil
+			}
+
+			workloads := RetrieveWorkloads(ctx)
+			projectedWorkloads := RetrieveProjectedWorkloads(ctx)
+
+			if len(workloads) != len(projectedWorkloads) {
+				panic(fmt.Errorf("workloads and projectedWorkloads must have the same number of items"))
+			}
+
+			rollout := &servicebindingv1.ServiceBindingRolloutStatus{}
+			// workloads that are still rolling out count against the budget, even when the binding is up to date
+			unavailable := 0
+			changed := []int{}
+			for i := range workloads {
+				if !equality.Semantic.DeepEqual(workloads[i], projectedWorkloads[i]) {
+					changed = append(changed, i)
+					continue
+				}
+				rollout.UpdatedWorkloads++
+				if workloadRolledOut(workloads[i].(*unstructured.Unstructured)) {
+					rollout.ReadyWorkloads++
+				} else {
+					unavailable++
+				}
+			}
+
+			maxUnavailable := resource.Spec.Rollout.GetMaxUnavailable(len(workloads))
+			for _, i := range changed {
+				if unavailable < maxUnavailable {
+					// the workload will be unavailable until the change is rolled out
+					unavailable++
+					rollout.UpdatedWorkloads++
+					continue
+				}
+				// hold the workload back, it is updated by a later reconcile once other workloads finish rolling out
+				projectedWorkloads[i] = workloads[i].DeepCopyObject()
+				setWorkloadStatus(resource, newWorkloadStatus(workloads[i], servicebindingv1.ServiceBindingWorkloadPending, "RolloutPending", "waiting for updated workloads to complete their rollout"))
+				rollout.PendingWorkloads++
+			}
+
+			StashProjectedWorkloads(ctx, projectedWorkloads)
+
+			resource.Status.Rollout = rollout
+			if rollout.PendingWorkloads != 0 {
+				resource.GetConditionManager().MarkUnknown(servicebindingv1.ServiceBindingConditionWorkloadProjected, "RolloutInProgress", "rolled out to %d of %d workloads", rollout.UpdatedWorkloads, len(workloads))
+			}
+
+			return nil
+		},
+	}
+}
+
+// workloadRolledOut checks the standard status fields of a workload for a completed rollout. Workloads that report
+// replicas, like the apps/v1 Deployment, ReplicaSet and StatefulSet, are rolled out once every desired replica is
+// updated and available. DaemonSets are rolled out once every scheduled pod is updated and available. Other workloads
+// are rolled out once their Ready, or else Available, condition is True. Workloads without any of these fields are
+// considered rolled out. The controller must have observed the current generation of the workload for any of these
+// fields to be trusted.
+func workloadRolledOut(workload *unstructured.Unstructured) bool {
+	content := workload.UnstructuredContent()
+
+	if observedGeneration, found, _ := unstructured.NestedInt64(content, "status", "observedGeneration"); found && observedGeneration < workload.GetGeneration() {
+		return false
+	}
+
+	if desired, found, _ := unstructured.NestedInt64(content, "status", "desiredNumberScheduled"); found {
+		updated, _, _ := unstructured.NestedInt64(content, "status", "updatedNumberScheduled")
+		available, _, _ := unstructured.NestedInt64(content, "status", "numberAvailable")
+		return updated >= desired && available >= desired
+	}
+
+	if desired, found, _ := unstructured.NestedInt64(content, "spec", "replicas"); found {
+		replicas, _, _ := unstructured.NestedInt64(content, "status", "replicas")
+		updated, _, _ := unstructured.NestedInt64(content, "status", "updatedReplicas")
+		available, _, _ := unstructured.NestedInt64(content, "status", "availableReplicas")
+		// old replicas must be gone before the rollout is complete
+		return updated >= desired && replicas <= updated && available >= desired
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(content, "status", "conditions")
+	for _, conditionType := range []string{"Ready", "Available"} {
+		for _, condition := range conditions {
+			if condition, ok := condition.(map[string]interface{}); ok && condition["type"] == conditionType {
+				return condition["status"] == "True"
+			}
+		}
+	}
+
+	return true
+}
+
+// ReflectWorkloadReadiness reports whether every workload the binding is projected into has rolled out with the
+// binding in the WorkloadReady condition, for bindings that wait for their workloads. The condition is removed from
+// bindings that do not.
+func ReflectWorkloadReadiness(hooks lifecycle.ServiceBindingHooks) reconcilers.SubReconciler[*servicebindingv1.ServiceBinding] {
+	return &reconcilers.SyncReconciler[*servicebindingv1.ServiceBinding]{
+		Name: "ReflectWorkloadReadiness",
+		Sync: func(ctx context.Context, resource *servicebindingv1.ServiceBinding) error {
+			if !resource.Spec.WaitForWorkloads {
+				// the condition is not part of the condition set, it is always safe to clear
+				_ = resource.GetConditionManager().ClearCondition(servicebindingv1.ServiceBindingConditionWorkloadReady)
+				return nil
+			}
+
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+			projector := hooks.GetProjector(hooks.GetResolver(TrackingClient(c)))
+
+			workloads := RetrieveWorkloads(ctx)
+			projectedWorkloads := RetrieveProjectedWorkloads(ctx)
+
+			if len(workloads) != len(projectedWorkloads) {
+				panic(fmt.Errorf("workloads and projectedWorkloads must have the same number of items"))
+			}
+
+			bound, ready := 0, 0
+			for i := range projectedWorkloads {
+				if !projector.IsProjected(ctx, resource, projectedWorkloads[i]) {
+					continue
+				}
+				bound++
+				// a workload updated by this reconcile has only started to roll out, the workload's status is
+				// reflected once the updated workload triggers the binding again
+				if equality.Semantic.DeepEqual(workloads[i], projectedWorkloads[i]) && workloadRolledOut(workloads[i].(*unstructured.Unstructured)) {
+					ready++
+				}
+			}
+
+			if ready < bound {
+				resource.GetConditionManager().MarkUnknown(servicebindingv1.ServiceBindingConditionWorkloadReady, "WorkloadsNotReady", "%d of %d bound workloads are ready", ready, bound)
+				return nil
+			}
+			resource.GetConditionManager().MarkTrue(servicebindingv1.ServiceBindingConditionWorkloadReady, "WorkloadsReady", "")
+
+			return nil
+		},
+	}
+}
+
+func PatchWorkloads(hooks lifecycle.ServiceBindingHooks) reconcilers.SubReconciler[*servicebindingv1.ServiceBinding] {
 	workloadManager := &reconcilers.UpdatingObjectManager[*unstructured.Unstructured]{
 		Name: "PatchWorkloads",
 		MergeBeforeUpdate: func(current, desired *unstructured.Unstructured) {
 			current.SetUnstructuredContent(desired.UnstructuredContent())
 		},
 	}
+	// failed updates are retried with an exponential backoff tracked for each workload, a workload that is persistently
+	// rejected does not hold back the retries for other workloads
+	workloadBackoff := workqueue.NewTypedItemExponentialFailureRateLimiter[types.UID](time.Second, 5*time.Minute)
 
 	return &reconcilers.SyncReconciler[*servicebindingv1.ServiceBinding]{
 		Name:                   "PatchWorkloads",
 		SyncDuringFinalization: true,
-		Sync: func(ctx context.Context, resource *servicebindingv1.ServiceBinding) error {
+		SyncWithResult: func(ctx context.Context, resource *servicebindingv1.ServiceBinding) (reconcile.Result, error) {
 			workloads := RetrieveWorkloads(ctx)
 			projectedWorkloads := RetrieveProjectedWorkloads(ctx)
 
@@ -252,39 +677,195 @@ func PatchWorkloads() reconcilers.SubReconciler[*servicebindingv1.ServiceBinding
 				panic(fmt.Errorf("workloads and projectedWorkloads must have the same number of items"))
 			}
 
+			defer func() {
+				resource.Status.SetWorkloads(resource.Status.Workloads, hooks.GetWorkloadStatusLimit())
+			}()
+
 			for i := range workloads {
-				workload := workloads[i].(*unstructured.Unstructured)
-				projectedWorkload := projectedWorkloads[i].(*unstructured.Unstructured)
+				workload := workloads[i].(metav1.Object)
+				projectedWorkload := projectedWorkloads[i].(metav1.Object)
 				if workload.GetUID() != projectedWorkload.GetUID() || workload.GetResourceVersion() != projectedWorkload.GetResourceVersion() {
 					panic(fmt.Errorf("workload and projectedWorkload must have the same uid and resourceVersion"))
 				}
+			}
 
-				if _, err := workloadManager.Manage(ctx, resource, workload, projectedWorkload); err != nil {
+			// every workload is attempted, a failure to update one workload must not block the projection into others
+			patchedWorkloads := make([]*unstructured.Unstructured, len(workloads))
+			patchErrs := make([]error, len(workloads))
+			_ = forEachWorkload(len(workloads), hooks.GetWorkloadConcurrency(), false, func(i int) error {
+				patchedWorkloads[i], patchErrs[i] = workloadManager.Manage(ctx, resource, workloads[i].(*unstructured.Unstructured), projectedWorkloads[i].(*unstructured.Unstructured))
+				return nil
+			})
+
+			// outcomes are recorded in the order of the workloads, independent of the order the updates completed
+			forbidden, failed := 0, 0
+			result := reconcile.Result{}
+			for i := range workloads {
+				workload := workloads[i].(*unstructured.Unstructured)
+				patchedWorkload, err := patchedWorkloads[i], patchErrs[i]
+				if err != nil {
 					if apierrs.IsNotFound(err) {
 						// someone must have deleted the workload while we were operating on it
+						workloadBackoff.Forget(workload.GetUID())
+						setWorkloadStatus(resource, newWorkloadStatus(workload, servicebindingv1.ServiceBindingWorkloadSkipped, "WorkloadNotFound", "the workload was deleted"))
 						continue
 					}
 					if apierrs.IsForbidden(err) {
 						// set False, the operator needs to give access to the resource
 						// see https://servicebinding.io/spec/core/1.0.0/#considerations-for-role-based-access-control-rbac-1
-						resource.GetConditionManager().MarkFalse(servicebindingv1.ServiceBindingConditionWorkloadProjected, "WorkloadForbidden", "the controller does not have permission to update the workloads")
-						return nil
+						forbidden++
+						setWorkloadStatus(resource, newWorkloadStatus(workload, servicebindingv1.ServiceBindingWorkloadFailed, "WorkloadForbidden", "the controller does not have permission to update the workload"))
+						continue
+					}
+					// the update may have been rejected by another admission webhook or have conflicted with another
+					// writer, retry the workload after its backoff
+					failed++
+					setWorkloadStatus(resource, newWorkloadStatus(workload, servicebindingv1.ServiceBindingWorkloadFailed, "WorkloadUpdateFailed", err.Error()))
+					result = reconcilers.AggregateResults(result, reconcile.Result{RequeueAfter: workloadBackoff.When(workload.GetUID())})
+					continue
+				}
+				workloadBackoff.Forget(workload.GetUID())
+				if patchedWorkload != nil {
+					// the generation of the workload is bumped when the projection changed the workload's spec
+					if status := getWorkloadStatus(resource, workload); status != nil {
+						status.ObservedGeneration = patchedWorkload.GetGeneration()
 					}
-					// TODO handle other err cases
-					return err
 				}
 			}
 
+			if failed != 0 {
+				resource.GetConditionManager().MarkFalse(servicebindingv1.ServiceBindingConditionWorkloadProjected, "WorkloadUpdateFailed", "failed to update %d of %d workloads, see .status.workloads for details", failed+forbidden, len(workloads))
+				return result, nil
+			}
+			if forbidden != 0 {
+				resource.GetConditionManager().MarkFalse(servicebindingv1.ServiceBindingConditionWorkloadProjected, "WorkloadForbidden", "the controller does not have permission to update the workloads")
+				return result, nil
+			}
+
 			// update the WorkloadProjected condition to indicate success, but only if the condition has not already been set with another status
 			if cond := resource.Status.GetCondition(servicebindingv1.ServiceBindingConditionWorkloadProjected); apis.ConditionIsUnknown(cond) && cond.Reason == "Initializing" {
 				resource.GetConditionManager().MarkTrue(servicebindingv1.ServiceBindingConditionWorkloadProjected, "WorkloadProjected", "")
 			}
 
-			return nil
+			return result, nil
 		},
 	}
 }
 
+// adoptedManualProjections describes the manual projections of the binding secret the binding adopted in the workload
+func adoptedManualProjections(resource *servicebindingv1.ServiceBinding, workload runtime.Object) (string, string) {
+	adopted, err := projector.RetrieveAdoptedProjections(resource, workload)
+	if err != nil || adopted.IsEmpty() {
+		return "", ""
+	}
+	projections := []string{}
+	for _, v := range adopted.Volumes {
+		projections = append(projections, fmt.Sprintf("volume %q", v))
+	}
+	for _, e := range adopted.Env {
+		projections = append(projections, fmt.Sprintf("env %q", e.Name))
+	}
+	kept := []string{}
+	for _, v := range adopted.Kept {
+		kept = append(kept, fmt.Sprintf("volume %q", v))
+	}
+	if len(kept) == 0 {
+		return "AdoptedManualProjections", fmt.Sprintf("adopted manual projections of the binding secret: %s", strings.Join(projections, ", "))
+	}
+	// the volume is mounted where the application expects it, moving it to the binding's path would break the workload
+	message := fmt.Sprintf("kept manual projections of the binding secret mounted at a custom path: %s", strings.Join(kept, ", "))
+	if len(projections) != 0 {
+		message = fmt.Sprintf("adopted manual projections of the binding secret: %s; %s", strings.Join(projections, ", "), message)
+	}
+	return "ManualProjectionsKept", message
+}
+
+func newWorkloadStatus(workload runtime.Object, result servicebindingv1.ServiceBindingWorkloadResult, reason, message string) servicebindingv1.ServiceBindingWorkloadStatus {
+	gvk := workload.GetObjectKind().GroupVersionKind()
+	obj := workload.(metav1.Object)
+	return servicebindingv1.ServiceBindingWorkloadStatus{
+		APIVersion:         gvk.GroupVersion().String(),
+		Kind:               gvk.Kind,
+		Name:               obj.GetName(),
+		UID:                obj.GetUID(),
+		ObservedGeneration: obj.GetGeneration(),
+		Result:             result,
+		Reason:             reason,
+		Message:            message,
+	}
+}
+
+func getWorkloadStatus(resource *servicebindingv1.ServiceBinding, workload metav1.Object) *servicebindingv1.ServiceBindingWorkloadStatus {
+	for i := range resource.Status.Workloads {
+		if resource.Status.Workloads[i].UID == workload.GetUID() {
+			return &resource.Status.Workloads[i]
+		}
+	}
+	return nil
+}
+
+func setWorkloadStatus(resource *servicebindingv1.ServiceBinding, workloadStatus servicebindingv1.ServiceBindingWorkloadStatus) {
+	for i := range resource.Status.Workloads {
+		if resource.Status.Workloads[i].UID == workloadStatus.UID {
+			resource.Status.Workloads[i] = workloadStatus
+			return
+		}
+	}
+	resource.Status.Workloads = append(resource.Status.Workloads, workloadStatus)
+}
+
+// forEachWorkload calls f for each of n workloads with at most concurrency calls in flight. Once a call fails no
+// further workloads are started when stopOnError is set. The error returned is the error of the failed workload with
+// the lowest index, which does not depend on the order the calls completed.
+func forEachWorkload(n, concurrency int, stopOnError bool, f func(i int) error) error {
+	errs := make([]error, n)
+	if concurrency <= 1 {
+		for i := 0; i < n; i++ {
+			if errs[i] = f(i); errs[i] != nil && stopOnError {
+				break
+			}
+		}
+	} else {
+		var wg sync.WaitGroup
+		var failed atomic.Bool
+		// a panic in a worker would otherwise crash the process, it is raised again on the calling goroutine
+		panics := make([]interface{}, n)
+		sem := make(chan struct{}, concurrency)
+		for i := 0; i < n; i++ {
+			sem <- struct{}{}
+			if stopOnError && failed.Load() {
+				break
+			}
+			wg.Add(1)
+			go func(i int) {
+				defer func() {
+					if r := recover(); r != nil {
+						panics[i] = r
+						failed.Store(true)
+					}
+					<-sem
+					wg.Done()
+				}()
+				if errs[i] = f(i); errs[i] != nil {
+					failed.Store(true)
+				}
+			}(i)
+		}
+		wg.Wait()
+		for _, r := range panics {
+			if r != nil {
+				panic(r)
+			}
+		}
+	}
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 const WorkloadsStashKey reconcilers.StashKey = "servicebinding.io:workloads"
 
 func StashWorkloads(ctx context.Context, workloads []runtime.Object) {
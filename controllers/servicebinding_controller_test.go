@@ -17,9 +17,12 @@ limitations under the License.
 package controllers_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -31,6 +34,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/uuid"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -86,6 +90,15 @@ func TestServiceBindingReconciler(t *testing.T) {
 			})
 		})
 
+	secret := diecorev1.SecretBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(secretName)
+		}).
+		Data(map[string][]byte{
+			"type": []byte("mysql"),
+		})
+
 	workloadMapping := dieservicebindingv1.ClusterWorkloadResourceMappingBlank.
 		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
 			d.Name("deployments.apps")
@@ -163,6 +176,13 @@ func TestServiceBindingReconciler(t *testing.T) {
 
 	newWorkloadUID := uuid.NewUUID()
 
+	workloadStatus := dieservicebindingv1.ServiceBindingWorkloadStatusBlank.
+		APIVersion("apps/v1").
+		Kind("Deployment").
+		Name(workload.GetName()).
+		UID(workload.GetUID()).
+		Result(servicebindingv1.ServiceBindingWorkloadProjected)
+
 	rts := rtesting.ReconcilerTests{
 		"in sync": {
 			Request: request,
@@ -177,16 +197,23 @@ func TestServiceBindingReconciler(t *testing.T) {
 					StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
 						d.ConditionsDie(
 							dieservicebindingv1.ServiceBindingConditionReady.True().Reason("ServiceBound"),
+							dieservicebindingv1.ServiceBindingConditionSecretValid.True().Reason("SecretValid"),
 							dieservicebindingv1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+							dieservicebindingv1.ServiceBindingConditionTypeResolved.True().Reason("TypeResolved"),
 							dieservicebindingv1.ServiceBindingConditionWorkloadProjected.True().Reason("WorkloadProjected"),
 						)
 						d.BindingDie(func(d *dieservicebindingv1.ServiceBindingSecretReferenceDie) {
 							d.Name(secretName)
 						})
+						d.Type("mysql")
+						d.WorkloadsDie(workloadStatus)
+						d.BoundWorkloads(1)
 					}),
+				secret,
 				projectedWorkload,
 			},
 			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
 				rtesting.NewTrackRequest(projectedWorkload, serviceBinding, scheme),
 				rtesting.NewTrackRequest(workloadMapping, serviceBinding, scheme),
 			},
@@ -219,6 +246,7 @@ func TestServiceBindingReconciler(t *testing.T) {
 					StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
 						d.ConditionsDie(
 							dieservicebindingv1.ServiceBindingConditionReady.Unknown().Reason("Initializing"),
+							dieservicebindingv1.ServiceBindingConditionSecretValid.Unknown().Reason("Initializing"),
 							dieservicebindingv1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
 							dieservicebindingv1.ServiceBindingConditionWorkloadProjected.Unknown().Reason("Initializing"),
 						)
@@ -243,9 +271,11 @@ func TestServiceBindingReconciler(t *testing.T) {
 							d.Name(secretName)
 						})
 					}),
+				secret,
 				workload,
 			},
 			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
 				rtesting.NewTrackRequest(projectedWorkload, serviceBinding, scheme),
 				rtesting.NewTrackRequest(workloadMapping, serviceBinding, scheme),
 			},
@@ -261,12 +291,17 @@ func TestServiceBindingReconciler(t *testing.T) {
 					StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
 						d.ConditionsDie(
 							dieservicebindingv1.ServiceBindingConditionReady.True().Reason("ServiceBound"),
+							dieservicebindingv1.ServiceBindingConditionSecretValid.True().Reason("SecretValid"),
 							dieservicebindingv1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+							dieservicebindingv1.ServiceBindingConditionTypeResolved.True().Reason("TypeResolved"),
 							dieservicebindingv1.ServiceBindingConditionWorkloadProjected.True().Reason("WorkloadProjected"),
 						)
 						d.BindingDie(func(d *dieservicebindingv1.ServiceBindingSecretReferenceDie) {
 							d.Name(secretName)
 						})
+						d.Type("mysql")
+						d.WorkloadsDie(workloadStatus)
+						d.BoundWorkloads(1)
 					}),
 			},
 		},
@@ -288,13 +323,17 @@ func TestServiceBindingReconciler(t *testing.T) {
 					StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
 						d.ConditionsDie(
 							dieservicebindingv1.ServiceBindingConditionReady.True().Reason("ServiceBound"),
+							dieservicebindingv1.ServiceBindingConditionSecretValid.True().Reason("SecretValid"),
 							dieservicebindingv1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+							dieservicebindingv1.ServiceBindingConditionTypeResolved.True().Reason("TypeResolved"),
 							dieservicebindingv1.ServiceBindingConditionWorkloadProjected.True().Reason("WorkloadProjected"),
 						)
 						d.BindingDie(func(d *dieservicebindingv1.ServiceBindingSecretReferenceDie) {
 							d.Name(secretName)
 						})
+						d.Type("mysql")
 					}),
+				secret,
 				projectedWorkload,
 				workload.
 					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
@@ -303,6 +342,7 @@ func TestServiceBindingReconciler(t *testing.T) {
 					}),
 			},
 			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
 				rtesting.NewTrackRequest(workload.MetadataDie(func(d *diemetav1.ObjectMetaDie) { d.Name("new-workload") }), serviceBinding, scheme),
 				rtesting.NewTrackRequest(workloadMapping, serviceBinding, scheme),
 				rtesting.NewTrackRequest(workloadMapping, serviceBinding, scheme),
@@ -310,6 +350,7 @@ func TestServiceBindingReconciler(t *testing.T) {
 			ExpectEvents: []rtesting.Event{
 				rtesting.NewEvent(serviceBinding, scheme, corev1.EventTypeNormal, "Updated", "Updated Deployment %q", workload.GetName()),
 				rtesting.NewEvent(serviceBinding, scheme, corev1.EventTypeNormal, "Updated", "Updated Deployment %q", "new-workload"),
+				rtesting.NewEvent(serviceBinding, scheme, corev1.EventTypeNormal, "StatusUpdated", "Updated status"),
 			},
 			ExpectUpdates: []client.Object{
 				// unproject my-workload
@@ -322,6 +363,104 @@ func TestServiceBindingReconciler(t *testing.T) {
 					}).
 					DieReleaseUnstructured(),
 			},
+			ExpectStatusUpdates: []client.Object{
+				serviceBinding.
+					SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+						d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+							d.Name("new-workload")
+						})
+					}).
+					StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+						d.ConditionsDie(
+							dieservicebindingv1.ServiceBindingConditionReady.True().Reason("ServiceBound"),
+							dieservicebindingv1.ServiceBindingConditionSecretValid.True().Reason("SecretValid"),
+							dieservicebindingv1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+							dieservicebindingv1.ServiceBindingConditionTypeResolved.True().Reason("TypeResolved"),
+							dieservicebindingv1.ServiceBindingConditionWorkloadProjected.True().Reason("WorkloadProjected"),
+						)
+						d.BindingDie(func(d *dieservicebindingv1.ServiceBindingSecretReferenceDie) {
+							d.Name(secretName)
+						})
+						d.Type("mysql")
+						d.WorkloadsDie(
+							workloadStatus.Result(servicebindingv1.ServiceBindingWorkloadUnprojected),
+							workloadStatus.Name("new-workload").UID(newWorkloadUID),
+						)
+						d.BoundWorkloads(1)
+					}),
+			},
+		},
+		"suspended": {
+			Request: request,
+			StatusSubResourceTypes: []client.Object{
+				&servicebindingv1.ServiceBinding{},
+			},
+			GivenObjects: []client.Object{
+				serviceBinding.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Finalizers("servicebinding.io/finalizer")
+					}).
+					SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+						d.Suspend(true)
+						// not projected while suspended
+						d.EnvDie("BOUND_TYPE", func(d *dieservicebindingv1.EnvMappingDie) {
+							d.Key("type")
+						})
+					}).
+					StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+						d.ConditionsDie(
+							dieservicebindingv1.ServiceBindingConditionReady.True().Reason("ServiceBound"),
+							dieservicebindingv1.ServiceBindingConditionSecretValid.True().Reason("SecretValid"),
+							dieservicebindingv1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+							dieservicebindingv1.ServiceBindingConditionTypeResolved.True().Reason("TypeResolved"),
+							dieservicebindingv1.ServiceBindingConditionWorkloadProjected.True().Reason("WorkloadProjected"),
+						)
+						d.BindingDie(func(d *dieservicebindingv1.ServiceBindingSecretReferenceDie) {
+							d.Name(secretName)
+						})
+						d.Type("mysql")
+						d.WorkloadsDie(workloadStatus)
+						d.BoundWorkloads(1)
+					}),
+				secret,
+				projectedWorkload,
+			},
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
+				rtesting.NewTrackRequest(projectedWorkload, serviceBinding, scheme),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(serviceBinding, scheme, corev1.EventTypeNormal, "StatusUpdated", "Updated status"),
+			},
+			ExpectStatusUpdates: []client.Object{
+				serviceBinding.
+					SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+						d.Suspend(true)
+						d.EnvDie("BOUND_TYPE", func(d *dieservicebindingv1.EnvMappingDie) {
+							d.Key("type")
+						})
+					}).
+					StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+						d.ConditionsDie(
+							dieservicebindingv1.ServiceBindingConditionReady.
+								Reason("Suspended").
+								Message("the binding is suspended, changes are not projected into the workloads"),
+							dieservicebindingv1.ServiceBindingConditionSecretValid.True().Reason("SecretValid"),
+							dieservicebindingv1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+							dieservicebindingv1.ServiceBindingConditionSuspended.True().Reason("Suspended"),
+							dieservicebindingv1.ServiceBindingConditionTypeResolved.True().Reason("TypeResolved"),
+							dieservicebindingv1.ServiceBindingConditionWorkloadProjected.
+								Reason("Suspended").
+								Message("the binding is suspended, changes are not projected into the workloads"),
+						)
+						d.BindingDie(func(d *dieservicebindingv1.ServiceBindingSecretReferenceDie) {
+							d.Name(secretName)
+						})
+						d.Type("mysql")
+						d.WorkloadsDie(workloadStatus)
+						d.BoundWorkloads(1)
+					}),
+			},
 		},
 		"terminating": {
 			Request: request,
@@ -591,9 +730,10 @@ func TestResolveBindingSecret(t *testing.T) {
 	})
 }
 
-func TestResolveWorkload(t *testing.T) {
+func TestValidateBindingSecret(t *testing.T) {
 	namespace := "test-namespace"
 	name := "my-binding"
+	secretName := "my-secret"
 
 	scheme := runtime.NewScheme()
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
@@ -603,192 +743,502 @@ func TestResolveWorkload(t *testing.T) {
 		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
 			d.Namespace(namespace)
 			d.Name(name)
+		}).
+		StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+			d.BindingDie(func(d *dieservicebindingv1.ServiceBindingSecretReferenceDie) {
+				d.Name(secretName)
+			})
 		})
 
-	workload := dieappsv1.DeploymentBlank.
-		APIVersion("apps/v1").
-		Kind("Deployment")
-	workload1 := workload.
-		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
-			d.Namespace(namespace)
-			d.Name("my-workload-1")
-			d.AddLabel("app", "my")
-		})
-	workload2 := workload.
-		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
-			d.Namespace(namespace)
-			d.Name("my-workload-2")
-			d.AddLabel("app", "my")
-		})
-	workload3 := workload.
+	secret := diecorev1.SecretBlank.
 		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
 			d.Namespace(namespace)
-			d.Name("not-my-workload")
-			d.AddLabel("app", "not")
+			d.Name(secretName)
 		})
 
 	rts := rtesting.SubReconcilerTests[*servicebindingv1.ServiceBinding]{
-		"resolve named workload": {
+		"binding secret not resolved": {
 			Resource: serviceBinding.
-				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
-					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
-						d.APIVersion("apps/v1")
-						d.Kind("Deployment")
-						d.Name("my-workload-1")
-					})
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.Binding(nil)
 				}).
 				DieReleasePtr(),
+		},
+		"secret is valid": {
+			Resource: serviceBinding.DieReleasePtr(),
 			GivenObjects: []client.Object{
-				workload1,
-				workload2,
-				workload3,
+				secret.
+					Data(map[string][]byte{
+						"type": []byte("mysql"),
+					}),
 			},
+			ExpectResource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1.ServiceBindingConditionSecretValid.
+							True().
+							Reason("SecretValid"),
+						dieservicebindingv1.ServiceBindingConditionTypeResolved.
+							True().
+							Reason("TypeResolved"),
+					)
+					d.Type("mysql")
+				}).
+				DieReleasePtr(),
 			ExpectTracks: []rtesting.TrackRequest{
-				rtesting.NewTrackRequest(workload1, serviceBinding, scheme),
-			},
-			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
-				controllers.WorkloadsStashKey: []runtime.Object{
-					workload1.DieReleaseUnstructured(),
-				},
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
 			},
 		},
-		"resolve named workload not found": {
+		"type and provider from the secret": {
 			Resource: serviceBinding.
 				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
-					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
-						d.APIVersion("apps/v1")
-						d.Kind("Deployment")
-						d.Name("my-workload-1")
+					d.EnvDie("BOUND_TYPE", func(d *dieservicebindingv1.EnvMappingDie) {
+						d.Key("type")
+					})
+					d.EnvDie("BOUND_PROVIDER", func(d *dieservicebindingv1.EnvMappingDie) {
+						d.Key("provider")
 					})
 				}).
 				DieReleasePtr(),
+			GivenObjects: []client.Object{
+				secret.
+					Data(map[string][]byte{
+						"type":     []byte("mysql"),
+						"provider": []byte("bitnami"),
+					}),
+			},
 			ExpectResource: serviceBinding.
 				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
-					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
-						d.APIVersion("apps/v1")
-						d.Kind("Deployment")
-						d.Name("my-workload-1")
+					d.EnvDie("BOUND_TYPE", func(d *dieservicebindingv1.EnvMappingDie) {
+						d.Key("type")
+					})
+					d.EnvDie("BOUND_PROVIDER", func(d *dieservicebindingv1.EnvMappingDie) {
+						d.Key("provider")
 					})
 				}).
 				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
 					d.ConditionsDie(
-						dieservicebindingv1.ServiceBindingConditionReady.
-							Reason("WorkloadNotFound").Message("the workload was not found"),
-						dieservicebindingv1.ServiceBindingConditionWorkloadProjected.
-							Reason("WorkloadNotFound").Message("the workload was not found"),
+						dieservicebindingv1.ServiceBindingConditionSecretValid.
+							True().
+							Reason("SecretValid"),
+						dieservicebindingv1.ServiceBindingConditionTypeResolved.
+							True().
+							Reason("TypeResolved"),
 					)
+					d.Type("mysql")
+					d.Provider("bitnami")
 				}).
 				DieReleasePtr(),
 			ExpectTracks: []rtesting.TrackRequest{
-				rtesting.NewTrackRequest(workload1, serviceBinding, scheme),
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
 			},
 		},
-		"resolve named workload forbidden": {
+		"type and provider from the binding": {
 			Resource: serviceBinding.
 				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
-					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
-						d.APIVersion("apps/v1")
-						d.Kind("Deployment")
-						d.Name("my-workload-1")
+					d.Type("mysql")
+					d.Provider("bitnami")
+					d.EnvDie("BOUND_TYPE", func(d *dieservicebindingv1.EnvMappingDie) {
+						d.Key("type")
+					})
+					d.EnvDie("BOUND_PROVIDER", func(d *dieservicebindingv1.EnvMappingDie) {
+						d.Key("provider")
+					})
+					d.EnvDie("BOUND_PASSWORD", func(d *dieservicebindingv1.EnvMappingDie) {
+						d.Key("password")
 					})
 				}).
 				DieReleasePtr(),
 			GivenObjects: []client.Object{
-				workload1,
-				workload2,
-				workload3,
-			},
-			WithReactors: []rtesting.ReactionFunc{
-				rtesting.InduceFailure("list", "DeploymentList", rtesting.InduceFailureOpts{
-					Error: apierrs.NewForbidden(schema.GroupResource{}, "", fmt.Errorf("test forbidden")),
-				}),
+				secret.
+					Data(map[string][]byte{
+						"type":     []byte("postgresql"),
+						"provider": []byte("example"),
+						"password": []byte("secret"),
+					}),
 			},
 			ExpectResource: serviceBinding.
 				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
-					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
-						d.APIVersion("apps/v1")
-						d.Kind("Deployment")
-						d.Name("my-workload-1")
+					d.Type("mysql")
+					d.Provider("bitnami")
+					d.EnvDie("BOUND_TYPE", func(d *dieservicebindingv1.EnvMappingDie) {
+						d.Key("type")
+					})
+					d.EnvDie("BOUND_PROVIDER", func(d *dieservicebindingv1.EnvMappingDie) {
+						d.Key("provider")
+					})
+					d.EnvDie("BOUND_PASSWORD", func(d *dieservicebindingv1.EnvMappingDie) {
+						d.Key("password")
 					})
 				}).
 				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
 					d.ConditionsDie(
-						dieservicebindingv1.ServiceBindingConditionReady.
-							False().
-							Reason("WorkloadForbidden").
-							Message("the controller does not have permission to get the workload"),
-						dieservicebindingv1.ServiceBindingConditionWorkloadProjected.
+						dieservicebindingv1.ServiceBindingConditionSecretValid.
+							True().
+							Reason("SecretValid"),
+						dieservicebindingv1.ServiceBindingConditionTypeResolved.
+							True().
+							Reason("TypeResolved"),
+					)
+					d.Type("mysql")
+					d.Provider("bitnami")
+				}).
+				DieReleasePtr(),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
+			},
+		},
+		"secret missing type": {
+			Resource: serviceBinding.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				secret.
+					Data(map[string][]byte{
+						"password": []byte("secret"),
+					}),
+			},
+			ExpectResource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1.ServiceBindingConditionSecretValid.
+							True().
+							Reason("SecretValid"),
+						dieservicebindingv1.ServiceBindingConditionTypeResolved.
 							False().
-							Reason("WorkloadForbidden").
-							Message("the controller does not have permission to get the workload"),
+							Reason("SecretMissingType").
+							Message(`the binding secret "my-secret" has no type entry and the binding does not set .spec.type`),
 					)
 				}).
 				DieReleasePtr(),
 			ExpectTracks: []rtesting.TrackRequest{
-				rtesting.NewTrackRequest(workload1, serviceBinding, scheme),
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
 			},
 		},
-		"resolve selected workload": {
+		"secret missing keys": {
 			Resource: serviceBinding.
 				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
-					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
-						d.APIVersion("apps/v1")
-						d.Kind("Deployment")
-						d.SelectorDie(func(d *diemetav1.LabelSelectorDie) {
-							d.AddMatchLabel("app", "my")
-						})
+					d.EnvDie("BOUND_USERNAME", func(d *dieservicebindingv1.EnvMappingDie) {
+						d.Key("username")
+					})
+					d.EnvDie("BOUND_PASSWORD", func(d *dieservicebindingv1.EnvMappingDie) {
+						d.Key("password")
+					})
+					d.EnvDie("PASSWORD", func(d *dieservicebindingv1.EnvMappingDie) {
+						d.Key("password")
 					})
 				}).
 				DieReleasePtr(),
 			GivenObjects: []client.Object{
-				workload1,
-				workload2,
-				workload3,
-			},
-			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
-				controllers.WorkloadsStashKey: []runtime.Object{
-					workload1.DieReleaseUnstructured(),
-					workload2.DieReleaseUnstructured(),
-				},
-			},
-			ExpectTracks: []rtesting.TrackRequest{
-				{
-					Tracker: types.NamespacedName{Namespace: serviceBinding.GetNamespace(), Name: serviceBinding.GetName()},
-					TrackedReference: tracker.Reference{
-						APIGroup:  "apps",
-						Kind:      "Deployment",
-						Namespace: serviceBinding.GetNamespace(),
-						Selector: labels.SelectorFromSet(labels.Set{
-							"app": "my",
-						}),
-					},
-				},
+				secret.
+					Data(map[string][]byte{
+						"username": []byte("admin"),
+					}),
 			},
-		},
-		"resolve selected workload not found": {
-			GivenObjects: []client.Object{},
-			Resource: serviceBinding.
+			ExpectResource: serviceBinding.
 				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
-					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
-						d.APIVersion("apps/v1")
-						d.Kind("Deployment")
-						d.SelectorDie(func(d *diemetav1.LabelSelectorDie) {
-							d.AddMatchLabel("app", "my")
-						})
+					d.EnvDie("BOUND_USERNAME", func(d *dieservicebindingv1.EnvMappingDie) {
+						d.Key("username")
 					})
+					d.EnvDie("BOUND_PASSWORD", func(d *dieservicebindingv1.EnvMappingDie) {
+						d.Key("password")
+					})
+					d.EnvDie("PASSWORD", func(d *dieservicebindingv1.EnvMappingDie) {
+						d.Key("password")
+					})
+				}).
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1.ServiceBindingConditionReady.
+							False().
+							Reason("SecretMissingKeys").
+							Message(`the binding secret "my-secret" is missing keys: password`),
+						dieservicebindingv1.ServiceBindingConditionSecretValid.
+							False().
+							Reason("SecretMissingKeys").
+							Message(`the binding secret "my-secret" is missing keys: password`),
+						dieservicebindingv1.ServiceBindingConditionTypeResolved.
+							False().
+							Reason("SecretMissingType").
+							Message(`the binding secret "my-secret" has no type entry and the binding does not set .spec.type`),
+					)
 				}).
 				DieReleasePtr(),
-			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
-				controllers.WorkloadsStashKey: []runtime.Object{},
-			},
 			ExpectTracks: []rtesting.TrackRequest{
-				{
-					Tracker: types.NamespacedName{Namespace: serviceBinding.GetNamespace(), Name: serviceBinding.GetName()},
-					TrackedReference: tracker.Reference{
-						APIGroup:  "apps",
-						Kind:      "Deployment",
-						Namespace: serviceBinding.GetNamespace(),
-						Selector: labels.SelectorFromSet(labels.Set{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
+			},
+		},
+		"secret not found": {
+			Resource: serviceBinding.DieReleasePtr(),
+			ExpectResource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1.ServiceBindingConditionReady.
+							False().
+							Reason("SecretNotFound").
+							Message(`the binding secret "my-secret" was not found`),
+						dieservicebindingv1.ServiceBindingConditionSecretValid.
+							False().
+							Reason("SecretNotFound").
+							Message(`the binding secret "my-secret" was not found`),
+					)
+				}).
+				DieReleasePtr(),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
+			},
+		},
+		"secret forbidden": {
+			Resource: serviceBinding.DieReleasePtr(),
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("get", "Secret", rtesting.InduceFailureOpts{
+					Error: apierrs.NewForbidden(schema.GroupResource{}, secretName, fmt.Errorf("test forbidden")),
+				}),
+			},
+			ExpectResource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1.ServiceBindingConditionReady.
+							False().
+							Reason("SecretForbidden").
+							Message("the controller does not have permission to get the binding secret"),
+						dieservicebindingv1.ServiceBindingConditionSecretValid.
+							False().
+							Reason("SecretForbidden").
+							Message("the controller does not have permission to get the binding secret"),
+					)
+				}).
+				DieReleasePtr(),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
+			},
+		},
+		"secret generic get error": {
+			Resource: serviceBinding.DieReleasePtr(),
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("get", "Secret"),
+			},
+			ShouldErr: true,
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, tc *rtesting.SubReconcilerTestCase[*servicebindingv1.ServiceBinding], c reconcilers.Config) reconcilers.SubReconciler[*servicebindingv1.ServiceBinding] {
+		return controllers.ValidateBindingSecret()
+	})
+}
+
+func TestResolveWorkload(t *testing.T) {
+	namespace := "test-namespace"
+	name := "my-binding"
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1.AddToScheme(scheme))
+
+	serviceBinding := dieservicebindingv1.ServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(name)
+		})
+
+	workload := dieappsv1.DeploymentBlank.
+		APIVersion("apps/v1").
+		Kind("Deployment")
+	workload1 := workload.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name("my-workload-1")
+			d.AddLabel("app", "my")
+		})
+	workload2 := workload.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name("my-workload-2")
+			d.AddLabel("app", "my")
+		})
+	workload3 := workload.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name("not-my-workload")
+			d.AddLabel("app", "not")
+		})
+	ownedWorkload := workload.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name("my-owned-workload")
+			d.AddLabel("app", "not")
+			d.OwnerReferences(metav1.OwnerReference{
+				APIVersion: "app.local/v1",
+				Kind:       "MyApp",
+				Name:       "my-app",
+				UID:        uuid.NewUUID(),
+				Controller: ptr.To(true),
+			})
+		})
+
+	rts := rtesting.SubReconcilerTests[*servicebindingv1.ServiceBinding]{
+		"resolve named workload": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name("my-workload-1")
+					})
+				}).
+				DieReleasePtr(),
+			GivenObjects: []client.Object{
+				workload1,
+				workload2,
+				workload3,
+			},
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(workload1, serviceBinding, scheme),
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{
+					workload1.DieReleaseUnstructured(),
+				},
+			},
+		},
+		"resolve named workload not found": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name("my-workload-1")
+					})
+				}).
+				DieReleasePtr(),
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name("my-workload-1")
+					})
+				}).
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1.ServiceBindingConditionReady.
+							Reason("WorkloadNotFound").Message("the workload was not found"),
+						dieservicebindingv1.ServiceBindingConditionWorkloadProjected.
+							Reason("WorkloadNotFound").Message("the workload was not found"),
+					)
+				}).
+				DieReleasePtr(),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(workload1, serviceBinding, scheme),
+			},
+		},
+		"resolve named workload forbidden": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name("my-workload-1")
+					})
+				}).
+				DieReleasePtr(),
+			GivenObjects: []client.Object{
+				workload1,
+				workload2,
+				workload3,
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("list", "DeploymentList", rtesting.InduceFailureOpts{
+					Error: apierrs.NewForbidden(schema.GroupResource{}, "", fmt.Errorf("test forbidden")),
+				}),
+			},
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name("my-workload-1")
+					})
+				}).
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1.ServiceBindingConditionReady.
+							False().
+							Reason("WorkloadForbidden").
+							Message("the controller does not have permission to get the workload"),
+						dieservicebindingv1.ServiceBindingConditionWorkloadProjected.
+							False().
+							Reason("WorkloadForbidden").
+							Message("the controller does not have permission to get the workload"),
+					)
+				}).
+				DieReleasePtr(),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(workload1, serviceBinding, scheme),
+			},
+		},
+		"resolve selected workload": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.SelectorDie(func(d *diemetav1.LabelSelectorDie) {
+							d.AddMatchLabel("app", "my")
+						})
+					})
+				}).
+				DieReleasePtr(),
+			GivenObjects: []client.Object{
+				workload1,
+				workload2,
+				workload3,
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{
+					workload1.DieReleaseUnstructured(),
+					workload2.DieReleaseUnstructured(),
+				},
+			},
+			ExpectTracks: []rtesting.TrackRequest{
+				{
+					Tracker: types.NamespacedName{Namespace: serviceBinding.GetNamespace(), Name: serviceBinding.GetName()},
+					TrackedReference: tracker.Reference{
+						APIGroup:  "apps",
+						Kind:      "Deployment",
+						Namespace: serviceBinding.GetNamespace(),
+						Selector: labels.SelectorFromSet(labels.Set{
+							"app": "my",
+						}),
+					},
+				},
+			},
+		},
+		"resolve selected workload not found": {
+			GivenObjects: []client.Object{},
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.SelectorDie(func(d *diemetav1.LabelSelectorDie) {
+							d.AddMatchLabel("app", "my")
+						})
+					})
+				}).
+				DieReleasePtr(),
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{},
+			},
+			ExpectTracks: []rtesting.TrackRequest{
+				{
+					Tracker: types.NamespacedName{Namespace: serviceBinding.GetNamespace(), Name: serviceBinding.GetName()},
+					TrackedReference: tracker.Reference{
+						APIGroup:  "apps",
+						Kind:      "Deployment",
+						Namespace: serviceBinding.GetNamespace(),
+						Selector: labels.SelectorFromSet(labels.Set{
 							"app": "my",
 						}),
 					},
@@ -854,15 +1304,88 @@ func TestResolveWorkload(t *testing.T) {
 				},
 			},
 		},
-	}
-
-	rts.Run(t, scheme, func(t *testing.T, tc *rtesting.SubReconcilerTestCase[*servicebindingv1.ServiceBinding], c reconcilers.Config) reconcilers.SubReconciler[*servicebindingv1.ServiceBinding] {
-		return controllers.ResolveWorkloads(lifecycle.ServiceBindingHooks{})
-	})
-}
-
-func TestProjectBinding(t *testing.T) {
-	namespace := "test-namespace"
+		"resolve owned workload": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.OwnerDie(func(d *dieservicebindingv1.ServiceBindingWorkloadOwnerReferenceDie) {
+							d.APIVersion("app.local/v1")
+							d.Kind("MyApp")
+							d.Name("my-app")
+						})
+					})
+				}).
+				DieReleasePtr(),
+			GivenObjects: []client.Object{
+				workload1,
+				ownedWorkload,
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{
+					ownedWorkload.DieReleaseUnstructured(),
+				},
+			},
+			ExpectTracks: []rtesting.TrackRequest{
+				{
+					Tracker: types.NamespacedName{Namespace: serviceBinding.GetNamespace(), Name: serviceBinding.GetName()},
+					TrackedReference: tracker.Reference{
+						APIGroup:  "apps",
+						Kind:      "Deployment",
+						Namespace: serviceBinding.GetNamespace(),
+						Selector:  labels.Everything(),
+					},
+				},
+			},
+		},
+		"resolve owned and selected workload": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.SelectorDie(func(d *diemetav1.LabelSelectorDie) {
+							d.AddMatchLabel("app", "my")
+						})
+						d.OwnerDie(func(d *dieservicebindingv1.ServiceBindingWorkloadOwnerReferenceDie) {
+							d.APIVersion("app.local/v1")
+							d.Kind("MyApp")
+							d.Name("my-app")
+						})
+					})
+				}).
+				DieReleasePtr(),
+			GivenObjects: []client.Object{
+				workload1,
+				ownedWorkload,
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{},
+			},
+			ExpectTracks: []rtesting.TrackRequest{
+				{
+					Tracker: types.NamespacedName{Namespace: serviceBinding.GetNamespace(), Name: serviceBinding.GetName()},
+					TrackedReference: tracker.Reference{
+						APIGroup:  "apps",
+						Kind:      "Deployment",
+						Namespace: serviceBinding.GetNamespace(),
+						Selector: labels.SelectorFromSet(labels.Set{
+							"app": "my",
+						}),
+					},
+				},
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, tc *rtesting.SubReconcilerTestCase[*servicebindingv1.ServiceBinding], c reconcilers.Config) reconcilers.SubReconciler[*servicebindingv1.ServiceBinding] {
+		return controllers.ResolveWorkloads(lifecycle.ServiceBindingHooks{})
+	})
+}
+
+func TestProjectBinding(t *testing.T) {
+	namespace := "test-namespace"
 	name := "my-binding"
 	uid := types.UID("dde10100-d7b3-4cba-9430-51d60a8612a6")
 	secretName := "my-secret"
@@ -970,6 +1493,24 @@ func TestProjectBinding(t *testing.T) {
 	unstructured.SetNestedSlice(containers[0].(map[string]interface{}), []interface{}{}, "volumeMounts")
 	unstructured.SetNestedSlice(unprojectedWorkload.UnstructuredContent(), containers, "spec", "template", "spec", "containers")
 	unstructured.SetNestedSlice(unprojectedWorkload.UnstructuredContent(), []interface{}{}, "spec", "template", "spec", "volumes")
+	orphanedWorkload := projectedWorkload.DieReleaseUnstructured()
+	orphanedWorkload.SetAnnotations(map[string]string{
+		fmt.Sprintf("projector.servicebinding.io/orphaned-%s", uid): podSpecableMapping,
+	})
+
+	podProjectedWorkload := workload.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.AddAnnotation(controllers.ProjectionAnnotationKey, controllers.ProjectionPod)
+		})
+	podProjectedUnprojectedWorkload := unprojectedWorkload.DeepCopy()
+	podProjectedUnprojectedWorkload.SetAnnotations(map[string]string{
+		controllers.ProjectionAnnotationKey: controllers.ProjectionPod,
+	})
+
+	workloadStatus := dieservicebindingv1.ServiceBindingWorkloadStatusBlank.
+		APIVersion("apps/v1").
+		Kind("Deployment").
+		Name(workload.GetName())
 
 	rts := rtesting.SubReconcilerTests[*servicebindingv1.ServiceBinding]{
 		"project workload": {
@@ -984,52 +1525,1048 @@ func TestProjectBinding(t *testing.T) {
 				DieReleasePtr(),
 			GivenStashedValues: map[reconcilers.StashKey]interface{}{
 				controllers.WorkloadsStashKey: []runtime.Object{
-					workload.DieReleaseUnstructured(),
+					workload.DieReleaseUnstructured(),
+				},
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ProjectedWorkloadsStashKey: []runtime.Object{
+					projectedWorkload.DieReleaseUnstructured(),
+				},
+			},
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name(workload.GetName())
+					})
+				}).
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.WorkloadsDie(
+						workloadStatus.Result(servicebindingv1.ServiceBindingWorkloadProjected),
+					)
+				}).
+				DieReleasePtr(),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(workloadMapping, serviceBinding, scheme),
+			},
+		},
+		"skip pod projected workload": {
+			Resource: serviceBinding.
+				DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{
+					podProjectedWorkload.DieReleaseUnstructured(),
+				},
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ProjectedWorkloadsStashKey: []runtime.Object{
+					podProjectedWorkload.DieReleaseUnstructured(),
+				},
+			},
+			ExpectResource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.WorkloadsDie(
+						workloadStatus.
+							Result(servicebindingv1.ServiceBindingWorkloadSkipped).
+							Reason("PodProjection").
+							Message("the binding is projected into the pods of the workload as they are created"),
+					)
+				}).
+				DieReleasePtr(),
+		},
+		"unproject workload opted into pod projection": {
+			Resource: serviceBinding.
+				DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{
+					projectedWorkload.
+						MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.AddAnnotation(controllers.ProjectionAnnotationKey, controllers.ProjectionPod)
+						}).
+						DieReleaseUnstructured(),
+				},
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ProjectedWorkloadsStashKey: []runtime.Object{
+					podProjectedUnprojectedWorkload,
+				},
+			},
+			ExpectResource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.WorkloadsDie(
+						workloadStatus.
+							Result(servicebindingv1.ServiceBindingWorkloadSkipped).
+							Reason("PodProjection").
+							Message("the binding is projected into the pods of the workload as they are created"),
+					)
+				}).
+				DieReleasePtr(),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(workloadMapping, serviceBinding, scheme),
+			},
+		},
+		"adopt manual projections": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name(workload.GetName())
+					})
+					d.AdoptManualProjections(true)
+				}).
+				DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{
+					workload.
+						SpecDie(func(d *dieappsv1.DeploymentSpecDie) {
+							d.TemplateDie(func(d *diecorev1.PodTemplateSpecDie) {
+								d.SpecDie(func(d *diecorev1.PodSpecDie) {
+									d.ContainerDie("my-container", func(d *diecorev1.ContainerDie) {
+										d.EnvDie("DB_PASSWORD", func(d *diecorev1.EnvVarDie) {
+											d.ValueFrom(&corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
+													Key: "password",
+												},
+											})
+										})
+									})
+								})
+							})
+						}).
+						DieReleaseUnstructured(),
+				},
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ProjectedWorkloadsStashKey: []runtime.Object{
+					projectedWorkload.
+						MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.AddAnnotation(fmt.Sprintf("projector.servicebinding.io/adopted-%s", uid), `{"env":[{"name":"DB_PASSWORD","key":"password"}]}`)
+						}).
+						SpecDie(func(d *dieappsv1.DeploymentSpecDie) {
+							d.TemplateDie(func(d *diecorev1.PodTemplateSpecDie) {
+								d.SpecDie(func(d *diecorev1.PodSpecDie) {
+									d.ContainerDie("my-container", func(d *diecorev1.ContainerDie) {
+										d.EnvDie("DB_PASSWORD", func(d *diecorev1.EnvVarDie) {
+											d.ValueFrom(&corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
+													Key: "password",
+												},
+											})
+										})
+									})
+								})
+							})
+						}).
+						DieReleaseUnstructured(),
+				},
+			},
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name(workload.GetName())
+					})
+					d.AdoptManualProjections(true)
+				}).
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.WorkloadsDie(
+						workloadStatus.
+							Result(servicebindingv1.ServiceBindingWorkloadProjected).
+							Reason("AdoptedManualProjections").
+							Message(`adopted manual projections of the binding secret: env "DB_PASSWORD"`),
+					)
+				}).
+				DieReleasePtr(),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(workloadMapping, serviceBinding, scheme),
+			},
+		},
+		"project workloads concurrently": {
+			Metadata: map[string]interface{}{
+				"WorkloadConcurrency": 2,
+			},
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name("")
+						d.SelectorDie(func(d *diemetav1.LabelSelectorDie) {
+							d.AddMatchLabel("app", "my")
+						})
+					})
+				}).
+				DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{
+					workload.MetadataDie(func(d *diemetav1.ObjectMetaDie) { d.Name("workload-1") }).DieReleaseUnstructured(),
+					workload.MetadataDie(func(d *diemetav1.ObjectMetaDie) { d.Name("workload-2") }).DieReleaseUnstructured(),
+					workload.MetadataDie(func(d *diemetav1.ObjectMetaDie) { d.Name("workload-3") }).DieReleaseUnstructured(),
+				},
+			},
+			// projected workloads and their status are in the order the workloads were resolved, independent of the
+			// order the projections completed
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ProjectedWorkloadsStashKey: []runtime.Object{
+					projectedWorkload.MetadataDie(func(d *diemetav1.ObjectMetaDie) { d.Name("workload-1") }).DieReleaseUnstructured(),
+					projectedWorkload.MetadataDie(func(d *diemetav1.ObjectMetaDie) { d.Name("workload-2") }).DieReleaseUnstructured(),
+					projectedWorkload.MetadataDie(func(d *diemetav1.ObjectMetaDie) { d.Name("workload-3") }).DieReleaseUnstructured(),
+				},
+			},
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name("")
+						d.SelectorDie(func(d *diemetav1.LabelSelectorDie) {
+							d.AddMatchLabel("app", "my")
+						})
+					})
+				}).
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.WorkloadsDie(
+						workloadStatus.Name("workload-1").Result(servicebindingv1.ServiceBindingWorkloadProjected),
+						workloadStatus.Name("workload-2").Result(servicebindingv1.ServiceBindingWorkloadProjected),
+						workloadStatus.Name("workload-3").Result(servicebindingv1.ServiceBindingWorkloadProjected),
+					)
+				}).
+				DieReleasePtr(),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(workloadMapping, serviceBinding, scheme),
+				rtesting.NewTrackRequest(workloadMapping, serviceBinding, scheme),
+				rtesting.NewTrackRequest(workloadMapping, serviceBinding, scheme),
+			},
+		},
+		"call workload hooks one at a time while projecting concurrently": {
+			Metadata: map[string]interface{}{
+				"WorkloadConcurrency": 2,
+				"WorkloadHooks":       true,
+			},
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name("")
+						d.SelectorDie(func(d *diemetav1.LabelSelectorDie) {
+							d.AddMatchLabel("app", "my")
+						})
+					})
+				}).
+				DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{
+					workload.MetadataDie(func(d *diemetav1.ObjectMetaDie) { d.Name("workload-1") }).DieReleaseUnstructured(),
+					workload.MetadataDie(func(d *diemetav1.ObjectMetaDie) { d.Name("workload-2") }).DieReleaseUnstructured(),
+					workload.MetadataDie(func(d *diemetav1.ObjectMetaDie) { d.Name("workload-3") }).DieReleaseUnstructured(),
+				},
+			},
+			// projected workloads and their status are in the order the workloads were resolved, independent of the
+			// order the projections completed
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ProjectedWorkloadsStashKey: []runtime.Object{
+					projectedWorkload.MetadataDie(func(d *diemetav1.ObjectMetaDie) { d.Name("workload-1") }).DieReleaseUnstructured(),
+					projectedWorkload.MetadataDie(func(d *diemetav1.ObjectMetaDie) { d.Name("workload-2") }).DieReleaseUnstructured(),
+					projectedWorkload.MetadataDie(func(d *diemetav1.ObjectMetaDie) { d.Name("workload-3") }).DieReleaseUnstructured(),
+				},
+			},
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name("")
+						d.SelectorDie(func(d *diemetav1.LabelSelectorDie) {
+							d.AddMatchLabel("app", "my")
+						})
+					})
+				}).
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.WorkloadsDie(
+						workloadStatus.Name("workload-1").Result(servicebindingv1.ServiceBindingWorkloadProjected),
+						workloadStatus.Name("workload-2").Result(servicebindingv1.ServiceBindingWorkloadProjected),
+						workloadStatus.Name("workload-3").Result(servicebindingv1.ServiceBindingWorkloadProjected),
+					)
+				}).
+				DieReleasePtr(),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(workloadMapping, serviceBinding, scheme),
+				rtesting.NewTrackRequest(workloadMapping, serviceBinding, scheme),
+				rtesting.NewTrackRequest(workloadMapping, serviceBinding, scheme),
+			},
+		},
+		"unproject terminating workload": {
+			Resource: serviceBinding.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.DeletionTimestamp(&now)
+					d.Finalizers("servicebinding.io/finalizer")
+				}).
+				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name(workload.GetName())
+					})
+				}).
+				DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{
+					projectedWorkload.DieReleaseUnstructured(),
+				},
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ProjectedWorkloadsStashKey: []runtime.Object{
+					unprojectedWorkload,
+				},
+			},
+			ExpectResource: serviceBinding.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.DeletionTimestamp(&now)
+					d.Finalizers("servicebinding.io/finalizer")
+				}).
+				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name(workload.GetName())
+					})
+				}).
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.WorkloadsDie(
+						workloadStatus.Result(servicebindingv1.ServiceBindingWorkloadUnprojected),
+					)
+				}).
+				DieReleasePtr(),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(workloadMapping, serviceBinding, scheme),
+			},
+		},
+		"orphan terminating workload": {
+			Resource: serviceBinding.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.DeletionTimestamp(&now)
+					d.Finalizers("servicebinding.io/finalizer")
+				}).
+				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name(workload.GetName())
+					})
+					d.DeletionPolicy(servicebindingv1.ServiceBindingDeletionPolicyOrphan)
+				}).
+				DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{
+					projectedWorkload.DieReleaseUnstructured(),
+				},
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ProjectedWorkloadsStashKey: []runtime.Object{
+					orphanedWorkload,
+				},
+			},
+			ExpectResource: serviceBinding.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.DeletionTimestamp(&now)
+					d.Finalizers("servicebinding.io/finalizer")
+				}).
+				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name(workload.GetName())
+					})
+					d.DeletionPolicy(servicebindingv1.ServiceBindingDeletionPolicyOrphan)
+				}).
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.WorkloadsDie(
+						workloadStatus.Result(servicebindingv1.ServiceBindingWorkloadUnprojected),
+					)
+				}).
+				DieReleasePtr(),
+		},
+		"unproject terminating workload when the projector cannot orphan": {
+			Metadata: map[string]interface{}{
+				"ProjectorWithoutOrphan": true,
+			},
+			Resource: serviceBinding.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.DeletionTimestamp(&now)
+					d.Finalizers("servicebinding.io/finalizer")
+				}).
+				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name(workload.GetName())
+					})
+					d.DeletionPolicy(servicebindingv1.ServiceBindingDeletionPolicyOrphan)
+				}).
+				DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{
+					projectedWorkload.DieReleaseUnstructured(),
+				},
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ProjectedWorkloadsStashKey: []runtime.Object{
+					unprojectedWorkload,
+				},
+			},
+			ExpectResource: serviceBinding.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.DeletionTimestamp(&now)
+					d.Finalizers("servicebinding.io/finalizer")
+				}).
+				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name(workload.GetName())
+					})
+					d.DeletionPolicy(servicebindingv1.ServiceBindingDeletionPolicyOrphan)
+				}).
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.WorkloadsDie(
+						workloadStatus.Result(servicebindingv1.ServiceBindingWorkloadUnprojected),
+					)
+				}).
+				DieReleasePtr(),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(workloadMapping, serviceBinding, scheme),
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, tc *rtesting.SubReconcilerTestCase[*servicebindingv1.ServiceBinding], c reconcilers.Config) reconcilers.SubReconciler[*servicebindingv1.ServiceBinding] {
+		restMapper := c.RESTMapper().(*meta.DefaultRESTMapper)
+		restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+		hooks := lifecycle.ServiceBindingHooks{}
+		if concurrency, ok := tc.Metadata["WorkloadConcurrency"].(int); ok {
+			hooks.WorkloadConcurrency = concurrency
+		}
+		if _, ok := tc.Metadata["ProjectorWithoutOrphan"]; ok {
+			// a custom projector that does not implement projector.Orphaner
+			hooks.ProjectorFactory = func(ms projector.MappingSource) projector.ServiceBindingProjector {
+				return struct {
+					projector.ServiceBindingProjector
+				}{projector.New(ms)}
+			}
+		}
+		if _, ok := tc.Metadata["WorkloadHooks"]; ok {
+			// the hooks write to the stash, which is not safe for concurrent use
+			inFlight := atomic.Int32{}
+			hook := func(ctx context.Context, workload runtime.Object) error {
+				if inFlight.Add(1) > 1 {
+					t.Errorf("workload hooks called concurrently")
+				}
+				defer inFlight.Add(-1)
+				reconcilers.StashValue(ctx, "test:workload", workload)
+				time.Sleep(10 * time.Millisecond)
+				return nil
+			}
+			hooks.WorkloadPreProjection = hook
+			hooks.WorkloadPostProjection = hook
+		}
+		return controllers.ProjectBinding(hooks)
+	})
+}
+
+func TestSuspendBinding(t *testing.T) {
+	namespace := "test-namespace"
+	name := "my-binding"
+	uid := types.UID("dde10100-d7b3-4cba-9430-51d60a8612a6")
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1.AddToScheme(scheme))
+
+	serviceBinding := dieservicebindingv1.ServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(name)
+			d.UID(uid)
+		}).
+		SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+			d.Suspend(true)
+		}).
+		StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+			d.ConditionsDie(
+				dieservicebindingv1.ServiceBindingConditionReady,
+				dieservicebindingv1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+				dieservicebindingv1.ServiceBindingConditionWorkloadProjected,
+			)
+		})
+
+	workload := dieappsv1.DeploymentBlank.
+		DieStamp(func(r *appsv1.Deployment) {
+			r.APIVersion = "apps/v1"
+			r.Kind = "Deployment"
+		}).
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name("my-workload")
+			d.UID(uuid.NewUUID())
+		})
+	projectedWorkload := workload.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("projected-workload")
+			d.UID(uuid.NewUUID())
+			d.AddAnnotation(fmt.Sprintf("projector.servicebinding.io/mapping-%s", uid), "{}")
+		})
+
+	workloadStatus := dieservicebindingv1.ServiceBindingWorkloadStatusBlank.
+		APIVersion("apps/v1").
+		Kind("Deployment")
+
+	rts := rtesting.SubReconcilerTests[*servicebindingv1.ServiceBinding]{
+		"record workloads without projecting": {
+			Resource: serviceBinding.DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{
+					projectedWorkload.DieReleaseUnstructured(),
+					workload.DieReleaseUnstructured(),
+				},
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{
+					projectedWorkload.DieReleaseUnstructured(),
+					workload.DieReleaseUnstructured(),
+				},
+				controllers.ProjectedWorkloadsStashKey: []runtime.Object{
+					projectedWorkload.DieReleaseUnstructured(),
+					workload.DieReleaseUnstructured(),
+				},
+			},
+			ExpectResource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1.ServiceBindingConditionReady.
+							Reason("Suspended").
+							Message("the binding is suspended, changes are not projected into the workloads"),
+						dieservicebindingv1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+						dieservicebindingv1.ServiceBindingConditionSuspended.True().Reason("Suspended"),
+						dieservicebindingv1.ServiceBindingConditionWorkloadProjected.
+							Reason("Suspended").
+							Message("the binding is suspended, changes are not projected into the workloads"),
+					)
+					d.WorkloadsDie(
+						workloadStatus.
+							Name(workload.GetName()).
+							UID(workload.GetUID()).
+							Result(servicebindingv1.ServiceBindingWorkloadUnprojected),
+						workloadStatus.
+							Name(projectedWorkload.GetName()).
+							UID(projectedWorkload.GetUID()).
+							Result(servicebindingv1.ServiceBindingWorkloadProjected),
+					)
+					d.BoundWorkloads(1)
+				}).
+				DieReleasePtr(),
+		},
+		"clear rollout progress": {
+			Resource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.RolloutDie(func(d *dieservicebindingv1.ServiceBindingRolloutStatusDie) {
+						d.UpdatedWorkloads(1)
+						d.PendingWorkloads(1)
+					})
+				}).
+				DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{},
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey:          []runtime.Object{},
+				controllers.ProjectedWorkloadsStashKey: []runtime.Object{},
+			},
+			ExpectResource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1.ServiceBindingConditionReady.
+							Reason("Suspended").
+							Message("the binding is suspended, changes are not projected into the workloads"),
+						dieservicebindingv1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+						dieservicebindingv1.ServiceBindingConditionSuspended.True().Reason("Suspended"),
+						dieservicebindingv1.ServiceBindingConditionWorkloadProjected.
+							Reason("Suspended").
+							Message("the binding is suspended, changes are not projected into the workloads"),
+					)
+				}).
+				DieReleasePtr(),
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, tc *rtesting.SubReconcilerTestCase[*servicebindingv1.ServiceBinding], c reconcilers.Config) reconcilers.SubReconciler[*servicebindingv1.ServiceBinding] {
+		return controllers.SuspendBinding(lifecycle.ServiceBindingHooks{})
+	})
+}
+
+func TestRolloutWorkloads(t *testing.T) {
+	namespace := "test-namespace"
+	name := "my-binding"
+
+	now := metav1.Now().Rfc3339Copy()
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1.AddToScheme(scheme))
+
+	serviceBinding := dieservicebindingv1.ServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(name)
+		}).
+		SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+			d.RolloutDie(func(d *dieservicebindingv1.ServiceBindingRolloutDie) {
+				d.MaxUnavailable(ptr.To(intstr.FromInt32(1)))
+			})
+		}).
+		StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+			d.ConditionsDie(
+				dieservicebindingv1.ServiceBindingConditionReady,
+				dieservicebindingv1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+				dieservicebindingv1.ServiceBindingConditionWorkloadProjected,
+			)
+		})
+
+	workload := dieappsv1.DeploymentBlank.
+		DieStamp(func(r *appsv1.Deployment) {
+			r.APIVersion = "apps/v1"
+			r.Kind = "Deployment"
+		}).
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Generation(1)
+		}).
+		SpecDie(func(d *dieappsv1.DeploymentSpecDie) {
+			d.Replicas(ptr.To(int32(1)))
+		})
+	rolledOut := func(d *dieappsv1.DeploymentStatusDie) {
+		d.ObservedGeneration(1)
+		d.Replicas(1)
+		d.UpdatedReplicas(1)
+		d.AvailableReplicas(1)
+	}
+	rollingOut := func(d *dieappsv1.DeploymentStatusDie) {
+		d.ObservedGeneration(1)
+		d.Replicas(2)
+		d.UpdatedReplicas(1)
+		d.AvailableReplicas(1)
+	}
+	workload1 := workload.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("workload-1")
+			d.UID("b5b0d1a4-1f0e-4c1a-8c57-0d4c3b1f2a01")
+		})
+	workload2 := workload.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("workload-2")
+			d.UID("b5b0d1a4-1f0e-4c1a-8c57-0d4c3b1f2a02")
+		})
+	workload3 := workload.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("workload-3")
+			d.UID("b5b0d1a4-1f0e-4c1a-8c57-0d4c3b1f2a03")
+		})
+	// not something a binding would ever project, but good enough for a test
+	project := func(d *dieappsv1.DeploymentSpecDie) {
+		d.Paused(true)
+	}
+
+	workloadStatus := dieservicebindingv1.ServiceBindingWorkloadStatusBlank.
+		APIVersion("apps/v1").
+		Kind("Deployment")
+	pendingStatus := func(workload client.Object) *dieservicebindingv1.ServiceBindingWorkloadStatusDie {
+		return workloadStatus.
+			Name(workload.GetName()).
+			UID(workload.GetUID()).
+			ObservedGeneration(1).
+			Result(servicebindingv1.ServiceBindingWorkloadPending).
+			Reason("RolloutPending").
+			Message("waiting for updated workloads to complete their rollout")
+	}
+
+	rts := rtesting.SubReconcilerTests[*servicebindingv1.ServiceBinding]{
+		"no rollout strategy": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+					d.Rollout(nil)
+				}).
+				DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{
+					workload1.DieReleaseUnstructured(),
+					workload2.DieReleaseUnstructured(),
+				},
+				controllers.ProjectedWorkloadsStashKey: []runtime.Object{
+					workload1.SpecDie(project).DieReleaseUnstructured(),
+					workload2.SpecDie(project).DieReleaseUnstructured(),
+				},
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ProjectedWorkloadsStashKey: []runtime.Object{
+					workload1.SpecDie(project).DieReleaseUnstructured(),
+					workload2.SpecDie(project).DieReleaseUnstructured(),
+				},
+			},
+		},
+		"hold back workloads beyond max unavailable": {
+			Resource: serviceBinding.DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{
+					workload1.StatusDie(rolledOut).DieReleaseUnstructured(),
+					workload2.StatusDie(rolledOut).DieReleaseUnstructured(),
+					workload3.StatusDie(rolledOut).DieReleaseUnstructured(),
+				},
+				controllers.ProjectedWorkloadsStashKey: []runtime.Object{
+					workload1.StatusDie(rolledOut).SpecDie(project).DieReleaseUnstructured(),
+					workload2.StatusDie(rolledOut).SpecDie(project).DieReleaseUnstructured(),
+					workload3.StatusDie(rolledOut).SpecDie(project).DieReleaseUnstructured(),
+				},
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ProjectedWorkloadsStashKey: []runtime.Object{
+					workload1.StatusDie(rolledOut).SpecDie(project).DieReleaseUnstructured(),
+					workload2.StatusDie(rolledOut).DieReleaseUnstructured(),
+					workload3.StatusDie(rolledOut).DieReleaseUnstructured(),
+				},
+			},
+			ExpectResource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1.ServiceBindingConditionReady.
+							Reason("RolloutInProgress").
+							Message("rolled out to 1 of 3 workloads"),
+						dieservicebindingv1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+						dieservicebindingv1.ServiceBindingConditionWorkloadProjected.
+							Reason("RolloutInProgress").
+							Message("rolled out to 1 of 3 workloads"),
+					)
+					d.WorkloadsDie(
+						pendingStatus(workload2),
+						pendingStatus(workload3),
+					)
+					d.RolloutDie(func(d *dieservicebindingv1.ServiceBindingRolloutStatusDie) {
+						d.UpdatedWorkloads(1)
+						d.PendingWorkloads(2)
+					})
+				}).
+				DieReleasePtr(),
+		},
+		"wait for updated workloads to roll out": {
+			Resource: serviceBinding.DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{
+					workload1.SpecDie(project).StatusDie(rollingOut).DieReleaseUnstructured(),
+					workload2.StatusDie(rolledOut).DieReleaseUnstructured(),
+				},
+				controllers.ProjectedWorkloadsStashKey: []runtime.Object{
+					workload1.SpecDie(project).StatusDie(rollingOut).DieReleaseUnstructured(),
+					workload2.StatusDie(rolledOut).SpecDie(project).DieReleaseUnstructured(),
+				},
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ProjectedWorkloadsStashKey: []runtime.Object{
+					workload1.SpecDie(project).StatusDie(rollingOut).DieReleaseUnstructured(),
+					workload2.StatusDie(rolledOut).DieReleaseUnstructured(),
+				},
+			},
+			ExpectResource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1.ServiceBindingConditionReady.
+							Reason("RolloutInProgress").
+							Message("rolled out to 1 of 2 workloads"),
+						dieservicebindingv1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+						dieservicebindingv1.ServiceBindingConditionWorkloadProjected.
+							Reason("RolloutInProgress").
+							Message("rolled out to 1 of 2 workloads"),
+					)
+					d.WorkloadsDie(
+						pendingStatus(workload2),
+					)
+					d.RolloutDie(func(d *dieservicebindingv1.ServiceBindingRolloutStatusDie) {
+						d.UpdatedWorkloads(1)
+						d.PendingWorkloads(1)
+					})
+				}).
+				DieReleasePtr(),
+		},
+		"continue once updated workloads are rolled out": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+					d.RolloutDie(func(d *dieservicebindingv1.ServiceBindingRolloutDie) {
+						d.MaxUnavailable(ptr.To(intstr.FromString("50%")))
+					})
+				}).
+				DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{
+					workload1.SpecDie(project).StatusDie(rolledOut).DieReleaseUnstructured(),
+					workload2.StatusDie(rolledOut).DieReleaseUnstructured(),
+					workload3.StatusDie(rolledOut).DieReleaseUnstructured(),
+				},
+				controllers.ProjectedWorkloadsStashKey: []runtime.Object{
+					workload1.SpecDie(project).StatusDie(rolledOut).DieReleaseUnstructured(),
+					workload2.StatusDie(rolledOut).SpecDie(project).DieReleaseUnstructured(),
+					workload3.StatusDie(rolledOut).SpecDie(project).DieReleaseUnstructured(),
+				},
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ProjectedWorkloadsStashKey: []runtime.Object{
+					workload1.SpecDie(project).StatusDie(rolledOut).DieReleaseUnstructured(),
+					workload2.StatusDie(rolledOut).SpecDie(project).DieReleaseUnstructured(),
+					workload3.StatusDie(rolledOut).SpecDie(project).DieReleaseUnstructured(),
+				},
+			},
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+					d.RolloutDie(func(d *dieservicebindingv1.ServiceBindingRolloutDie) {
+						d.MaxUnavailable(ptr.To(intstr.FromString("50%")))
+					})
+				}).
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.RolloutDie(func(d *dieservicebindingv1.ServiceBindingRolloutStatusDie) {
+						d.UpdatedWorkloads(3)
+						d.ReadyWorkloads(1)
+					})
+				}).
+				DieReleasePtr(),
+		},
+		"unproject terminating binding at once": {
+			Resource: serviceBinding.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.DeletionTimestamp(&now)
+					d.Finalizers("servicebinding.io/finalizer")
+				}).
+				DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{
+					workload1.SpecDie(project).StatusDie(rolledOut).DieReleaseUnstructured(),
+					workload2.SpecDie(project).StatusDie(rolledOut).DieReleaseUnstructured(),
+				},
+				controllers.ProjectedWorkloadsStashKey: []runtime.Object{
+					workload1.StatusDie(rolledOut).DieReleaseUnstructured(),
+					workload2.StatusDie(rolledOut).DieReleaseUnstructured(),
+				},
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ProjectedWorkloadsStashKey: []runtime.Object{
+					workload1.StatusDie(rolledOut).DieReleaseUnstructured(),
+					workload2.StatusDie(rolledOut).DieReleaseUnstructured(),
+				},
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, tc *rtesting.SubReconcilerTestCase[*servicebindingv1.ServiceBinding], c reconcilers.Config) reconcilers.SubReconciler[*servicebindingv1.ServiceBinding] {
+		return controllers.RolloutWorkloads()
+	})
+}
+
+func TestReflectWorkloadReadiness(t *testing.T) {
+	namespace := "test-namespace"
+	name := "my-binding"
+	uid := types.UID("dde10100-d7b3-4cba-9430-51d60a8612a6")
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1.AddToScheme(scheme))
+
+	serviceBinding := dieservicebindingv1.ServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(name)
+			d.UID(uid)
+		}).
+		SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+			d.WaitForWorkloads(true)
+		}).
+		StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+			d.ConditionsDie(
+				dieservicebindingv1.ServiceBindingConditionReady,
+				dieservicebindingv1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+				dieservicebindingv1.ServiceBindingConditionWorkloadProjected.True().Reason("WorkloadProjected"),
+			)
+		})
+
+	workload := dieappsv1.DeploymentBlank.
+		DieStamp(func(r *appsv1.Deployment) {
+			r.APIVersion = "apps/v1"
+			r.Kind = "Deployment"
+		}).
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name("my-workload")
+			d.Generation(1)
+			d.AddAnnotation(fmt.Sprintf("projector.servicebinding.io/mapping-%s", uid), "{}")
+		}).
+		SpecDie(func(d *dieappsv1.DeploymentSpecDie) {
+			d.Replicas(ptr.To(int32(1)))
+		})
+	rolledOut := func(d *dieappsv1.DeploymentStatusDie) {
+		d.ObservedGeneration(1)
+		d.Replicas(1)
+		d.UpdatedReplicas(1)
+		d.AvailableReplicas(1)
+	}
+	rollingOut := func(d *dieappsv1.DeploymentStatusDie) {
+		d.ObservedGeneration(1)
+		d.Replicas(1)
+		d.UpdatedReplicas(1)
+	}
+
+	rts := rtesting.SubReconcilerTests[*servicebindingv1.ServiceBinding]{
+		"remove the condition when not waiting for workloads": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+					d.WaitForWorkloads(false)
+				}).
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1.ServiceBindingConditionReady.True().Reason("ServiceBound"),
+						dieservicebindingv1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+						dieservicebindingv1.ServiceBindingConditionWorkloadProjected.True().Reason("WorkloadProjected"),
+						dieservicebindingv1.ServiceBindingConditionWorkloadReady,
+					)
+				}).
+				DieReleasePtr(),
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+					d.WaitForWorkloads(false)
+				}).
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1.ServiceBindingConditionReady.True().Reason("ServiceBound"),
+						dieservicebindingv1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+						dieservicebindingv1.ServiceBindingConditionWorkloadProjected.True().Reason("WorkloadProjected"),
+					)
+				}).
+				DieReleasePtr(),
+		},
+		"workloads ready": {
+			Resource: serviceBinding.DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{
+					workload.StatusDie(rolledOut).DieReleaseUnstructured(),
 				},
-			},
-			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
 				controllers.ProjectedWorkloadsStashKey: []runtime.Object{
-					projectedWorkload.DieReleaseUnstructured(),
+					workload.StatusDie(rolledOut).DieReleaseUnstructured(),
 				},
 			},
-			ExpectTracks: []rtesting.TrackRequest{
-				rtesting.NewTrackRequest(workloadMapping, serviceBinding, scheme),
-			},
-		},
-		"unproject terminating workload": {
-			Resource: serviceBinding.
-				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
-					d.DeletionTimestamp(&now)
-					d.Finalizers("servicebinding.io/finalizer")
-				}).
-				SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
-					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
-						d.APIVersion("apps/v1")
-						d.Kind("Deployment")
-						d.Name(workload.GetName())
-					})
+			ExpectResource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1.ServiceBindingConditionReady.True().Reason("ServiceBound"),
+						dieservicebindingv1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+						dieservicebindingv1.ServiceBindingConditionWorkloadProjected.True().Reason("WorkloadProjected"),
+						dieservicebindingv1.ServiceBindingConditionWorkloadReady.True().Reason("WorkloadsReady"),
+					)
 				}).
 				DieReleasePtr(),
+		},
+		"workloads rolling out": {
+			Resource: serviceBinding.DieReleasePtr(),
 			GivenStashedValues: map[reconcilers.StashKey]interface{}{
 				controllers.WorkloadsStashKey: []runtime.Object{
-					projectedWorkload.DieReleaseUnstructured(),
+					workload.StatusDie(rollingOut).DieReleaseUnstructured(),
+				},
+				controllers.ProjectedWorkloadsStashKey: []runtime.Object{
+					workload.StatusDie(rollingOut).DieReleaseUnstructured(),
 				},
 			},
-			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+			ExpectResource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1.ServiceBindingConditionReady.
+							Reason("WorkloadsNotReady").
+							Message("0 of 1 bound workloads are ready"),
+						dieservicebindingv1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+						dieservicebindingv1.ServiceBindingConditionWorkloadProjected.True().Reason("WorkloadProjected"),
+						dieservicebindingv1.ServiceBindingConditionWorkloadReady.
+							Reason("WorkloadsNotReady").
+							Message("0 of 1 bound workloads are ready"),
+					)
+				}).
+				DieReleasePtr(),
+		},
+		"workloads updated by this reconcile are not ready": {
+			Resource: serviceBinding.DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{
+					workload.StatusDie(rolledOut).DieReleaseUnstructured(),
+				},
 				controllers.ProjectedWorkloadsStashKey: []runtime.Object{
-					unprojectedWorkload,
+					workload.
+						StatusDie(rolledOut).
+						SpecDie(func(d *dieappsv1.DeploymentSpecDie) {
+							// not something a binding would ever project, but good enough for a test
+							d.Paused(true)
+						}).
+						DieReleaseUnstructured(),
 				},
 			},
-			ExpectTracks: []rtesting.TrackRequest{
-				rtesting.NewTrackRequest(workloadMapping, serviceBinding, scheme),
+			ExpectResource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1.ServiceBindingConditionReady.
+							Reason("WorkloadsNotReady").
+							Message("0 of 1 bound workloads are ready"),
+						dieservicebindingv1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+						dieservicebindingv1.ServiceBindingConditionWorkloadProjected.True().Reason("WorkloadProjected"),
+						dieservicebindingv1.ServiceBindingConditionWorkloadReady.
+							Reason("WorkloadsNotReady").
+							Message("0 of 1 bound workloads are ready"),
+					)
+				}).
+				DieReleasePtr(),
+		},
+		"ignore workloads the binding is not projected into": {
+			Resource: serviceBinding.DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{
+					workload.
+						MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.Annotations(nil)
+						}).
+						StatusDie(rollingOut).
+						DieReleaseUnstructured(),
+				},
+				controllers.ProjectedWorkloadsStashKey: []runtime.Object{
+					workload.
+						MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.Annotations(nil)
+						}).
+						StatusDie(rollingOut).
+						DieReleaseUnstructured(),
+				},
 			},
+			ExpectResource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1.ServiceBindingConditionReady.True().Reason("ServiceBound"),
+						dieservicebindingv1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+						dieservicebindingv1.ServiceBindingConditionWorkloadProjected.True().Reason("WorkloadProjected"),
+						dieservicebindingv1.ServiceBindingConditionWorkloadReady.True().Reason("WorkloadsReady"),
+					)
+				}).
+				DieReleasePtr(),
 		},
 	}
 
 	rts.Run(t, scheme, func(t *testing.T, tc *rtesting.SubReconcilerTestCase[*servicebindingv1.ServiceBinding], c reconcilers.Config) reconcilers.SubReconciler[*servicebindingv1.ServiceBinding] {
-		restMapper := c.RESTMapper().(*meta.DefaultRESTMapper)
-		restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
-		return controllers.ProjectBinding(lifecycle.ServiceBindingHooks{})
+		return controllers.ReflectWorkloadReadiness(lifecycle.ServiceBindingHooks{})
 	})
 }
 
@@ -1077,6 +2614,18 @@ func TestPatchWorkloads(t *testing.T) {
 			})
 		})
 
+	otherWorkload := workload.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("other-workload")
+			d.UID("8b0d6b8e-4f3a-4c59-9d0e-2f1a7c3e5b94")
+		})
+
+	workloadStatus := dieservicebindingv1.ServiceBindingWorkloadStatusBlank.
+		APIVersion("apps/v1").
+		Kind("Deployment").
+		Name("my-workload").
+		UID(uid)
+
 	rts := rtesting.SubReconcilerTests[*servicebindingv1.ServiceBinding]{
 		"in sync": {
 			Resource: serviceBinding.
@@ -1100,6 +2649,53 @@ func TestPatchWorkloads(t *testing.T) {
 				},
 			},
 		},
+		"in sync concurrently": {
+			Metadata: map[string]interface{}{
+				"WorkloadConcurrency": 2,
+			},
+			Resource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1.ServiceBindingConditionReady.True().Reason("ServiceBound"),
+						dieservicebindingv1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+						dieservicebindingv1.ServiceBindingConditionWorkloadProjected.True().Reason("WorkloadProjected"),
+					)
+					d.WorkloadsDie(
+						workloadStatus.Result(servicebindingv1.ServiceBindingWorkloadProjected),
+						workloadStatus.Name(otherWorkload.GetName()).UID(otherWorkload.GetUID()).Result(servicebindingv1.ServiceBindingWorkloadProjected),
+					)
+				}).
+				DieReleasePtr(),
+			GivenObjects: []client.Object{
+				workload.MetadataDie(func(d *diemetav1.ObjectMetaDie) { d.Generation(2) }),
+				otherWorkload.MetadataDie(func(d *diemetav1.ObjectMetaDie) { d.Generation(3) }),
+			},
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{
+					workload.MetadataDie(func(d *diemetav1.ObjectMetaDie) { d.Generation(2) }).DieReleaseUnstructured(),
+					otherWorkload.MetadataDie(func(d *diemetav1.ObjectMetaDie) { d.Generation(3) }).DieReleaseUnstructured(),
+				},
+				controllers.ProjectedWorkloadsStashKey: []runtime.Object{
+					workload.MetadataDie(func(d *diemetav1.ObjectMetaDie) { d.Generation(2) }).DieReleaseUnstructured(),
+					otherWorkload.MetadataDie(func(d *diemetav1.ObjectMetaDie) { d.Generation(3) }).DieReleaseUnstructured(),
+				},
+			},
+			ExpectResource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1.ServiceBindingConditionReady.True().Reason("ServiceBound"),
+						dieservicebindingv1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+						dieservicebindingv1.ServiceBindingConditionWorkloadProjected.True().Reason("WorkloadProjected"),
+					)
+					// each workload's status is matched to the outcome of its own update
+					d.WorkloadsDie(
+						workloadStatus.ObservedGeneration(2).Result(servicebindingv1.ServiceBindingWorkloadProjected),
+						workloadStatus.Name(otherWorkload.GetName()).UID(otherWorkload.GetUID()).ObservedGeneration(3).Result(servicebindingv1.ServiceBindingWorkloadProjected),
+					)
+					d.BoundWorkloads(2)
+				}).
+				DieReleasePtr(),
+		},
 		"update workload": {
 			Resource: serviceBinding.DieReleasePtr(),
 			GivenObjects: []client.Object{
@@ -1160,6 +2756,12 @@ func TestPatchWorkloads(t *testing.T) {
 						dieservicebindingv1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
 						dieservicebindingv1.ServiceBindingConditionWorkloadProjected.True().Reason("WorkloadProjected"),
 					)
+					d.WorkloadsDie(
+						workloadStatus.
+							Result(servicebindingv1.ServiceBindingWorkloadSkipped).
+							Reason("WorkloadNotFound").
+							Message("the workload was deleted"),
+					)
 				}).
 				DieReleasePtr(),
 			ExpectEvents: []rtesting.Event{
@@ -1211,6 +2813,12 @@ func TestPatchWorkloads(t *testing.T) {
 							Reason("WorkloadForbidden").
 							Message("the controller does not have permission to update the workloads"),
 					)
+					d.WorkloadsDie(
+						workloadStatus.
+							Result(servicebindingv1.ServiceBindingWorkloadFailed).
+							Reason("WorkloadForbidden").
+							Message("the controller does not have permission to update the workload"),
+					)
 				}).
 				DieReleasePtr(),
 			ExpectEvents: []rtesting.Event{
@@ -1224,6 +2832,173 @@ func TestPatchWorkloads(t *testing.T) {
 					}).DieReleaseUnstructured(),
 			},
 		},
+		"update workload failed": {
+			Resource: serviceBinding.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				workload,
+			},
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{
+					workload.DieReleaseUnstructured(),
+				},
+				controllers.ProjectedWorkloadsStashKey: []runtime.Object{
+					workload.
+						SpecDie(func(d *dieappsv1.DeploymentSpecDie) {
+							// not something a binding would ever project, but good enough for a test
+							d.Paused(true)
+						}).
+						DieReleaseUnstructured(),
+				},
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("update", "Deployment", rtesting.InduceFailureOpts{
+					Error: apierrs.NewBadRequest("admission webhook denied the request"),
+				}),
+			},
+			ExpectResource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1.ServiceBindingConditionReady.
+							False().
+							Reason("WorkloadUpdateFailed").
+							Message("failed to update 1 of 1 workloads, see .status.workloads for details"),
+						dieservicebindingv1.ServiceBindingConditionServiceAvailable.
+							True().
+							Reason("ResolvedBindingSecret"),
+						dieservicebindingv1.ServiceBindingConditionWorkloadProjected.
+							False().
+							Reason("WorkloadUpdateFailed").
+							Message("failed to update 1 of 1 workloads, see .status.workloads for details"),
+					)
+					d.WorkloadsDie(
+						workloadStatus.
+							Result(servicebindingv1.ServiceBindingWorkloadFailed).
+							Reason("WorkloadUpdateFailed").
+							Message("admission webhook denied the request"),
+					)
+				}).
+				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(serviceBinding, scheme, corev1.EventTypeWarning, "UpdateFailed", "Failed to update Deployment %q: admission webhook denied the request", "my-workload"),
+			},
+			ExpectUpdates: []client.Object{
+				workload.
+					SpecDie(func(d *dieappsv1.DeploymentSpecDie) {
+						// not something a binding would ever project, but good enough for a test
+						d.Paused(true)
+					}).DieReleaseUnstructured(),
+			},
+			ExpectedResult: reconcile.Result{RequeueAfter: time.Second},
+		},
+		"update remaining workloads after a failure": {
+			Resource: serviceBinding.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				workload,
+				otherWorkload,
+			},
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{
+					workload.DieReleaseUnstructured(),
+					otherWorkload.DieReleaseUnstructured(),
+				},
+				controllers.ProjectedWorkloadsStashKey: []runtime.Object{
+					workload.
+						SpecDie(func(d *dieappsv1.DeploymentSpecDie) {
+							// not something a binding would ever project, but good enough for a test
+							d.Paused(true)
+						}).
+						DieReleaseUnstructured(),
+					otherWorkload.
+						SpecDie(func(d *dieappsv1.DeploymentSpecDie) {
+							// not something a binding would ever project, but good enough for a test
+							d.Paused(true)
+						}).
+						DieReleaseUnstructured(),
+				},
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("update", "Deployment", rtesting.InduceFailureOpts{
+					Name:  "my-workload",
+					Error: apierrs.NewBadRequest("admission webhook denied the request"),
+				}),
+			},
+			ExpectResource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1.ServiceBindingConditionReady.
+							False().
+							Reason("WorkloadUpdateFailed").
+							Message("failed to update 1 of 2 workloads, see .status.workloads for details"),
+						dieservicebindingv1.ServiceBindingConditionServiceAvailable.
+							True().
+							Reason("ResolvedBindingSecret"),
+						dieservicebindingv1.ServiceBindingConditionWorkloadProjected.
+							False().
+							Reason("WorkloadUpdateFailed").
+							Message("failed to update 1 of 2 workloads, see .status.workloads for details"),
+					)
+					d.WorkloadsDie(
+						workloadStatus.
+							Result(servicebindingv1.ServiceBindingWorkloadFailed).
+							Reason("WorkloadUpdateFailed").
+							Message("admission webhook denied the request"),
+					)
+				}).
+				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(serviceBinding, scheme, corev1.EventTypeWarning, "UpdateFailed", "Failed to update Deployment %q: admission webhook denied the request", "my-workload"),
+				rtesting.NewEvent(serviceBinding, scheme, corev1.EventTypeNormal, "Updated", "Updated Deployment %q", "other-workload"),
+			},
+			ExpectUpdates: []client.Object{
+				workload.
+					SpecDie(func(d *dieappsv1.DeploymentSpecDie) {
+						// not something a binding would ever project, but good enough for a test
+						d.Paused(true)
+					}).DieReleaseUnstructured(),
+				otherWorkload.
+					SpecDie(func(d *dieappsv1.DeploymentSpecDie) {
+						// not something a binding would ever project, but good enough for a test
+						d.Paused(true)
+					}).DieReleaseUnstructured(),
+			},
+			ExpectedResult: reconcile.Result{RequeueAfter: time.Second},
+		},
+		"cap recorded workloads": {
+			Metadata: map[string]interface{}{
+				"WorkloadStatusLimit": 1,
+			},
+			Resource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.WorkloadsDie(
+						workloadStatus.
+							Result(servicebindingv1.ServiceBindingWorkloadProjected),
+						workloadStatus.
+							Name("other-workload").
+							UID("").
+							Result(servicebindingv1.ServiceBindingWorkloadFailed).
+							Reason("WorkloadForbidden"),
+					)
+				}).
+				DieReleasePtr(),
+			ExpectResource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1.ServiceBindingConditionReady.True().Reason("ServiceBound"),
+						dieservicebindingv1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+						dieservicebindingv1.ServiceBindingConditionWorkloadProjected.True().Reason("WorkloadProjected"),
+					)
+					// workloads that are not projected are kept over projected workloads
+					d.WorkloadsDie(
+						workloadStatus.
+							Name("other-workload").
+							UID("").
+							Result(servicebindingv1.ServiceBindingWorkloadFailed).
+							Reason("WorkloadForbidden"),
+					)
+					d.BoundWorkloads(1)
+				}).
+				DieReleasePtr(),
+		},
 		"require same number of workloads and projected workloads": {
 			Resource: serviceBinding.DieReleasePtr(),
 			GivenObjects: []client.Object{
@@ -1280,6 +3055,13 @@ func TestPatchWorkloads(t *testing.T) {
 	}
 
 	rts.Run(t, scheme, func(t *testing.T, tc *rtesting.SubReconcilerTestCase[*servicebindingv1.ServiceBinding], c reconcilers.Config) reconcilers.SubReconciler[*servicebindingv1.ServiceBinding] {
-		return controllers.PatchWorkloads()
+		hooks := lifecycle.ServiceBindingHooks{}
+		if limit, ok := tc.Metadata["WorkloadStatusLimit"].(int); ok {
+			hooks.WorkloadStatusLimit = limit
+		}
+		if concurrency, ok := tc.Metadata["WorkloadConcurrency"].(int); ok {
+			hooks.WorkloadConcurrency = concurrency
+		}
+		return controllers.PatchWorkloads(hooks)
 	})
 }
@@ -0,0 +1,137 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/metadata/metadatainformer"
+	toolscache "k8s.io/client-go/tools/cache"
+	"reconciler.io/runtime/tracker"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ manager.Runnable = (*TriggerInformers)(nil)
+
+// TriggerInformers watch the metadata of the resources observed by bindings as an alternative to the trigger webhook.
+// A change to a watched resource enqueues the bindings tracking the resource. Informers are started and stopped as the
+// observed resources change.
+type TriggerInformers struct {
+	client  metadata.Interface
+	tracker tracker.Tracker
+	queue   *TriggerQueue
+
+	m         sync.Mutex
+	ctx       context.Context
+	cancel    context.CancelFunc
+	informers map[schema.GroupVersionResource]context.CancelFunc
+}
+
+// NewTriggerInformers creates trigger informers that enqueue the observers known to the tracker.
+func NewTriggerInformers(client metadata.Interface, tracker tracker.Tracker, queue *TriggerQueue) *TriggerInformers {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &TriggerInformers{
+		client:    client,
+		tracker:   tracker,
+		queue:     queue,
+		ctx:       ctx,
+		cancel:    cancel,
+		informers: map[schema.GroupVersionResource]context.CancelFunc{},
+	}
+}
+
+// Start blocks until the context is done and then stops every informer.
+func (i *TriggerInformers) Start(ctx context.Context) error {
+	<-ctx.Done()
+
+	i.m.Lock()
+	defer i.m.Unlock()
+	i.cancel()
+	i.informers = map[schema.GroupVersionResource]context.CancelFunc{}
+
+	return nil
+}
+
+// Sync starts informers for resources that are not watched yet and stops informers for resources that are no longer
+// observed. The resources are keyed by their resource with the kind objects are reported as.
+func (i *TriggerInformers) Sync(ctx context.Context, resources map[schema.GroupVersionResource]schema.GroupVersionKind) error {
+	log := logr.FromContextOrDiscard(ctx)
+
+	i.m.Lock()
+	defer i.m.Unlock()
+
+	for gvr, stop := range i.informers {
+		if _, ok := resources[gvr]; !ok {
+			log.Info("stopping trigger informer", "resource", gvr)
+			stop()
+			delete(i.informers, gvr)
+		}
+	}
+	for gvr, gvk := range resources {
+		if _, ok := i.informers[gvr]; ok {
+			continue
+		}
+		log.Info("starting trigger informer", "resource", gvr)
+		informer := metadatainformer.NewFilteredMetadataInformer(i.client, gvr, metav1.NamespaceAll, 0, toolscache.Indexers{}, nil).Informer()
+		if _, err := informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				i.trigger(gvk, obj)
+			},
+			UpdateFunc: func(_, obj interface{}) {
+				i.trigger(gvk, obj)
+			},
+			DeleteFunc: func(obj interface{}) {
+				i.trigger(gvk, obj)
+			},
+		}); err != nil {
+			return err
+		}
+		informerCtx, stop := context.WithCancel(i.ctx)
+		go informer.Run(informerCtx.Done())
+		i.informers[gvr] = stop
+	}
+
+	return nil
+}
+
+// trigger enqueues the observers of the object
+func (i *TriggerInformers) trigger(gvk schema.GroupVersionKind, obj interface{}) {
+	if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	meta, ok := obj.(*metav1.PartialObjectMetadata)
+	if !ok {
+		return
+	}
+	// the tracker matches observers by kind, which is not always populated on items from a list
+	meta = meta.DeepCopy()
+	meta.SetGroupVersionKind(gvk)
+
+	obs, err := i.tracker.GetObservers(meta)
+	if err != nil {
+		return
+	}
+	for _, ob := range obs {
+		i.queue.Enqueue(reconcile.Request{NamespacedName: ob})
+	}
+}
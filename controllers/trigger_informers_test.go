@@ -0,0 +1,128 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	metadatafake "k8s.io/client-go/metadata/fake"
+	"k8s.io/client-go/util/workqueue"
+	"reconciler.io/runtime/tracker"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/servicebinding/runtime/controllers"
+)
+
+func TestTriggerInformers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	serviceGVK := schema.GroupVersionKind{Group: "example", Version: "v1", Kind: "MyService"}
+	serviceGVR := schema.GroupVersionResource{Group: "example", Version: "v1", Resource: "myservices"}
+	service := &metav1.PartialObjectMetadata{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: serviceGVK.GroupVersion().String(),
+			Kind:       serviceGVK.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "my-namespace",
+			Name:      "my-service",
+		},
+	}
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "my-namespace", Name: "my-binding"}}
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(metav1.AddMetaToScheme(scheme))
+	metadataClient := metadatafake.NewSimpleMetadataClient(scheme, service)
+
+	queue := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+	defer queue.ShutDown()
+	triggerQueue := controllers.NewTriggerQueue(10, 100)
+	if err := triggerQueue.ServiceBindingSource().Start(ctx, queue); err != nil {
+		t.Fatalf("unable to start source: %v", err)
+	}
+
+	informers := controllers.NewTriggerInformers(metadataClient, &mockTracker{
+		observed: serviceGVK.GroupKind(),
+		observers: map[types.NamespacedName][]types.NamespacedName{
+			{Namespace: "my-namespace", Name: "my-service"}: {request.NamespacedName},
+		},
+	}, triggerQueue)
+	go informers.Start(ctx)
+
+	expectRequest := func(t *testing.T) {
+		t.Helper()
+		if err := wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, 10*time.Second, true, func(ctx context.Context) (bool, error) {
+			return queue.Len() > 0, nil
+		}); err != nil {
+			t.Fatalf("expected request to be enqueued: %v", err)
+		}
+		actual, _ := queue.Get()
+		queue.Done(actual)
+		if diff := cmp.Diff(request, actual); diff != "" {
+			t.Errorf("enqueued request (-expected, +actual): %s", diff)
+		}
+	}
+
+	if err := informers.Sync(ctx, map[schema.GroupVersionResource]schema.GroupVersionKind{serviceGVR: serviceGVK}); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	// the existing service is listed
+	expectRequest(t)
+
+	if err := metadataClient.Resource(serviceGVR).Namespace("my-namespace").Delete(ctx, "my-service", metav1.DeleteOptions{}); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	// the service is deleted
+	expectRequest(t)
+
+	if err := informers.Sync(ctx, map[schema.GroupVersionResource]schema.GroupVersionKind{}); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+}
+
+var _ tracker.Tracker = (*mockTracker)(nil)
+
+type mockTracker struct {
+	observed  schema.GroupKind
+	observers map[types.NamespacedName][]types.NamespacedName
+}
+
+func (t *mockTracker) TrackReference(ref tracker.Reference, obj client.Object) error {
+	return nil
+}
+
+func (t *mockTracker) TrackObject(ref client.Object, obj client.Object) error {
+	return nil
+}
+
+func (t *mockTracker) GetObservers(obj client.Object) ([]types.NamespacedName, error) {
+	if obj.GetObjectKind().GroupVersionKind().GroupKind() != t.observed {
+		return nil, nil
+	}
+	return t.observers[types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}], nil
+}
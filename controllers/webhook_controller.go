@@ -18,11 +18,25 @@ package controllers
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"reflect"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+	"gomodules.xyz/jsonpatch/v2"
+	admissionv1 "k8s.io/api/admission/v1"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
@@ -34,141 +48,334 @@ import (
 	controllerruntime "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	servicebindingv1 "github.com/servicebinding/runtime/apis/v1"
 	"github.com/servicebinding/runtime/lifecycle"
+	"github.com/servicebinding/runtime/projector"
 	"github.com/servicebinding/runtime/rbac"
 )
 
-//+kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=mutatingwebhookconfigurations,verbs=get;list;watch;create;update;patch
-//+kubebuilder:rbac:groups=core,resources=events,verbs=get;list;watch;create;update;patch;delete
+// WebhookOptions identify the webhook entry a reconciler manages, allowing a webhook configuration to hold several
+// entries, for example split by failure policy or shared by installs side by side.
+type WebhookOptions struct {
+	// Name of the webhook configuration
+	Name string
+	// WebhookName is the name of the entry within the webhook configuration to manage. When empty, the configuration
+	// must hold a single entry.
+	WebhookName string
+	// FailurePolicy to set on the managed entry. When nil, the failure policy is left as configured.
+	FailurePolicy *admissionregistrationv1.FailurePolicyType
+}
 
-// AdmissionProjector reconciles a MutatingWebhookConfiguration object
-func AdmissionProjectorReconciler(c reconcilers.Config, name string, accessChecker rbac.AccessChecker) *reconcilers.AggregateReconciler[*admissionregistrationv1.MutatingWebhookConfiguration] {
-	req := reconcile.Request{
+func (o WebhookOptions) request() reconcile.Request {
+	return reconcile.Request{
 		NamespacedName: types.NamespacedName{
-			Name: name,
+			Name: o.Name,
 		},
 	}
+}
+
+// mutatingWebhook returns the managed entry of the configuration, or nil when the entry is not found
+func (o WebhookOptions) mutatingWebhook(resource *admissionregistrationv1.MutatingWebhookConfiguration) *admissionregistrationv1.MutatingWebhook {
+	if resource == nil {
+		return nil
+	}
+	if o.WebhookName == "" {
+		if len(resource.Webhooks) != 1 {
+			return nil
+		}
+		return &resource.Webhooks[0]
+	}
+	for i := range resource.Webhooks {
+		if resource.Webhooks[i].Name == o.WebhookName {
+			return &resource.Webhooks[i]
+		}
+	}
+	return nil
+}
+
+// validatingWebhook returns the managed entry of the configuration, or nil when the entry is not found
+func (o WebhookOptions) validatingWebhook(resource *admissionregistrationv1.ValidatingWebhookConfiguration) *admissionregistrationv1.ValidatingWebhook {
+	if resource == nil {
+		return nil
+	}
+	if o.WebhookName == "" {
+		if len(resource.Webhooks) != 1 {
+			return nil
+		}
+		return &resource.Webhooks[0]
+	}
+	for i := range resource.Webhooks {
+		if resource.Webhooks[i].Name == o.WebhookName {
+			return &resource.Webhooks[i]
+		}
+	}
+	return nil
+}
+
+// webhookEntry points at the fields of the managed entry of a webhook configuration that the webhook reconcilers own
+type webhookEntry struct {
+	rules             *[]admissionregistrationv1.RuleWithOperations
+	namespaceSelector **metav1.LabelSelector
+	failurePolicy     **admissionregistrationv1.FailurePolicyType
+}
+
+func (o WebhookOptions) mutatingWebhookEntry(resource *admissionregistrationv1.MutatingWebhookConfiguration) *webhookEntry {
+	webhook := o.mutatingWebhook(resource)
+	if webhook == nil {
+		return nil
+	}
+	return &webhookEntry{rules: &webhook.Rules, namespaceSelector: &webhook.NamespaceSelector, failurePolicy: &webhook.FailurePolicy}
+}
+
+func (o WebhookOptions) validatingWebhookEntry(resource *admissionregistrationv1.ValidatingWebhookConfiguration) *webhookEntry {
+	webhook := o.validatingWebhook(resource)
+	if webhook == nil {
+		return nil
+	}
+	return &webhookEntry{rules: &webhook.Rules, namespaceSelector: &webhook.NamespaceSelector, failurePolicy: &webhook.FailurePolicy}
+}
 
-	return &reconcilers.AggregateReconciler[*admissionregistrationv1.MutatingWebhookConfiguration]{
-		Name:    "AdmissionProjector",
+// webhookConfigurationReconciler reconciles the managed entry of a webhook configuration. The reconciler stashes the
+// webhook rules and namespaces, which are set on the entry along with the configured failure policy. Other entries and
+// fields of the configuration are left alone.
+func webhookConfigurationReconciler[T client.Object](c reconcilers.Config, name string, opts WebhookOptions, entry func(T) *webhookEntry, kinds *UnmappedKinds, reconciler reconcilers.SubReconciler[client.Object], setup func(ctx context.Context, mgr controllerruntime.Manager, bldr *builder.Builder) error) *reconcilers.AggregateReconciler[T] {
+	req := opts.request()
+
+	return &reconcilers.AggregateReconciler[T]{
+		Name:    name,
 		Request: req,
-		Reconciler: &reconcilers.CastResource[*admissionregistrationv1.MutatingWebhookConfiguration, client.Object]{
-			Reconciler: reconcilers.Sequence[client.Object]{
-				LoadServiceBindings(req),
-				InterceptGVKs(),
-				WebhookRules([]admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update}, []string{}, accessChecker),
-			},
+		Reconciler: &reconcilers.CastResource[T, client.Object]{
+			Reconciler: reconciler,
 		},
-		DesiredResource: func(ctx context.Context, resource *admissionregistrationv1.MutatingWebhookConfiguration) (*admissionregistrationv1.MutatingWebhookConfiguration, error) {
-			if resource == nil || len(resource.Webhooks) != 1 {
+		DesiredResource: func(ctx context.Context, resource T) (T, error) {
+			webhook := entry(resource)
+			if webhook == nil {
 				// the webhook config isn't in a form that we expect, ignore it
 				return resource, nil
 			}
-			rules := RetrieveWebhookRules(ctx)
-			resource.Webhooks[0].Rules = rules
+			*webhook.rules = RetrieveWebhookRules(ctx)
+			*webhook.namespaceSelector = webhookNamespaceSelector(*webhook.namespaceSelector, RetrieveWebhookNamespaces(ctx))
+			if opts.FailurePolicy != nil {
+				*webhook.failurePolicy = opts.FailurePolicy
+			}
 			return resource, nil
 		},
-		AggregateObjectManager: &reconcilers.UpdatingObjectManager[*admissionregistrationv1.MutatingWebhookConfiguration]{
-			MergeBeforeUpdate: func(current, desired *admissionregistrationv1.MutatingWebhookConfiguration) {
-				if current == nil || len(current.Webhooks) != 1 || desired == nil || len(desired.Webhooks) != 1 {
+		AggregateObjectManager: &reconcilers.UpdatingObjectManager[T]{
+			MergeBeforeUpdate: func(current, desired T) {
+				currentWebhook, desiredWebhook := entry(current), entry(desired)
+				if currentWebhook == nil || desiredWebhook == nil {
 					// the webhook config isn't in a form that we expect, ignore it
 					return
 				}
-				current.Webhooks[0].Rules = desired.Webhooks[0].Rules
+				*currentWebhook.rules = *desiredWebhook.rules
+				*currentWebhook.namespaceSelector = *desiredWebhook.namespaceSelector
+				*currentWebhook.failurePolicy = *desiredWebhook.failurePolicy
 			},
-			Sanitize: func(resource *admissionregistrationv1.MutatingWebhookConfiguration) interface{} {
-				if resource == nil || len(resource.Webhooks) == 0 {
+			Sanitize: func(resource T) interface{} {
+				webhook := entry(resource)
+				if webhook == nil {
 					return nil
 				}
-				return resource.Webhooks[0].Rules
+				return map[string]interface{}{
+					"rules":             *webhook.rules,
+					"namespaceSelector": *webhook.namespaceSelector,
+					"failurePolicy":     *webhook.failurePolicy,
+				}
 			},
 		},
 
 		Setup: func(ctx context.Context, mgr controllerruntime.Manager, bldr *builder.Builder) error {
-			if err := mgr.GetFieldIndexer().IndexField(ctx, &servicebindingv1.ServiceBinding{}, WorkloadRefIndexKey, WorkloadRefIndexFunc); err != nil {
-				return err
+			if setup != nil {
+				if err := setup(ctx, mgr, bldr); err != nil {
+					return err
+				}
 			}
+			watchCustomResourceDefinitions(mgr, bldr, req, kinds)
 			return nil
 		},
 		Config: c,
 	}
 }
 
-func AdmissionProjectorWebhook(c reconcilers.Config, hooks lifecycle.ServiceBindingHooks) *reconcilers.AdmissionWebhookAdapter[*unstructured.Unstructured] {
+// PodAdmission configures how the admission projector treats pods as they are created. The zero value leaves pods
+// alone, the webhook only intercepts pods when at least one option is enabled.
+type PodAdmission struct {
+	// Projection projects the bindings of a controlling workload that opted into pod projection into the pod
+	Projection bool
+	// SchedulingGate holds pods with a scheduling gate until the bindings of the pod and its controllers are bound
+	SchedulingGate bool
+	// ReadinessGate adds a readiness gate reflecting the health of the bindings projected into the pod
+	ReadinessGate bool
+}
+
+func (p PodAdmission) enabled() bool {
+	return p.Projection || p.SchedulingGate || p.ReadinessGate
+}
+
+//+kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=mutatingwebhookconfigurations,verbs=get;list;watch;create;update;patch
+//+kubebuilder:rbac:groups=core,resources=events,verbs=get;list;watch;create;update;patch;delete
+
+// AdmissionProjector reconciles the webhook entry of a MutatingWebhookConfiguration object
+func AdmissionProjectorReconciler(c reconcilers.Config, opts WebhookOptions, accessChecker rbac.AccessChecker, kinds *UnmappedKinds, pods PodAdmission) *reconcilers.AggregateReconciler[*admissionregistrationv1.MutatingWebhookConfiguration] {
+	req := opts.request()
+
+	rules := reconcilers.Sequence[client.Object]{
+		LoadServiceBindings(req),
+		WebhookNamespaces(),
+		InterceptGVKs(),
+		WebhookRules([]admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update}, []string{}, accessChecker, kinds),
+	}
+	if pods.enabled() {
+		rules = append(rules, PodRules(pods))
+	}
+
+	return webhookConfigurationReconciler(c, "AdmissionProjector", opts, opts.mutatingWebhookEntry, kinds, rules,
+		func(ctx context.Context, mgr controllerruntime.Manager, bldr *builder.Builder) error {
+			if err := mgr.GetFieldIndexer().IndexField(ctx, &servicebindingv1.ServiceBinding{}, WorkloadRefIndexKey, WorkloadRefIndexFunc); err != nil {
+				return err
+			}
+			return mgr.GetFieldIndexer().IndexField(ctx, &servicebindingv1.ClusterServiceBinding{}, WorkloadRefIndexKey, WorkloadRefIndexFunc)
+		},
+	)
+}
+
+// AdmissionModeLabelKey on a namespace overrides the admission mode of the projector for workloads in the namespace
+const AdmissionModeLabelKey = "servicebinding.io/admission-mode"
+
+// AdmissionMode controls whether the admission projector mutates workloads
+type AdmissionMode string
+
+const (
+	// AdmissionModeEnforce projects bindings into the admitted workload
+	AdmissionModeEnforce AdmissionMode = "enforce"
+	// AdmissionModeAudit computes the projection without changing the admitted workload. The patch that would be
+	// applied is described with admission warnings and audit annotations.
+	AdmissionModeAudit AdmissionMode = "audit"
+)
+
+var admissionProjections = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "servicebinding_admission_projections_total",
+	Help: "Number of workloads admitted with bindings by the admission projector, by workload kind and admission mode",
+}, []string{"group", "version", "kind", "mode", "changed"})
+
+func init() {
+	metrics.Registry.MustRegister(admissionProjections)
+}
+
+// AdmissionProjectorWebhook projects the bindings of the admitted workload. The mode applies to workloads in namespaces
+// that are not labeled with an admission mode. Pods being created are additionally handled as configured by the pod
+// admission options.
+func AdmissionProjectorWebhook(c reconcilers.Config, hooks lifecycle.ServiceBindingHooks, mode AdmissionMode, pods PodAdmission) *reconcilers.AdmissionWebhookAdapter[*unstructured.Unstructured] {
 	return &reconcilers.AdmissionWebhookAdapter[*unstructured.Unstructured]{
 		Name: "AdmissionProjectorWebhook",
 		Reconciler: &reconcilers.SyncReconciler[*unstructured.Unstructured]{
 			Sync: func(ctx context.Context, workload *unstructured.Unstructured) error {
 				c := reconcilers.RetrieveConfigOrDie(ctx)
 
-				// find matching service bindings
-				serviceBindings := &servicebindingv1.ServiceBindingList{}
 				gvk := schema.FromAPIVersionAndKind(workload.GetAPIVersion(), workload.GetKind())
-				if err := c.List(ctx, serviceBindings, client.InNamespace(workload.GetNamespace()), client.MatchingFields{WorkloadRefIndexKey: workloadRefIndexValue(gvk.Group, gvk.Kind)}); err != nil {
+				projector := hooks.GetProjector(hooks.GetResolver(c))
+				activeServiceBindings, err := resolveActiveServiceBindings(ctx, c, projector, workload)
+				if err != nil {
 					return err
 				}
-
-				projector := hooks.GetProjector(hooks.GetResolver(c))
-
-				// check that bindings are for this workload
-				activeServiceBindings := []servicebindingv1.ServiceBinding{}
-				for _, sb := range serviceBindings.Items {
-					if !sb.DeletionTimestamp.IsZero() {
-						continue
-					}
-					if projector.IsProjected(ctx, &sb, workload) {
-						activeServiceBindings = append(activeServiceBindings, sb)
-						continue
-					}
-					ref := sb.Spec.Workload
-					if ref.Name == workload.GetName() {
-						activeServiceBindings = append(activeServiceBindings, sb)
-						continue
+				if isPodProjected(workload) {
+					// the bindings are projected into the workload's pods as they are created
+					activeServiceBindings = nil
+				}
+				// pods are created from the pod template of their controllers, the bindings of the controllers apply
+				podCreate := gvk.Group == "" && gvk.Kind == "Pod" && reconcilers.RetrieveAdmissionRequest(ctx).Operation == admissionv1.Create
+				podOwners := []*unstructured.Unstructured{}
+				if podCreate && pods.enabled() {
+					podOwners, err = resolvePodOwners(ctx, c, workload)
+					if err != nil {
+						return err
 					}
-					if ref.Selector != nil {
-						selector, err := metav1.LabelSelectorAsSelector(ref.Selector)
-						if err != nil {
+				}
+				podServiceBindings := []servicebindingv1.ServiceBinding{}
+				if pods.Projection {
+					// the annotation is copied down the owner chain, for example from a Deployment onto its ReplicaSets,
+					// so the bindings of every annotated owner apply
+					for _, owner := range podOwners {
+						if !isPodProjected(owner) {
 							continue
 						}
-						if selector.Matches(labels.Set(workload.GetLabels())) {
-							activeServiceBindings = append(activeServiceBindings, sb)
-							continue
+						ownerServiceBindings, err := resolveActiveServiceBindings(ctx, c, projector, owner)
+						if err != nil {
+							return err
+						}
+						for _, sb := range podServiceBindingsFor(ownerServiceBindings, workload) {
+							if !slices.ContainsFunc(podServiceBindings, func(psb servicebindingv1.ServiceBinding) bool {
+								return psb.Namespace == sb.Namespace && psb.Name == sb.Name
+							}) {
+								podServiceBindings = append(podServiceBindings, sb)
+							}
 						}
 					}
 				}
 
+				// in audit mode the projection is computed on a copy, the admitted workload is left unchanged
+				workloadMode := mode
+				if workload.GetNamespace() != "" {
+					namespace := &corev1.Namespace{}
+					if err := c.Get(ctx, client.ObjectKey{Name: workload.GetNamespace()}, namespace); err != nil && !apierrs.IsNotFound(err) {
+						return err
+					}
+					if labeled := AdmissionMode(namespace.Labels[AdmissionModeLabelKey]); labeled == AdmissionModeEnforce || labeled == AdmissionModeAudit {
+						workloadMode = labeled
+					}
+				}
+				original := workload
+				if workloadMode == AdmissionModeAudit {
+					workload = workload.DeepCopy()
+				} else {
+					original = workload.DeepCopy()
+				}
+
 				// project active bindings into workload
 				if f := hooks.WorkloadPreProjection; f != nil {
 					if err := f(ctx, workload); err != nil {
 						return err
 					}
 				}
-				for i := range activeServiceBindings {
-					sb := activeServiceBindings[i].DeepCopy()
-					(&servicebindingv1.ServiceBinding{}).Default(ctx, sb)
-					if f := hooks.ServiceBindingPreProjection; f != nil {
-						if err := f(ctx, sb); err != nil {
-							return err
-						}
-					}
-					if err := projector.Project(ctx, sb, workload); err != nil {
+				if err := projectServiceBindings(ctx, hooks, projector, activeServiceBindings, workload); err != nil {
+					return err
+				}
+				if err := projectServiceBindings(ctx, hooks, podProjector(hooks), podServiceBindings, workload); err != nil {
+					return err
+				}
+				if f := hooks.WorkloadPostProjection; f != nil {
+					if err := f(ctx, workload); err != nil {
 						return err
 					}
-					if f := hooks.ServiceBindingPostProjection; f != nil {
-						if err := f(ctx, sb); err != nil {
+				}
+				activeServiceBindings = append(activeServiceBindings, podServiceBindings...)
+
+				if podCreate && pods.SchedulingGate {
+					serviceBindings := activeServiceBindings
+					for _, owner := range podOwners {
+						ownerServiceBindings, err := resolveActiveServiceBindings(ctx, c, projector, owner)
+						if err != nil {
 							return err
 						}
+						serviceBindings = append(serviceBindings, ownerServiceBindings...)
 					}
+					gateScheduling(workload, serviceBindings)
 				}
-				if f := hooks.WorkloadPostProjection; f != nil {
-					if err := f(ctx, workload); err != nil {
-						return err
-					}
+				if podCreate && pods.ReadinessGate {
+					addReadinessGate(workload)
+				}
+
+				changed := !equality.Semantic.DeepEqual(original, workload)
+				if len(activeServiceBindings) != 0 {
+					admissionProjections.WithLabelValues(gvk.Group, gvk.Version, gvk.Kind, string(workloadMode), strconv.FormatBool(changed)).Inc()
+				}
+				if workloadMode == AdmissionModeAudit && changed {
+					return auditProjection(ctx, original, workload, activeServiceBindings)
 				}
 
 				return nil
@@ -178,58 +385,201 @@ func AdmissionProjectorWebhook(c reconcilers.Config, hooks lifecycle.ServiceBind
 	}
 }
 
+// auditProjection describes the patch projecting the bindings would apply to the workload with admission warnings and
+// audit annotations on the admission response
+func auditProjection(ctx context.Context, original, projected *unstructured.Unstructured, serviceBindings []servicebindingv1.ServiceBinding) error {
+	patch, err := projectionPatch(original, projected)
+	if err != nil {
+		return err
+	}
+	patchJSON, err := json.Marshal(patch)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, len(serviceBindings))
+	for i := range serviceBindings {
+		names[i] = serviceBindings[i].Name
+	}
+
+	resp := reconcilers.RetrieveAdmissionResponse(ctx)
+	for _, op := range patch {
+		resp.Warnings = append(resp.Warnings, fmt.Sprintf("audit mode: projecting service bindings would %s %s", op.Operation, op.Path))
+	}
+	if resp.AuditAnnotations == nil {
+		resp.AuditAnnotations = map[string]string{}
+	}
+	resp.AuditAnnotations["projection-patch"] = string(patchJSON)
+	resp.AuditAnnotations["service-bindings"] = strings.Join(names, ",")
+
+	return nil
+}
+
+// projectionPatch creates the patch that turns the original workload into the projected workload
+func projectionPatch(original, projected *unstructured.Unstructured) ([]jsonpatch.Operation, error) {
+	originalJSON, err := original.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	projectedJSON, err := projected.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return jsonpatch.CreatePatch(originalJSON, projectedJSON)
+}
+
+// ProjectionGuardMode controls how the projection guard responds to a workload update that removes a projection
+type ProjectionGuardMode string
+
+const (
+	// ProjectionGuardOff does not check workload updates
+	ProjectionGuardOff ProjectionGuardMode = "off"
+	// ProjectionGuardWarn admits the workload update with a warning naming the binding
+	ProjectionGuardWarn ProjectionGuardMode = "warn"
+	// ProjectionGuardDeny rejects the workload update with an error naming the binding
+	ProjectionGuardDeny ProjectionGuardMode = "deny"
+)
+
 //+kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=validatingwebhookconfigurations,verbs=get;list;watch;create;update;patch
 //+kubebuilder:rbac:groups=core;events.k8s.io,resources=events,verbs=get;list;watch;create;update;patch;delete
 
-// TriggerReconciler reconciles a ValidatingWebhookConfiguration object
-func TriggerReconciler(c reconcilers.Config, name string, accessChecker rbac.AccessChecker) *reconcilers.AggregateReconciler[*admissionregistrationv1.ValidatingWebhookConfiguration] {
-	req := reconcile.Request{
-		NamespacedName: types.NamespacedName{
-			Name: name,
-		},
+// ProjectionGuardReconciler reconciles the projection guard entry of a ValidatingWebhookConfiguration object. The rules
+// intercept updates to bound workloads, unless the guard is off.
+func ProjectionGuardReconciler(c reconcilers.Config, opts WebhookOptions, mode ProjectionGuardMode, accessChecker rbac.AccessChecker, kinds *UnmappedKinds) *reconcilers.AggregateReconciler[*admissionregistrationv1.ValidatingWebhookConfiguration] {
+	req := opts.request()
+
+	rules := reconcilers.Sequence[client.Object]{}
+	if mode != ProjectionGuardOff {
+		rules = append(rules, WebhookRules([]admissionregistrationv1.OperationType{admissionregistrationv1.Update}, []string{}, accessChecker, kinds))
 	}
 
-	return &reconcilers.AggregateReconciler[*admissionregistrationv1.ValidatingWebhookConfiguration]{
-		Name:    "Trigger",
-		Request: req,
-		Reconciler: &reconcilers.CastResource[*admissionregistrationv1.ValidatingWebhookConfiguration, client.Object]{
-			Reconciler: reconcilers.Sequence[client.Object]{
-				LoadServiceBindings(req),
-				TriggerGVKs(),
-				InterceptGVKs(),
-				WebhookRules([]admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update, admissionregistrationv1.Delete}, []string{"status"}, accessChecker),
-			},
-		},
-		DesiredResource: func(ctx context.Context, resource *admissionregistrationv1.ValidatingWebhookConfiguration) (*admissionregistrationv1.ValidatingWebhookConfiguration, error) {
-			if resource == nil || len(resource.Webhooks) != 1 {
-				// the webhook config isn't in a form that we expect, ignore it
-				return resource, nil
-			}
-			rules := RetrieveWebhookRules(ctx)
-			resource.Webhooks[0].Rules = rules
-			return resource, nil
+	return webhookConfigurationReconciler(c, "ProjectionGuard", opts, opts.validatingWebhookEntry, kinds,
+		reconcilers.Sequence[client.Object]{
+			LoadServiceBindings(req),
+			WebhookNamespaces(),
+			InterceptGVKs(),
+			rules,
 		},
-		AggregateObjectManager: &reconcilers.UpdatingObjectManager[*admissionregistrationv1.ValidatingWebhookConfiguration]{
-			MergeBeforeUpdate: func(current, desired *admissionregistrationv1.ValidatingWebhookConfiguration) {
-				if current == nil || len(current.Webhooks) != 1 || desired == nil || len(desired.Webhooks) != 1 {
-					// the webhook config isn't in a form that we expect, ignore it
-					return
+		nil,
+	)
+}
+
+// ProjectionGuardWebhook checks workload updates for projected volumes, volume mounts and env vars that are removed
+// while the binding that owns them is still active. An update is checked against each binding that was fully projected
+// into the previous workload, the fields projecting the binding would restore into the updated workload are removed by
+// the update.
+func ProjectionGuardWebhook(c reconcilers.Config, hooks lifecycle.ServiceBindingHooks, mode ProjectionGuardMode) *reconcilers.AdmissionWebhookAdapter[*unstructured.Unstructured] {
+	return &reconcilers.AdmissionWebhookAdapter[*unstructured.Unstructured]{
+		Name: "ProjectionGuardWebhook",
+		Reconciler: &reconcilers.SyncReconciler[*unstructured.Unstructured]{
+			Sync: func(ctx context.Context, workload *unstructured.Unstructured) error {
+				c := reconcilers.RetrieveConfigOrDie(ctx)
+				req := reconcilers.RetrieveAdmissionRequest(ctx)
+
+				if mode == ProjectionGuardOff || req.Operation != admissionv1.Update || len(req.OldObject.Raw) == 0 {
+					return nil
 				}
-				current.Webhooks[0].Rules = desired.Webhooks[0].Rules
-			},
-			Sanitize: func(resource *admissionregistrationv1.ValidatingWebhookConfiguration) interface{} {
-				if resource == nil || len(resource.Webhooks) == 0 {
+				previous := &unstructured.Unstructured{}
+				if err := previous.UnmarshalJSON(req.OldObject.Raw); err != nil {
+					return err
+				}
+
+				projector := hooks.GetProjector(hooks.GetResolver(c))
+				activeServiceBindings, err := resolveActiveServiceBindings(ctx, c, projector, workload)
+				if err != nil {
+					return err
+				}
+
+				violations := []string{}
+				for i := range activeServiceBindings {
+					if activeServiceBindings[i].Spec.Suspend {
+						// the projection of a suspended binding is not maintained
+						continue
+					}
+					sb := activeServiceBindings[i].DeepCopy()
+					(&servicebindingv1.ServiceBinding{}).Default(ctx, sb)
+					if !projector.IsProjected(ctx, sb, previous) {
+						continue
+					}
+
+					// only a binding in sync with the previous workload is checked, a binding waiting to be projected
+					// again, for example after the secret rotated, would flag its own changes
+					reprojected := previous.DeepCopy()
+					if err := projector.Project(ctx, sb, reprojected); err != nil {
+						return err
+					}
+					if !equality.Semantic.DeepEqual(previous, reprojected) {
+						continue
+					}
+
+					projected := workload.DeepCopy()
+					if err := projector.Project(ctx, sb, projected); err != nil {
+						return err
+					}
+					patch, err := projectionPatch(workload, projected)
+					if err != nil {
+						return err
+					}
+					if len(patch) == 0 {
+						continue
+					}
+					paths := make([]string, len(patch))
+					for j := range patch {
+						paths[j] = patch[j].Path
+					}
+					violations = append(violations, fmt.Sprintf("ServiceBinding %q is projected into the workload, the update removes projected fields: %s", sb.Name, strings.Join(paths, ", ")))
+				}
+				if len(violations) == 0 {
+					return nil
+				}
+
+				resp := reconcilers.RetrieveAdmissionResponse(ctx)
+				if mode == ProjectionGuardDeny {
+					resp.Allowed = false
+					resp.Result = &metav1.Status{
+						Status:  metav1.StatusFailure,
+						Code:    http.StatusForbidden,
+						Reason:  metav1.StatusReasonForbidden,
+						Message: strings.Join(violations, "; "),
+					}
 					return nil
 				}
-				return resource.Webhooks[0].Rules
+				resp.Warnings = append(resp.Warnings, violations...)
+
+				return nil
 			},
 		},
-
 		Config: c,
 	}
 }
 
-func TriggerWebhook(c reconcilers.Config, serviceBindingController controller.Controller) *reconcilers.AdmissionWebhookAdapter[*unstructured.Unstructured] {
+//+kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=validatingwebhookconfigurations,verbs=get;list;watch;create;update;patch
+//+kubebuilder:rbac:groups=core;events.k8s.io,resources=events,verbs=get;list;watch;create;update;patch;delete
+
+// TriggerReconciler reconciles the webhook entry of a ValidatingWebhookConfiguration object. When informers are
+// provided, the observed resources are watched by the informers in place of the webhook and the webhook's rules are
+// cleared.
+func TriggerReconciler(c reconcilers.Config, opts WebhookOptions, accessChecker rbac.AccessChecker, kinds *UnmappedKinds, informers *TriggerInformers) *reconcilers.AggregateReconciler[*admissionregistrationv1.ValidatingWebhookConfiguration] {
+	req := opts.request()
+
+	triggers := WebhookRules([]admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update, admissionregistrationv1.Delete}, []string{"status"}, accessChecker, kinds)
+	if informers != nil {
+		triggers = InformerResources(informers, accessChecker, kinds)
+	}
+
+	return webhookConfigurationReconciler(c, "Trigger", opts, opts.validatingWebhookEntry, kinds,
+		reconcilers.Sequence[client.Object]{
+			LoadServiceBindings(req),
+			WebhookNamespaces(),
+			TriggerGVKs(),
+			InterceptGVKs(),
+			triggers,
+		},
+		nil,
+	)
+}
+
+func TriggerWebhook(c reconcilers.Config, queue *TriggerQueue) *reconcilers.AdmissionWebhookAdapter[*unstructured.Unstructured] {
 	return &reconcilers.AdmissionWebhookAdapter[*unstructured.Unstructured]{
 		Name: "TriggerWebhook",
 		Reconciler: &reconcilers.SyncReconciler[*unstructured.Unstructured]{
@@ -238,14 +588,6 @@ func TriggerWebhook(c reconcilers.Config, serviceBindingController controller.Co
 				c := reconcilers.RetrieveConfigOrDie(ctx)
 				req := reconcilers.RetrieveAdmissionRequest(ctx)
 
-				// TODO find a better way to get at the queue, this is fragile and may break in any controller-runtime update
-				queueValue := reflect.ValueOf(serviceBindingController).Elem().FieldByName("Queue")
-				if queueValue.IsNil() {
-					// queue is not populated yet
-					return nil
-				}
-				queue := queueValue.Interface().(workqueue.TypedInterface[reconcile.Request])
-
 				obs, err := c.Tracker.GetObservers(trigger)
 				if err != nil {
 					return err
@@ -257,7 +599,9 @@ func TriggerWebhook(c reconcilers.Config, serviceBindingController controller.Co
 						// ignore dry run requests
 						continue
 					}
-					queue.Add(rr)
+					if !queue.Enqueue(rr) {
+						log.V(2).Info("controller not started, dropping tracked request", "request", rr)
+					}
 				}
 
 				return nil
@@ -267,6 +611,83 @@ func TriggerWebhook(c reconcilers.Config, serviceBindingController controller.Co
 	}
 }
 
+// TriggerQueue enqueues requests to reconcile ServiceBindings and ClusterServiceBindings from outside of their controllers'
+// watches. Each controller watches the trigger queue's source for its resource, requests are added to the controller's queue
+// once the controller starts. A request that is already waiting in the queue is not added again, bursts of requests are
+// rate limited.
+type TriggerQueue struct {
+	m                      sync.Mutex
+	rateLimiter            workqueue.TypedRateLimiter[reconcile.Request]
+	serviceBindings        workqueue.TypedRateLimitingInterface[reconcile.Request]
+	clusterServiceBindings workqueue.TypedRateLimitingInterface[reconcile.Request]
+	// waiting holds when each delayed request is added to its queue
+	waiting map[reconcile.Request]time.Time
+}
+
+// NewTriggerQueue creates a trigger queue that enqueues at most qps requests per second after an initial burst.
+func NewTriggerQueue(qps float64, burst int) *TriggerQueue {
+	return &TriggerQueue{
+		rateLimiter: &workqueue.TypedBucketRateLimiter[reconcile.Request]{
+			Limiter: rate.NewLimiter(rate.Limit(qps), burst),
+		},
+		waiting: map[reconcile.Request]time.Time{},
+	}
+}
+
+// ServiceBindingSource is the source of triggered requests for ServiceBindings
+func (t *TriggerQueue) ServiceBindingSource() source.Source {
+	return source.Func(func(ctx context.Context, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) error {
+		t.m.Lock()
+		defer t.m.Unlock()
+		t.serviceBindings = queue
+		return nil
+	})
+}
+
+// ClusterServiceBindingSource is the source of triggered requests for ClusterServiceBindings
+func (t *TriggerQueue) ClusterServiceBindingSource() source.Source {
+	return source.Func(func(ctx context.Context, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) error {
+		t.m.Lock()
+		defer t.m.Unlock()
+		t.clusterServiceBindings = queue
+		return nil
+	})
+}
+
+// Enqueue adds the request to the queue of the ServiceBinding controller, or the ClusterServiceBinding controller for
+// requests without a namespace. False is returned when the controller has not started, the request is dropped.
+func (t *TriggerQueue) Enqueue(req reconcile.Request) bool {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	// service bindings are namespaced, cluster service bindings are not
+	queue := t.serviceBindings
+	if req.Namespace == "" {
+		queue = t.clusterServiceBindings
+	}
+	if queue == nil {
+		return false
+	}
+
+	now := time.Now()
+	for waiting, at := range t.waiting {
+		if !now.Before(at) {
+			delete(t.waiting, waiting)
+		}
+	}
+	if _, ok := t.waiting[req]; ok {
+		// the request is already waiting, charging the rate limiter again would only push it further back
+		return true
+	}
+	delay := t.rateLimiter.When(req)
+	if delay > 0 {
+		t.waiting[req] = now.Add(delay)
+	}
+	// the queue dedupes requests that are already queued
+	queue.AddAfter(req, delay)
+	return true
+}
+
 func LoadServiceBindings(req reconcile.Request) reconcilers.SubReconciler[client.Object] {
 	return &reconcilers.SyncReconciler[client.Object]{
 		Name: "LoadServiceBindings",
@@ -280,6 +701,13 @@ func LoadServiceBindings(req reconcile.Request) reconcilers.SubReconciler[client
 
 			StashServiceBindings(ctx, serviceBindings.Items)
 
+			clusterServiceBindings := &servicebindingv1.ClusterServiceBindingList{}
+			if err := c.List(ctx, clusterServiceBindings); err != nil {
+				return err
+			}
+
+			StashClusterServiceBindings(ctx, clusterServiceBindings.Items)
+
 			return nil
 		},
 		Setup: func(ctx context.Context, mgr controllerruntime.Manager, bldr *builder.Builder) error {
@@ -288,16 +716,82 @@ func LoadServiceBindings(req reconcile.Request) reconcilers.SubReconciler[client
 					return []reconcile.Request{req}
 				},
 			))
+			bldr.Watches(&servicebindingv1.ClusterServiceBinding{}, handler.EnqueueRequestsFromMapFunc(
+				func(ctx context.Context, o client.Object) []reconcile.Request {
+					return []reconcile.Request{req}
+				},
+			))
 			return nil
 		},
 	}
 }
 
+// WebhookNamespaces resolves the namespaces that contain bindings, the webhooks are only called for resources within
+// these namespaces. A ClusterServiceBinding contributes the namespaces it is bound into.
+func WebhookNamespaces() reconcilers.SubReconciler[client.Object] {
+	return &reconcilers.SyncReconciler[client.Object]{
+		Name: "WebhookNamespaces",
+		Sync: func(ctx context.Context, _ client.Object) error {
+			serviceBindings := RetrieveServiceBindings(ctx)
+			clusterServiceBindings := RetrieveClusterServiceBindings(ctx)
+
+			namespaces := sets.NewString()
+			for i := range serviceBindings {
+				namespaces.Insert(serviceBindings[i].Namespace)
+			}
+			for i := range clusterServiceBindings {
+				for _, namespace := range clusterServiceBindings[i].Status.Namespaces {
+					namespaces.Insert(namespace.Namespace)
+				}
+			}
+
+			StashWebhookNamespaces(ctx, namespaces.List())
+
+			return nil
+		},
+	}
+}
+
+// webhookNamespaceSelector restricts the selector to the namespaces by the name label every namespace carries, replacing
+// an In requirement on the label. Other requirements of the selector, like namespaces excluded with NotIn, are preserved.
+// The selector is not restricted when there are no namespaces.
+func webhookNamespaceSelector(selector *metav1.LabelSelector, namespaces []string) *metav1.LabelSelector {
+	expressions := []metav1.LabelSelectorRequirement{}
+	if selector != nil {
+		for _, expression := range selector.MatchExpressions {
+			if expression.Key != corev1.LabelMetadataName || expression.Operator != metav1.LabelSelectorOpIn {
+				expressions = append(expressions, expression)
+			}
+		}
+	}
+	if len(namespaces) != 0 {
+		expressions = append(expressions, metav1.LabelSelectorRequirement{
+			Key:      corev1.LabelMetadataName,
+			Operator: metav1.LabelSelectorOpIn,
+			Values:   namespaces,
+		})
+	}
+	if len(expressions) == 0 {
+		expressions = nil
+	}
+
+	if selector == nil {
+		if expressions == nil {
+			return nil
+		}
+		return &metav1.LabelSelector{MatchExpressions: expressions}
+	}
+	selector = selector.DeepCopy()
+	selector.MatchExpressions = expressions
+	return selector
+}
+
 func InterceptGVKs() reconcilers.SubReconciler[client.Object] {
 	return &reconcilers.SyncReconciler[client.Object]{
 		Name: "InterceptGVKs",
 		Sync: func(ctx context.Context, _ client.Object) error {
 			serviceBindings := RetrieveServiceBindings(ctx)
+			clusterServiceBindings := RetrieveClusterServiceBindings(ctx)
 			gvks := RetrieveObservedGKVs(ctx)
 
 			for i := range serviceBindings {
@@ -305,6 +799,11 @@ func InterceptGVKs() reconcilers.SubReconciler[client.Object] {
 				gvk := schema.FromAPIVersionAndKind(workload.APIVersion, workload.Kind)
 				gvks = append(gvks, gvk)
 			}
+			for i := range clusterServiceBindings {
+				workload := clusterServiceBindings[i].Spec.Workload
+				gvk := schema.FromAPIVersionAndKind(workload.APIVersion, workload.Kind)
+				gvks = append(gvks, gvk)
+			}
 
 			StashObservedGVKs(ctx, gvks)
 
@@ -318,10 +817,17 @@ func TriggerGVKs() reconcilers.SubReconciler[client.Object] {
 		Name: "TriggerGVKs",
 		Sync: func(ctx context.Context, _ client.Object) error {
 			serviceBindings := RetrieveServiceBindings(ctx)
+			clusterServiceBindings := RetrieveClusterServiceBindings(ctx)
 			gvks := RetrieveObservedGKVs(ctx)
 
+			services := []servicebindingv1.ServiceBindingServiceReference{}
 			for i := range serviceBindings {
-				service := serviceBindings[i].Spec.Service
+				services = append(services, serviceBindings[i].Spec.Service)
+			}
+			for i := range clusterServiceBindings {
+				services = append(services, clusterServiceBindings[i].Spec.Service)
+			}
+			for _, service := range services {
 				gvk := schema.FromAPIVersionAndKind(service.APIVersion, service.Kind)
 				if gvk.Kind == "Secret" && (gvk.Group == "" || gvk.Group == "core") {
 					// ignore direct bindings
@@ -337,7 +843,7 @@ func TriggerGVKs() reconcilers.SubReconciler[client.Object] {
 	}
 }
 
-func WebhookRules(operations []admissionregistrationv1.OperationType, subresources []string, accessChecker rbac.AccessChecker) reconcilers.SubReconciler[client.Object] {
+func WebhookRules(operations []admissionregistrationv1.OperationType, subresources []string, accessChecker rbac.AccessChecker, kinds *UnmappedKinds) reconcilers.SubReconciler[client.Object] {
 	return &reconcilers.SyncReconciler[client.Object]{
 		Name: "WebhookRules",
 		Sync: func(ctx context.Context, _ client.Object) error {
@@ -345,13 +851,12 @@ func WebhookRules(operations []admissionregistrationv1.OperationType, subresourc
 			c := reconcilers.RetrieveConfigOrDie(ctx)
 
 			// dedup gvks as gvrs
-			gvks := RetrieveObservedGKVs(ctx)
+			mappings, err := observedRESTMappings(ctx, c, kinds)
+			if err != nil {
+				return err
+			}
 			groupResources := map[string]map[string]interface{}{}
-			for _, gvk := range gvks {
-				rm, err := c.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
-				if err != nil {
-					return err
-				}
+			for _, rm := range mappings {
 				gvr := rm.Resource
 				if _, ok := groupResources[gvr.Group]; !ok {
 					groupResources[gvr.Group] = map[string]interface{}{}
@@ -405,6 +910,234 @@ func WebhookRules(operations []admissionregistrationv1.OperationType, subresourc
 	}
 }
 
+// PodRules adds a rule for pods being created to the stashed webhook rules, so the pods of bound workloads are admitted
+// by the webhook. Pods are not updated, as the pod spec is immutable. The rule is only added while a binding needs its
+// pods admitted, as every pod created in the namespaces of the bindings is intercepted: with a gate every bound pod is
+// admitted, with projection alone only bindings projected into the pods of their workloads need the rule.
+func PodRules(pods PodAdmission) reconcilers.SubReconciler[client.Object] {
+	return &reconcilers.SyncReconciler[client.Object]{
+		Name: "PodRules",
+		Sync: func(ctx context.Context, _ client.Object) error {
+			// the webhook is only limited to the namespaces of the bindings when there are some, an empty list would
+			// intercept pods in every namespace
+			if len(RetrieveWebhookNamespaces(ctx)) == 0 {
+				return nil
+			}
+			if !pods.SchedulingGate && !pods.ReadinessGate && !hasPodProjectedServiceBindings(ctx) {
+				return nil
+			}
+
+			rules := RetrieveWebhookRules(ctx)
+			rules = append(rules, admissionregistrationv1.RuleWithOperations{
+				Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create},
+				Rule: admissionregistrationv1.Rule{
+					APIGroups:   []string{""},
+					APIVersions: []string{"v1"},
+					Resources:   []string{"pods"},
+				},
+			})
+			StashWebhookRules(ctx, rules)
+
+			return nil
+		},
+	}
+}
+
+// hasPodProjectedServiceBindings returns true when a stashed binding is projected into the pods of a workload. The
+// workloads of a ClusterServiceBinding are not reported in its status, so every ClusterServiceBinding bound into a
+// namespace counts.
+func hasPodProjectedServiceBindings(ctx context.Context) bool {
+	serviceBindings := RetrieveServiceBindings(ctx)
+	for i := range serviceBindings {
+		for _, workload := range serviceBindings[i].Status.Workloads {
+			if workload.Reason == podProjectionReason {
+				return true
+			}
+		}
+	}
+	clusterServiceBindings := RetrieveClusterServiceBindings(ctx)
+	for i := range clusterServiceBindings {
+		if len(clusterServiceBindings[i].Status.Namespaces) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// InformerResources starts and stops the trigger informers for the observed resources. Resources the informers may not
+// watch are ignored. No webhook rules are stashed, so the webhook is not called while informers trigger the bindings.
+func InformerResources(informers *TriggerInformers, accessChecker rbac.AccessChecker, kinds *UnmappedKinds) reconcilers.SubReconciler[client.Object] {
+	return &reconcilers.SyncReconciler[client.Object]{
+		Name: "InformerResources",
+		Sync: func(ctx context.Context, _ client.Object) error {
+			log := logr.FromContextOrDiscard(ctx)
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+
+			// dedup gvks as gvrs
+			mappings, err := observedRESTMappings(ctx, c, kinds)
+			if err != nil {
+				return err
+			}
+			resources := map[schema.GroupVersionResource]schema.GroupVersionKind{}
+			for _, rm := range mappings {
+				if _, ok := resources[rm.Resource]; ok {
+					continue
+				}
+				// informers are subject to RBAC, unlike admission webhooks
+				if !accessChecker.CanI(ctx, rm.Resource.Group, rm.Resource.Resource) {
+					log.Info("ignoring resource, access denied", "group", rm.Resource.Group, "resource", rm.Resource.Resource)
+					continue
+				}
+				resources[rm.Resource] = rm.GroupVersionKind
+			}
+
+			return informers.Sync(ctx, resources)
+		},
+	}
+}
+
+// observedRESTMappings maps the observed kinds to their resources. Kinds that are not served by the API server, typically
+// because their CRD is not installed yet, are skipped rather than failing the rules for every binding. The skipped kinds
+// are stashed and reported with an event on the bindings that reference them.
+func observedRESTMappings(ctx context.Context, c reconcilers.Config, kinds *UnmappedKinds) ([]*meta.RESTMapping, error) {
+	log := logr.FromContextOrDiscard(ctx)
+
+	mappings := []*meta.RESTMapping{}
+	mapped := []schema.GroupVersionKind{}
+	unmapped := []schema.GroupVersionKind{}
+	for _, gvk := range RetrieveObservedGKVs(ctx) {
+		rm, err := c.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err != nil {
+			if !meta.IsNoMatchError(err) {
+				return nil, err
+			}
+			if !slices.Contains(unmapped, gvk) {
+				log.Info("ignoring kind, not served by the API server", "gvk", gvk)
+				unmapped = append(unmapped, gvk)
+			}
+			continue
+		}
+		mapped = append(mapped, gvk)
+		mappings = append(mappings, rm)
+	}
+
+	StashUnmappedGVKs(ctx, unmapped)
+	kinds.record(ctx, c, mapped, unmapped)
+
+	return mappings, nil
+}
+
+// UnmappedKinds tracks the kinds referenced by bindings that are not served by the API server. A single tracker is
+// shared by the webhook reconcilers, so each binding is warned once per kind no matter how many reconcilers observe the
+// kind, and the API server's kinds are only rediscovered as CRDs change.
+type UnmappedKinds struct {
+	m               sync.Mutex
+	unmapped        sets.Set[schema.GroupVersionKind]
+	reported        sets.Set[unmappedKind]
+	resourceVersion string
+}
+
+type unmappedKind struct {
+	uid types.UID
+	gvk schema.GroupVersionKind
+}
+
+func NewUnmappedKinds() *UnmappedKinds {
+	return &UnmappedKinds{
+		unmapped: sets.New[schema.GroupVersionKind](),
+		reported: sets.New[unmappedKind](),
+	}
+}
+
+// record warns each binding that references an unmapped kind, unless the binding was already warned about the kind.
+// Kinds that are now mapped are forgotten, so they are reported again should they go away.
+func (k *UnmappedKinds) record(ctx context.Context, c reconcilers.Config, mapped, unmapped []schema.GroupVersionKind) {
+	k.m.Lock()
+	defer k.m.Unlock()
+
+	for _, gvk := range mapped {
+		k.unmapped.Delete(gvk)
+	}
+	for key := range k.reported {
+		if slices.Contains(mapped, key.gvk) {
+			k.reported.Delete(key)
+		}
+	}
+	k.unmapped.Insert(unmapped...)
+	if len(unmapped) == 0 {
+		return
+	}
+
+	record := func(binding client.Object, refs ...schema.GroupVersionKind) {
+		for _, ref := range refs {
+			key := unmappedKind{uid: binding.GetUID(), gvk: ref}
+			if !slices.Contains(unmapped, ref) || k.reported.Has(key) {
+				continue
+			}
+			k.reported.Insert(key)
+			c.Recorder.Eventf(binding, corev1.EventTypeWarning, "UnmappedKind",
+				"Kind %s in %s is not served by the API server and is ignored by the webhooks until it is installed", ref.Kind, ref.GroupVersion())
+		}
+	}
+	serviceBindings := RetrieveServiceBindings(ctx)
+	for i := range serviceBindings {
+		spec := serviceBindings[i].Spec
+		record(&serviceBindings[i],
+			schema.FromAPIVersionAndKind(spec.Service.APIVersion, spec.Service.Kind),
+			schema.FromAPIVersionAndKind(spec.Workload.APIVersion, spec.Workload.Kind),
+		)
+	}
+	clusterServiceBindings := RetrieveClusterServiceBindings(ctx)
+	for i := range clusterServiceBindings {
+		spec := clusterServiceBindings[i].Spec
+		record(&clusterServiceBindings[i],
+			schema.FromAPIVersionAndKind(spec.Service.APIVersion, spec.Service.Kind),
+			schema.FromAPIVersionAndKind(spec.Workload.APIVersion, spec.Workload.Kind),
+		)
+	}
+}
+
+// rediscover resets the REST mapper when a CRD in the group of an unmapped kind changes or a CRD is deleted, so the
+// kinds the CRD serves are discovered again. Each change of a CRD resets the mapper once, however many reconcilers
+// watch CRDs.
+func (k *UnmappedKinds) rediscover(mapper meta.RESTMapper, crd client.Object) {
+	k.m.Lock()
+	defer k.m.Unlock()
+
+	if crd.GetResourceVersion() == k.resourceVersion {
+		return
+	}
+	// CRDs are named <plural>.<group>
+	_, group, _ := strings.Cut(crd.GetName(), ".")
+	relevant := crd.GetDeletionTimestamp() != nil
+	for gvk := range k.unmapped {
+		relevant = relevant || gvk.Group == group
+	}
+	if !relevant {
+		return
+	}
+	k.resourceVersion = crd.GetResourceVersion()
+	if mapper, ok := mapper.(meta.ResettableRESTMapper); ok {
+		mapper.Reset()
+	}
+}
+
+//+kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch
+
+// watchCustomResourceDefinitions enqueues the request as CRDs change, so kinds that were skipped because they were not
+// served are picked up once their CRD is installed. The API server's kinds are rediscovered before the request is
+// enqueued.
+func watchCustomResourceDefinitions(mgr controllerruntime.Manager, bldr *builder.Builder, req reconcile.Request, kinds *UnmappedKinds) {
+	crd := &metav1.PartialObjectMetadata{}
+	crd.SetGroupVersionKind(schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"})
+	bldr.Watches(crd, handler.EnqueueRequestsFromMapFunc(
+		func(ctx context.Context, o client.Object) []reconcile.Request {
+			kinds.rediscover(mgr.GetRESTMapper(), o)
+			return []reconcile.Request{req}
+		},
+	))
+}
+
 const ServiceBindingsStashKey reconcilers.StashKey = "servicebinding.io:servicebindings"
 
 func StashServiceBindings(ctx context.Context, serviceBindings []servicebindingv1.ServiceBinding) {
@@ -419,6 +1152,48 @@ func RetrieveServiceBindings(ctx context.Context) []servicebindingv1.ServiceBind
 	return nil
 }
 
+const ClusterServiceBindingsStashKey reconcilers.StashKey = "servicebinding.io:clusterservicebindings"
+
+func StashClusterServiceBindings(ctx context.Context, clusterServiceBindings []servicebindingv1.ClusterServiceBinding) {
+	reconcilers.StashValue(ctx, ClusterServiceBindingsStashKey, clusterServiceBindings)
+}
+
+func RetrieveClusterServiceBindings(ctx context.Context) []servicebindingv1.ClusterServiceBinding {
+	value := reconcilers.RetrieveValue(ctx, ClusterServiceBindingsStashKey)
+	if clusterServiceBindings, ok := value.([]servicebindingv1.ClusterServiceBinding); ok {
+		return clusterServiceBindings
+	}
+	return nil
+}
+
+const UnmappedGVKsStashKey reconcilers.StashKey = "servicebinding.io:unmappedgvks"
+
+func StashUnmappedGVKs(ctx context.Context, gvks []schema.GroupVersionKind) {
+	reconcilers.StashValue(ctx, UnmappedGVKsStashKey, gvks)
+}
+
+func RetrieveUnmappedGVKs(ctx context.Context) []schema.GroupVersionKind {
+	value := reconcilers.RetrieveValue(ctx, UnmappedGVKsStashKey)
+	if gvks, ok := value.([]schema.GroupVersionKind); ok {
+		return gvks
+	}
+	return nil
+}
+
+const WebhookNamespacesStashKey reconcilers.StashKey = "servicebinding.io:webhooknamespaces"
+
+func StashWebhookNamespaces(ctx context.Context, namespaces []string) {
+	reconcilers.StashValue(ctx, WebhookNamespacesStashKey, namespaces)
+}
+
+func RetrieveWebhookNamespaces(ctx context.Context) []string {
+	value := reconcilers.RetrieveValue(ctx, WebhookNamespacesStashKey)
+	if namespaces, ok := value.([]string); ok {
+		return namespaces
+	}
+	return nil
+}
+
 const ObservedGVKsStashKey reconcilers.StashKey = "servicebinding.io:observedgvks"
 
 func StashObservedGVKs(ctx context.Context, gvks []schema.GroupVersionKind) {
@@ -450,13 +1225,225 @@ func RetrieveWebhookRules(ctx context.Context) []admissionregistrationv1.RuleWit
 const WorkloadRefIndexKey = ".metadata.workloadRef"
 
 func WorkloadRefIndexFunc(obj client.Object) []string {
-	serviceBinding := obj.(*servicebindingv1.ServiceBinding)
-	gvk := schema.FromAPIVersionAndKind(serviceBinding.Spec.Workload.APIVersion, serviceBinding.Spec.Workload.Kind)
+	var workload servicebindingv1.ServiceBindingWorkloadReference
+	switch serviceBinding := obj.(type) {
+	case *servicebindingv1.ServiceBinding:
+		workload = serviceBinding.Spec.Workload
+	case *servicebindingv1.ClusterServiceBinding:
+		workload = serviceBinding.Spec.Workload
+	default:
+		return nil
+	}
+	gvk := schema.FromAPIVersionAndKind(workload.APIVersion, workload.Kind)
 	return []string{
 		workloadRefIndexValue(gvk.Group, gvk.Kind),
 	}
 }
 
+// projectServiceBindings projects the bindings into the workload, calling the binding projection hooks around each
+// binding. Suspended bindings keep the projection the workload already has, changes are projected once the binding is
+// resumed.
+func projectServiceBindings(ctx context.Context, hooks lifecycle.ServiceBindingHooks, projector projector.ServiceBindingProjector, serviceBindings []servicebindingv1.ServiceBinding, workload *unstructured.Unstructured) error {
+	for i := range serviceBindings {
+		if serviceBindings[i].Spec.Suspend {
+			continue
+		}
+		sb := serviceBindings[i].DeepCopy()
+		(&servicebindingv1.ServiceBinding{}).Default(ctx, sb)
+		if f := hooks.ServiceBindingPreProjection; f != nil {
+			if err := f(ctx, sb); err != nil {
+				return err
+			}
+		}
+		if err := projector.Project(ctx, sb, workload); err != nil {
+			return err
+		}
+		if f := hooks.ServiceBindingPostProjection; f != nil {
+			if err := f(ctx, sb); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+var podRESTMapping = &meta.RESTMapping{
+	GroupVersionKind: corev1.SchemeGroupVersion.WithKind("Pod"),
+	Resource:         corev1.SchemeGroupVersion.WithResource("pods"),
+	Scope:            meta.RESTScopeNamespace,
+}
+
+// podMapping locates the containers and volumes of a pod, in place of the pod template of a PodSpecable workload
+func podMapping() *servicebindingv1.ClusterWorkloadResourceMappingSpec {
+	return &servicebindingv1.ClusterWorkloadResourceMappingSpec{
+		Versions: []servicebindingv1.ClusterWorkloadResourceMappingTemplate{
+			{
+				Version:     "*",
+				Annotations: ".metadata.annotations",
+				Containers: []servicebindingv1.ClusterWorkloadResourceMappingContainer{
+					{
+						Path: ".spec.initContainers[*]",
+						Name: ".name",
+					},
+					{
+						Path: ".spec.containers[*]",
+						Name: ".name",
+					},
+				},
+				Volumes: ".spec.volumes",
+			},
+		},
+	}
+}
+
+// podProjector projects bindings into pods, independent of the mappings for the workload controlling the pod
+func podProjector(hooks lifecycle.ServiceBindingHooks) projector.ServiceBindingProjector {
+	return hooks.GetProjector(projector.NewStaticMapping(podMapping(), podRESTMapping))
+}
+
+// podOwnerDepth limits how far the controllers of a pod are followed, a Deployment controls a ReplicaSet that controls
+// the pod
+const podOwnerDepth = 5
+
+// podOwnerTimeout bounds resolving the controllers of a pod, the API server holds the pod creation while the webhook
+// looks them up
+const podOwnerTimeout = 2 * time.Second
+
+// resolvePodOwners returns the controllers of the pod, starting with the pod's direct controller. Controllers that no
+// longer exist or may not be read end the chain. Only the metadata of each controller is resolved, the lookups are read
+// from the API server and fail once podOwnerTimeout passes.
+func resolvePodOwners(ctx context.Context, c reconcilers.Config, pod *unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+	ctx, cancel := context.WithTimeout(ctx, podOwnerTimeout)
+	defer cancel()
+
+	owners := []*unstructured.Unstructured{}
+	var current metav1.Object = pod
+	for i := 0; i < podOwnerDepth; i++ {
+		ref := metav1.GetControllerOf(current)
+		if ref == nil {
+			break
+		}
+		owner := &metav1.PartialObjectMetadata{}
+		owner.SetGroupVersionKind(schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind))
+		if err := c.APIReader.Get(ctx, client.ObjectKey{Namespace: pod.GetNamespace(), Name: ref.Name}, owner); err != nil {
+			if apierrs.IsNotFound(err) || apierrs.IsForbidden(err) || meta.IsNoMatchError(err) {
+				break
+			}
+			return nil, err
+		}
+		workload := &unstructured.Unstructured{}
+		workload.SetAPIVersion(ref.APIVersion)
+		workload.SetKind(ref.Kind)
+		workload.SetNamespace(owner.Namespace)
+		workload.SetName(owner.Name)
+		workload.SetUID(owner.UID)
+		workload.SetLabels(owner.Labels)
+		workload.SetAnnotations(owner.Annotations)
+		workload.SetOwnerReferences(owner.OwnerReferences)
+		owners = append(owners, workload)
+		current = owner
+	}
+	return owners, nil
+}
+
+// podServiceBindingsFor retargets the bindings of a controller of the pod to the pod. The pod may not have a name yet,
+// so it is targeted by its direct controller.
+func podServiceBindingsFor(serviceBindings []servicebindingv1.ServiceBinding, pod *unstructured.Unstructured) []servicebindingv1.ServiceBinding {
+	controller := metav1.GetControllerOf(pod)
+	podServiceBindings := make([]servicebindingv1.ServiceBinding, len(serviceBindings))
+	for i := range serviceBindings {
+		podServiceBindings[i] = *serviceBindings[i].DeepCopy()
+		podServiceBindings[i].Spec.Workload = servicebindingv1.ServiceBindingWorkloadReference{
+			APIVersion: "v1",
+			Kind:       "Pod",
+			Owner: &servicebindingv1.ServiceBindingWorkloadOwnerReference{
+				APIVersion: controller.APIVersion,
+				Kind:       controller.Kind,
+				Name:       controller.Name,
+			},
+			Containers: serviceBindings[i].Spec.Workload.Containers,
+		}
+	}
+	return podServiceBindings
+}
+
+// resolveActiveServiceBindings finds the bindings targeting the workload that are not terminating. ClusterServiceBindings
+// selecting the workload's namespace are resolved as a ServiceBinding in the namespace.
+func resolveActiveServiceBindings(ctx context.Context, c reconcilers.Config, projector projector.ServiceBindingProjector, workload *unstructured.Unstructured) ([]servicebindingv1.ServiceBinding, error) {
+	// find matching service bindings
+	serviceBindings := &servicebindingv1.ServiceBindingList{}
+	gvk := schema.FromAPIVersionAndKind(workload.GetAPIVersion(), workload.GetKind())
+	if err := c.List(ctx, serviceBindings, client.InNamespace(workload.GetNamespace()), client.MatchingFields{WorkloadRefIndexKey: workloadRefIndexValue(gvk.Group, gvk.Kind)}); err != nil {
+		return nil, err
+	}
+
+	// check that bindings are for this workload
+	activeServiceBindings := []servicebindingv1.ServiceBinding{}
+	for _, sb := range serviceBindings.Items {
+		if !sb.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if isBoundWorkload(ctx, projector, &sb, workload) {
+			activeServiceBindings = append(activeServiceBindings, sb)
+		}
+	}
+
+	// find matching cluster service bindings, bound as a service binding in the workload's namespace
+	clusterServiceBindings := &servicebindingv1.ClusterServiceBindingList{}
+	if err := c.List(ctx, clusterServiceBindings, client.MatchingFields{WorkloadRefIndexKey: workloadRefIndexValue(gvk.Group, gvk.Kind)}); err != nil {
+		return nil, err
+	}
+	var namespace *corev1.Namespace
+	for _, csb := range clusterServiceBindings.Items {
+		if !csb.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if namespace == nil {
+			namespace = &corev1.Namespace{}
+			if err := c.Get(ctx, client.ObjectKey{Name: workload.GetNamespace()}, namespace); err != nil {
+				return nil, err
+			}
+		}
+		selector, err := metav1.LabelSelectorAsSelector(csb.Spec.NamespaceSelector)
+		if err != nil || !selector.Matches(labels.Set(namespace.Labels)) {
+			continue
+		}
+		sb := csb.ServiceBindingFor(workload.GetNamespace())
+		if isBoundWorkload(ctx, projector, sb, workload) {
+			activeServiceBindings = append(activeServiceBindings, *sb)
+		}
+	}
+
+	return activeServiceBindings, nil
+}
+
+// isBoundWorkload returns true if the service binding targets the workload, or is already projected into it.
+func isBoundWorkload(ctx context.Context, projector projector.ServiceBindingProjector, serviceBinding *servicebindingv1.ServiceBinding, workload *unstructured.Unstructured) bool {
+	if projector.IsProjected(ctx, serviceBinding, workload) {
+		return true
+	}
+	ref := serviceBinding.Spec.Workload
+	if ref.Name == workload.GetName() {
+		return true
+	}
+	if ref.Name != "" || (ref.Selector == nil && ref.Owner == nil) {
+		return false
+	}
+	if ref.Selector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(ref.Selector)
+		if err != nil {
+			return false
+		}
+		if !selector.Matches(labels.Set(workload.GetLabels())) {
+			return false
+		}
+	}
+	if ref.Owner != nil && !ref.Owner.Controls(workload) {
+		return false
+	}
+	return true
+}
+
 func workloadRefIndexValue(group, kind string) string {
 	return schema.GroupKind{Group: group, Kind: kind}.String()
 }
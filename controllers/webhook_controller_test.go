@@ -18,10 +18,12 @@ package controllers_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
-	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
 	"gomodules.xyz/jsonpatch/v2"
 	admissionv1 "k8s.io/api/admission/v1"
@@ -35,6 +37,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	metadatafake "k8s.io/client-go/metadata/fake"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/ptr"
 	dieadmissionv1 "reconciler.io/dies/apis/admission/v1"
@@ -46,9 +49,7 @@ import (
 	rtesting "reconciler.io/runtime/testing"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
-	"sigs.k8s.io/controller-runtime/pkg/source"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	servicebindingv1 "github.com/servicebinding/runtime/apis/v1"
@@ -78,6 +79,11 @@ func TestAdmissionProjectorReconciler(t *testing.T) {
 					d.Name("my-service")
 				})
 			})
+			d.NamespaceSelector(&metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: corev1.LabelMetadataName, Operator: metav1.LabelSelectorOpIn, Values: []string{"my-namespace"}},
+				},
+			})
 			d.RulesDie(
 				dieadmissionregistrationv1.RuleWithOperationsBlank.
 					APIGroups("apps").
@@ -144,6 +150,185 @@ func TestAdmissionProjectorReconciler(t *testing.T) {
 				webhook,
 			},
 		},
+		"preserve namespace selector requirements": {
+			Request: request,
+			GivenObjects: []client.Object{
+				webhook.
+					WebhookDie("projector.servicebinding.io", func(d *dieadmissionregistrationv1.MutatingWebhookDie) {
+						d.NamespaceSelector(&metav1.LabelSelector{
+							MatchLabels: map[string]string{"bind": "true"},
+							MatchExpressions: []metav1.LabelSelectorRequirement{
+								{Key: "control-plane", Operator: metav1.LabelSelectorOpDoesNotExist},
+								{Key: corev1.LabelMetadataName, Operator: metav1.LabelSelectorOpNotIn, Values: []string{"kube-system"}},
+								{Key: corev1.LabelMetadataName, Operator: metav1.LabelSelectorOpIn, Values: []string{"other-namespace"}},
+							},
+						})
+					}),
+				serviceBinding,
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				allowSelfSubjectAccessReviewFor("apps", "deployments", "update"),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(webhook, scheme, corev1.EventTypeNormal, "Updated", "Updated MutatingWebhookConfiguration %q", name),
+			},
+			ExpectCreates: []client.Object{
+				selfSubjectAccessReviewFor("apps", "deployments", "update"),
+			},
+			ExpectUpdates: []client.Object{
+				webhook.
+					WebhookDie("projector.servicebinding.io", func(d *dieadmissionregistrationv1.MutatingWebhookDie) {
+						d.NamespaceSelector(&metav1.LabelSelector{
+							MatchLabels: map[string]string{"bind": "true"},
+							MatchExpressions: []metav1.LabelSelectorRequirement{
+								{Key: "control-plane", Operator: metav1.LabelSelectorOpDoesNotExist},
+								{Key: corev1.LabelMetadataName, Operator: metav1.LabelSelectorOpNotIn, Values: []string{"kube-system"}},
+								{Key: corev1.LabelMetadataName, Operator: metav1.LabelSelectorOpIn, Values: []string{"my-namespace"}},
+							},
+						})
+					}),
+			},
+		},
+		"manage named webhook among several": {
+			Request: request,
+			GivenObjects: []client.Object{
+				webhook.
+					WebhookDie("other.servicebinding.io", func(d *dieadmissionregistrationv1.MutatingWebhookDie) {
+						d.FailurePolicy(ptr.To(admissionregistrationv1.Fail))
+					}).
+					WebhookDie("projector.servicebinding.io", func(d *dieadmissionregistrationv1.MutatingWebhookDie) {
+						d.Rules()
+					}),
+				serviceBinding,
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				allowSelfSubjectAccessReviewFor("apps", "deployments", "update"),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(webhook, scheme, corev1.EventTypeNormal, "Updated", "Updated MutatingWebhookConfiguration %q", name),
+			},
+			ExpectCreates: []client.Object{
+				selfSubjectAccessReviewFor("apps", "deployments", "update"),
+			},
+			ExpectUpdates: []client.Object{
+				webhook.
+					WebhookDie("other.servicebinding.io", func(d *dieadmissionregistrationv1.MutatingWebhookDie) {
+						d.FailurePolicy(ptr.To(admissionregistrationv1.Fail))
+					}),
+			},
+		},
+		"set failure policy": {
+			Metadata: map[string]interface{}{
+				"FailurePolicy": admissionregistrationv1.Ignore,
+			},
+			Request: request,
+			GivenObjects: []client.Object{
+				webhook,
+				serviceBinding,
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				allowSelfSubjectAccessReviewFor("apps", "deployments", "update"),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(webhook, scheme, corev1.EventTypeNormal, "Updated", "Updated MutatingWebhookConfiguration %q", name),
+			},
+			ExpectCreates: []client.Object{
+				selfSubjectAccessReviewFor("apps", "deployments", "update"),
+			},
+			ExpectUpdates: []client.Object{
+				webhook.
+					WebhookDie("projector.servicebinding.io", func(d *dieadmissionregistrationv1.MutatingWebhookDie) {
+						d.FailurePolicy(ptr.To(admissionregistrationv1.Ignore))
+					}),
+			},
+		},
+		"add pod rule with pod projection": {
+			Metadata: map[string]interface{}{
+				"PodProjection": true,
+			},
+			Request: request,
+			GivenObjects: []client.Object{
+				webhook,
+				serviceBinding.
+					StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+						d.WorkloadsDie(
+							dieservicebindingv1.ServiceBindingWorkloadStatusBlank.
+								APIVersion("apps/v1").
+								Kind("Deployment").
+								Name("my-workload").
+								Result(servicebindingv1.ServiceBindingWorkloadSkipped).
+								Reason("PodProjection"),
+						)
+					}),
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				allowSelfSubjectAccessReviewFor("apps", "deployments", "update"),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(webhook, scheme, corev1.EventTypeNormal, "Updated", "Updated MutatingWebhookConfiguration %q", name),
+			},
+			ExpectCreates: []client.Object{
+				selfSubjectAccessReviewFor("apps", "deployments", "update"),
+			},
+			ExpectUpdates: []client.Object{
+				webhook.
+					WebhookDie("projector.servicebinding.io", func(d *dieadmissionregistrationv1.MutatingWebhookDie) {
+						d.RulesDie(
+							dieadmissionregistrationv1.RuleWithOperationsBlank.
+								APIGroups("apps").
+								APIVersions("*").
+								Resources("deployments").
+								Operations(
+									admissionregistrationv1.Create,
+									admissionregistrationv1.Update,
+								),
+							dieadmissionregistrationv1.RuleWithOperationsBlank.
+								APIGroups("").
+								APIVersions("v1").
+								Resources("pods").
+								Operations(
+									admissionregistrationv1.Create,
+								),
+						)
+					}),
+			},
+		},
+		"skip pod rule without pod projected bindings": {
+			Metadata: map[string]interface{}{
+				"PodProjection": true,
+			},
+			Request: request,
+			GivenObjects: []client.Object{
+				webhook,
+				serviceBinding,
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				allowSelfSubjectAccessReviewFor("apps", "deployments", "update"),
+			},
+			ExpectCreates: []client.Object{
+				selfSubjectAccessReviewFor("apps", "deployments", "update"),
+			},
+		},
+		"skip pod rule without bindings": {
+			Metadata: map[string]interface{}{
+				"PodProjection":  true,
+				"SchedulingGate": true,
+			},
+			Request: request,
+			GivenObjects: []client.Object{
+				webhook,
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(webhook, scheme, corev1.EventTypeNormal, "Updated", "Updated MutatingWebhookConfiguration %q", name),
+			},
+			ExpectUpdates: []client.Object{
+				webhook.
+					WebhookDie("projector.servicebinding.io", func(d *dieadmissionregistrationv1.MutatingWebhookDie) {
+						d.NamespaceSelector(nil)
+						d.Rules()
+					}),
+			},
+		},
 		"ignore other keys": {
 			Request: reconcilers.Request{
 				NamespacedName: types.NamespacedName{
@@ -175,7 +360,17 @@ func TestAdmissionProjectorReconciler(t *testing.T) {
 		restMapper := c.RESTMapper().(*meta.DefaultRESTMapper)
 		restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
 		accessChecker := rbac.NewAccessChecker(c, 0).WithVerb("update")
-		return controllers.AdmissionProjectorReconciler(c, name, accessChecker)
+		opts := controllers.WebhookOptions{
+			Name:        name,
+			WebhookName: "projector.servicebinding.io",
+		}
+		if failurePolicy, ok := tc.Metadata["FailurePolicy"].(admissionregistrationv1.FailurePolicyType); ok {
+			opts.FailurePolicy = &failurePolicy
+		}
+		pods := controllers.PodAdmission{}
+		pods.Projection, _ = tc.Metadata["PodProjection"].(bool)
+		pods.SchedulingGate, _ = tc.Metadata["SchedulingGate"].(bool)
+		return controllers.AdmissionProjectorReconciler(c, opts, accessChecker, controllers.NewUnmappedKinds(), pods)
 	})
 }
 
@@ -221,6 +416,35 @@ func TestAdmissionProjectorWebhook(t *testing.T) {
 			})
 		})
 
+	namespaceObj := diecorev1.NamespaceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(namespace)
+		})
+	clusterServiceBinding := dieservicebindingv1.ClusterServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(name)
+			d.UID(bindingUID)
+		}).
+		SpecDie(func(d *dieservicebindingv1.ClusterServiceBindingSpecDie) {
+			d.ServiceBindingSpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+				d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+					d.APIVersion("apps/v1")
+					d.Kind("Deployment")
+					d.Name(name)
+				})
+			})
+			d.NamespaceSelectorDie(func(d *diemetav1.LabelSelectorDie) {
+				d.AddMatchLabel("bind", "true")
+			})
+		}).
+		StatusDie(func(d *dieservicebindingv1.ClusterServiceBindingStatusDie) {
+			d.NamespaceDie(namespace, func(d *dieservicebindingv1.ClusterServiceBindingNamespaceStatusDie) {
+				d.BindingDie(func(d *dieservicebindingv1.ServiceBindingSecretReferenceDie) {
+					d.Name(secret)
+				})
+			})
+		})
+
 	request := dieadmissionv1.AdmissionRequestBlank.
 		UID(requestUID).
 		Operation(admissionv1.Create)
@@ -228,8 +452,134 @@ func TestAdmissionProjectorWebhook(t *testing.T) {
 		Allowed(true)
 
 	addWorkloadRefIndex := func(cb *fake.ClientBuilder) *fake.ClientBuilder {
-		return cb.WithIndex(&servicebindingv1.ServiceBinding{}, controllers.WorkloadRefIndexKey, controllers.WorkloadRefIndexFunc)
+		return cb.
+			WithIndex(&servicebindingv1.ServiceBinding{}, controllers.WorkloadRefIndexKey, controllers.WorkloadRefIndexFunc).
+			WithIndex(&servicebindingv1.ClusterServiceBinding{}, controllers.WorkloadRefIndexKey, controllers.WorkloadRefIndexFunc)
+	}
+
+	// the patch projecting serviceBinding into workload
+	projectionPatches := []jsonpatch.Operation{
+		{
+			Operation: "add",
+			Path:      "/metadata/annotations",
+			Value: map[string]interface{}{
+				fmt.Sprintf("projector.servicebinding.io/mapping-%s", bindingUID): podSpecableMapping,
+			},
+		},
+		{
+			Operation: "add",
+			Path:      "/spec/template/metadata/annotations",
+			Value: map[string]interface{}{
+				fmt.Sprintf("projector.servicebinding.io/secret-%s", bindingUID): secret,
+			},
+		},
+		{
+			Operation: "add",
+			Path:      "/spec/template/spec/containers/0/env",
+			Value: []interface{}{
+				map[string]interface{}{
+					"name":  "SERVICE_BINDING_ROOT",
+					"value": "/bindings",
+				},
+			},
+		},
+		{
+			Operation: "add",
+			Path:      "/spec/template/spec/containers/0/volumeMounts",
+			Value: []interface{}{
+				map[string]interface{}{
+					"name":      fmt.Sprintf("servicebinding-%s", bindingUID),
+					"mountPath": "/bindings/my-workload",
+					"readOnly":  true,
+				},
+			},
+		},
+		{
+			Operation: "add",
+			Path:      "/spec/template/spec/volumes",
+			Value: []interface{}{
+				map[string]interface{}{
+					"name": fmt.Sprintf("servicebinding-%s", bindingUID),
+					"projected": map[string]interface{}{
+						"defaultMode": float64(projector.VolumeDefaultMode),
+						"sources": []interface{}{
+							map[string]interface{}{
+								"secret": map[string]interface{}{
+									"name": secret,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
+	projectionPatchesJSON, err := json.Marshal(projectionPatches)
+	utilruntime.Must(err)
+	auditResponse := response.
+		Warnings(
+			"audit mode: projecting service bindings would add /metadata/annotations",
+			"audit mode: projecting service bindings would add /spec/template/metadata/annotations",
+			"audit mode: projecting service bindings would add /spec/template/spec/containers/0/env",
+			"audit mode: projecting service bindings would add /spec/template/spec/containers/0/volumeMounts",
+			"audit mode: projecting service bindings would add /spec/template/spec/volumes",
+		).
+		AuditAnnotations(map[string]string{
+			"projection-patch": string(projectionPatchesJSON),
+			"service-bindings": name,
+		})
+	boundServiceBinding := serviceBinding.SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+		d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+			d.APIVersion("apps/v1")
+			d.Kind("Deployment")
+			d.Name(name)
+		})
+	})
+
+	podProjectedWorkload := workload.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.UID("d6a3b2f1-5f0e-4a47-9e0c-1d2b8e6f4c3a")
+			d.AddAnnotation(controllers.ProjectionAnnotationKey, controllers.ProjectionPod)
+		})
+	replicaSet := dieappsv1.ReplicaSetBlank.
+		APIVersion("apps/v1").
+		Kind("ReplicaSet").
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(fmt.Sprintf("%s-abc", name))
+			d.UID("4e1c7b0a-8f3d-4c2e-b5a6-9d7e2f1c0b8a")
+			d.OwnerReferences(metav1.OwnerReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       name,
+				UID:        "d6a3b2f1-5f0e-4a47-9e0c-1d2b8e6f4c3a",
+				Controller: ptr.To(true),
+			})
+		})
+	// the Deployment controller copies the workload's annotations onto its ReplicaSets
+	podProjectedReplicaSet := replicaSet.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.AddAnnotation(controllers.ProjectionAnnotationKey, controllers.ProjectionPod)
+		})
+	pod := diecorev1.PodBlank.
+		APIVersion("v1").
+		Kind("Pod").
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.GenerateName(fmt.Sprintf("%s-abc-", name))
+			d.OwnerReferences(metav1.OwnerReference{
+				APIVersion: "apps/v1",
+				Kind:       "ReplicaSet",
+				Name:       fmt.Sprintf("%s-abc", name),
+				UID:        "4e1c7b0a-8f3d-4c2e-b5a6-9d7e2f1c0b8a",
+				Controller: ptr.To(true),
+			})
+		}).
+		SpecDie(func(d *diecorev1.PodSpecDie) {
+			d.ContainerDie("workload", func(d *diecorev1.ContainerDie) {
+				d.Image("scratch")
+			})
+		})
 
 	wts := rtesting.AdmissionWebhookTests{
 		"no binding targeting workload": {
@@ -321,6 +671,27 @@ func TestAdmissionProjectorWebhook(t *testing.T) {
 				AdmissionResponse: response.DieRelease(),
 			},
 		},
+		"suspended binding not projected": {
+			WithClientBuilder: addWorkloadRefIndex,
+			GivenObjects: []client.Object{
+				serviceBinding.SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name(name)
+					})
+					d.Suspend(true)
+				}),
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(workload.DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+			},
+		},
 		"binding projected by name": {
 			WithClientBuilder: addWorkloadRefIndex,
 			GivenObjects: []client.Object{
@@ -473,22 +844,13 @@ func TestAdmissionProjectorWebhook(t *testing.T) {
 				},
 			},
 		},
-		"ingore terminating bindings": {
+		"binding audited in audit mode": {
+			Metadata: map[string]interface{}{
+				"AdmissionMode": controllers.AdmissionModeAudit,
+			},
 			WithClientBuilder: addWorkloadRefIndex,
 			GivenObjects: []client.Object{
-				serviceBinding.
-					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
-						now := metav1.Now()
-						d.DeletionTimestamp(&now)
-						d.Finalizers("servicebinding.io/finalizer")
-					}).
-					SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
-						d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
-							d.APIVersion("apps/v1")
-							d.Kind("Deployment")
-							d.Name(name)
-						})
-					}),
+				boundServiceBinding,
 			},
 			Request: &admission.Request{
 				AdmissionRequest: request.
@@ -496,13 +858,16 @@ func TestAdmissionProjectorWebhook(t *testing.T) {
 					DieRelease(),
 			},
 			ExpectedResponse: admission.Response{
-				AdmissionResponse: response.DieRelease(),
+				AdmissionResponse: auditResponse.DieRelease(),
 			},
 		},
-		"error loading bindings": {
+		"binding audited in namespace labeled for audit": {
 			WithClientBuilder: addWorkloadRefIndex,
-			WithReactors: []rtesting.ReactionFunc{
-				rtesting.InduceFailure("list", "ServiceBindingList"),
+			GivenObjects: []client.Object{
+				namespaceObj.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.AddLabel(controllers.AdmissionModeLabelKey, string(controllers.AdmissionModeAudit))
+				}),
+				boundServiceBinding,
 			},
 			Request: &admission.Request{
 				AdmissionRequest: request.
@@ -510,29 +875,832 @@ func TestAdmissionProjectorWebhook(t *testing.T) {
 					DieRelease(),
 			},
 			ExpectedResponse: admission.Response{
-				AdmissionResponse: response.
-					Allowed(false).
-					ResultDie(func(d *diemetav1.StatusDie) {
-						d.Code(500)
-						d.Message("inducing failure for list ServiceBindingList")
-					}).
+				AdmissionResponse: auditResponse.DieRelease(),
+			},
+		},
+		"binding projected in namespace labeled for enforce": {
+			Metadata: map[string]interface{}{
+				"AdmissionMode": controllers.AdmissionModeAudit,
+			},
+			WithClientBuilder: addWorkloadRefIndex,
+			GivenObjects: []client.Object{
+				namespaceObj.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.AddLabel(controllers.AdmissionModeLabelKey, string(controllers.AdmissionModeEnforce))
+				}),
+				boundServiceBinding,
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(workload.DieReleaseRawExtension()).
 					DieRelease(),
 			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+				Patches:           projectionPatches,
+			},
 		},
-	}
-	wts.Run(t, scheme, func(t *testing.T, tc *rtesting.AdmissionWebhookTestCase, c reconcilers.Config) *admission.Webhook {
-		restMapper := c.RESTMapper().(*meta.DefaultRESTMapper)
-		restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
-		return controllers.AdmissionProjectorWebhook(c, lifecycle.ServiceBindingHooks{}).Build()
-	})
-}
-
-func TestTriggerReconciler(t *testing.T) {
-	name := "my-webhook"
-	request := reconcilers.Request{NamespacedName: types.NamespacedName{Name: name}}
-
-	scheme := runtime.NewScheme()
-	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+		"pod projected workload not projected": {
+			WithClientBuilder: addWorkloadRefIndex,
+			GivenObjects: []client.Object{
+				boundServiceBinding,
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(podProjectedWorkload.DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+			},
+		},
+		"binding projected into pod of pod projected workload": {
+			Metadata: map[string]interface{}{
+				"PodProjection": true,
+			},
+			WithClientBuilder: addWorkloadRefIndex,
+			GivenObjects: []client.Object{
+				boundServiceBinding,
+			},
+			APIGivenObjects: []client.Object{
+				podProjectedWorkload,
+				podProjectedReplicaSet,
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(pod.DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+				Patches: []jsonpatch.Operation{
+					{
+						Operation: "add",
+						Path:      "/metadata/annotations",
+						Value: map[string]interface{}{
+							fmt.Sprintf("projector.servicebinding.io/secret-%s", bindingUID): secret,
+						},
+					},
+					{
+						Operation: "add",
+						Path:      "/spec/volumes",
+						Value: []interface{}{
+							map[string]interface{}{
+								"name": fmt.Sprintf("servicebinding-%s", bindingUID),
+								"projected": map[string]interface{}{
+									"defaultMode": float64(projector.VolumeDefaultMode),
+									"sources": []interface{}{
+										map[string]interface{}{
+											"secret": map[string]interface{}{
+												"name": secret,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					{
+						Operation: "add",
+						Path:      "/spec/containers/0/env",
+						Value: []interface{}{
+							map[string]interface{}{
+								"name":  "SERVICE_BINDING_ROOT",
+								"value": "/bindings",
+							},
+						},
+					},
+					{
+						Operation: "add",
+						Path:      "/spec/containers/0/volumeMounts",
+						Value: []interface{}{
+							map[string]interface{}{
+								"name":      fmt.Sprintf("servicebinding-%s", bindingUID),
+								"mountPath": "/bindings/my-workload",
+								"readOnly":  true,
+							},
+						},
+					},
+				},
+			},
+		},
+		"pod not projected without pod projection": {
+			WithClientBuilder: addWorkloadRefIndex,
+			GivenObjects: []client.Object{
+				boundServiceBinding,
+			},
+			APIGivenObjects: []client.Object{
+				podProjectedWorkload,
+				podProjectedReplicaSet,
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(pod.DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+			},
+		},
+		"pod of workload without pod projection not projected": {
+			Metadata: map[string]interface{}{
+				"PodProjection": true,
+			},
+			WithClientBuilder: addWorkloadRefIndex,
+			GivenObjects: []client.Object{
+				boundServiceBinding,
+			},
+			APIGivenObjects: []client.Object{
+				workload,
+				replicaSet,
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(pod.DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+			},
+		},
+		"pod gated until bindings of its controllers are ready": {
+			Metadata: map[string]interface{}{
+				"SchedulingGate": true,
+			},
+			WithClientBuilder: addWorkloadRefIndex,
+			GivenObjects: []client.Object{
+				boundServiceBinding,
+			},
+			APIGivenObjects: []client.Object{
+				workload,
+				replicaSet,
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(pod.DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+				Patches: []jsonpatch.Operation{
+					{
+						Operation: "add",
+						Path:      "/metadata/labels",
+						Value: map[string]interface{}{
+							controllers.GatedPodLabelKey: "true",
+						},
+					},
+					{
+						Operation: "add",
+						Path:      "/metadata/annotations",
+						Value: map[string]interface{}{
+							controllers.SchedulingGateBindingsAnnotationKey: string(bindingUID),
+						},
+					},
+					{
+						Operation: "add",
+						Path:      "/spec/schedulingGates",
+						Value: []interface{}{
+							map[string]interface{}{
+								"name": controllers.SchedulingGateName,
+							},
+						},
+					},
+				},
+			},
+		},
+		"pod not gated when bindings are ready": {
+			Metadata: map[string]interface{}{
+				"SchedulingGate": true,
+			},
+			WithClientBuilder: addWorkloadRefIndex,
+			GivenObjects: []client.Object{
+				boundServiceBinding.
+					StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+						d.ConditionsDie(
+							dieservicebindingv1.ServiceBindingConditionReady.True(),
+							dieservicebindingv1.ServiceBindingConditionServiceAvailable.True(),
+							dieservicebindingv1.ServiceBindingConditionSecretValid.True(),
+							dieservicebindingv1.ServiceBindingConditionWorkloadProjected.True(),
+						)
+					}),
+			},
+			APIGivenObjects: []client.Object{
+				workload,
+				replicaSet,
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(pod.DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+			},
+		},
+		"readiness gate added to pod with projected bindings": {
+			Metadata: map[string]interface{}{
+				"ReadinessGate": true,
+			},
+			WithClientBuilder: addWorkloadRefIndex,
+			APIGivenObjects: []client.Object{
+				workload,
+				replicaSet,
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(
+						pod.
+							MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+								d.AddAnnotation(fmt.Sprintf("projector.servicebinding.io/secret-%s", bindingUID), secret)
+							}).
+							DieReleaseRawExtension(),
+					).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+				Patches: []jsonpatch.Operation{
+					{
+						Operation: "add",
+						Path:      "/metadata/labels",
+						Value: map[string]interface{}{
+							controllers.GatedPodLabelKey: "true",
+						},
+					},
+					{
+						Operation: "add",
+						Path:      "/spec/readinessGates",
+						Value: []interface{}{
+							map[string]interface{}{
+								"conditionType": string(controllers.ReadinessGateConditionType),
+							},
+						},
+					},
+				},
+			},
+		},
+		"readiness gate not added to pod without projected bindings": {
+			Metadata: map[string]interface{}{
+				"ReadinessGate": true,
+			},
+			WithClientBuilder: addWorkloadRefIndex,
+			APIGivenObjects: []client.Object{
+				workload,
+				replicaSet,
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(pod.DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+			},
+		},
+		"cluster binding projected in selected namespace": {
+			WithClientBuilder: addWorkloadRefIndex,
+			GivenObjects: []client.Object{
+				namespaceObj.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.AddLabel("bind", "true")
+				}),
+				clusterServiceBinding,
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(workload.DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+				Patches: []jsonpatch.Operation{
+					{
+						Operation: "add",
+						Path:      "/metadata/annotations",
+						Value: map[string]interface{}{
+							fmt.Sprintf("projector.servicebinding.io/mapping-%s", bindingUID): podSpecableMapping,
+						},
+					},
+					{
+						Operation: "add",
+						Path:      "/spec/template/metadata/annotations",
+						Value: map[string]interface{}{
+							fmt.Sprintf("projector.servicebinding.io/secret-%s", bindingUID): secret,
+						},
+					},
+					{
+						Operation: "add",
+						Path:      "/spec/template/spec/containers/0/env",
+						Value: []interface{}{
+							map[string]interface{}{
+								"name":  "SERVICE_BINDING_ROOT",
+								"value": "/bindings",
+							},
+						},
+					},
+					{
+						Operation: "add",
+						Path:      "/spec/template/spec/containers/0/volumeMounts",
+						Value: []interface{}{
+							map[string]interface{}{
+								"name":      fmt.Sprintf("servicebinding-%s", bindingUID),
+								"mountPath": "/bindings/my-workload",
+								"readOnly":  true,
+							},
+						},
+					},
+					{
+						Operation: "add",
+						Path:      "/spec/template/spec/volumes",
+						Value: []interface{}{
+							map[string]interface{}{
+								"name": fmt.Sprintf("servicebinding-%s", bindingUID),
+								"projected": map[string]interface{}{
+									"defaultMode": float64(projector.VolumeDefaultMode),
+									"sources": []interface{}{
+										map[string]interface{}{
+											"secret": map[string]interface{}{
+												"name": secret,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"cluster binding ignored in unselected namespace": {
+			WithClientBuilder: addWorkloadRefIndex,
+			GivenObjects: []client.Object{
+				namespaceObj,
+				clusterServiceBinding,
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(workload.DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+			},
+		},
+		"ingore terminating bindings": {
+			WithClientBuilder: addWorkloadRefIndex,
+			GivenObjects: []client.Object{
+				serviceBinding.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						now := metav1.Now()
+						d.DeletionTimestamp(&now)
+						d.Finalizers("servicebinding.io/finalizer")
+					}).
+					SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+						d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+							d.APIVersion("apps/v1")
+							d.Kind("Deployment")
+							d.Name(name)
+						})
+					}),
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(workload.DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+			},
+		},
+		"error loading bindings": {
+			WithClientBuilder: addWorkloadRefIndex,
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("list", "ServiceBindingList"),
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(workload.DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.
+					Allowed(false).
+					ResultDie(func(d *diemetav1.StatusDie) {
+						d.Code(500)
+						d.Message("inducing failure for list ServiceBindingList")
+					}).
+					DieRelease(),
+			},
+		},
+	}
+	wts.Run(t, scheme, func(t *testing.T, tc *rtesting.AdmissionWebhookTestCase, c reconcilers.Config) *admission.Webhook {
+		restMapper := c.RESTMapper().(*meta.DefaultRESTMapper)
+		restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+		mode := controllers.AdmissionModeEnforce
+		if m, ok := tc.Metadata["AdmissionMode"].(controllers.AdmissionMode); ok {
+			mode = m
+		}
+		pods := controllers.PodAdmission{}
+		pods.Projection, _ = tc.Metadata["PodProjection"].(bool)
+		pods.SchedulingGate, _ = tc.Metadata["SchedulingGate"].(bool)
+		pods.ReadinessGate, _ = tc.Metadata["ReadinessGate"].(bool)
+		return controllers.AdmissionProjectorWebhook(c, lifecycle.ServiceBindingHooks{}, mode, pods).Build()
+	})
+}
+
+func TestProjectionGuardReconciler(t *testing.T) {
+	name := "my-webhook"
+	request := reconcilers.Request{NamespacedName: types.NamespacedName{Name: name}}
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1.AddToScheme(scheme))
+
+	webhook := dieadmissionregistrationv1.ValidatingWebhookConfigurationBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(name)
+		}).
+		WebhookDie("trigger.servicebinding.io", func(d *dieadmissionregistrationv1.ValidatingWebhookDie) {
+			d.ClientConfigDie(func(d *dieadmissionregistrationv1.WebhookClientConfigDie) {
+				d.ServiceDie(func(d *dieadmissionregistrationv1.ServiceReferenceDie) {
+					d.Namespace("my-system")
+					d.Name("my-service")
+				})
+			})
+		}).
+		WebhookDie("guard.servicebinding.io", func(d *dieadmissionregistrationv1.ValidatingWebhookDie) {
+			d.ClientConfigDie(func(d *dieadmissionregistrationv1.WebhookClientConfigDie) {
+				d.ServiceDie(func(d *dieadmissionregistrationv1.ServiceReferenceDie) {
+					d.Namespace("my-system")
+					d.Name("my-service")
+				})
+			})
+			d.NamespaceSelector(&metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: corev1.LabelMetadataName, Operator: metav1.LabelSelectorOpIn, Values: []string{"my-namespace"}},
+				},
+			})
+			d.RulesDie(
+				dieadmissionregistrationv1.RuleWithOperationsBlank.
+					APIGroups("apps").
+					APIVersions("*").
+					Resources("deployments").
+					Operations(
+						admissionregistrationv1.Update,
+					),
+			)
+		})
+
+	serviceBinding := dieservicebindingv1.ServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace("my-namespace")
+			d.Name("my-binding")
+		}).
+		SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+			d.ServiceDie(func(d *dieservicebindingv1.ServiceBindingServiceReferenceDie) {
+				d.APIVersion("example/v1")
+				d.Kind("MyService")
+				d.Name("my-service")
+			})
+			d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+				d.APIVersion("apps/v1")
+				d.Kind("Deployment")
+				d.Name("my-workload")
+			})
+		})
+
+	rts := rtesting.ReconcilerTests{
+		"in sync": {
+			Request: request,
+			GivenObjects: []client.Object{
+				webhook,
+				serviceBinding,
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				allowSelfSubjectAccessReviewFor("apps", "deployments", "update"),
+			},
+			ExpectCreates: []client.Object{
+				selfSubjectAccessReviewFor("apps", "deployments", "update"),
+			},
+		},
+		"update": {
+			Request: request,
+			GivenObjects: []client.Object{
+				webhook.
+					WebhookDie("guard.servicebinding.io", func(d *dieadmissionregistrationv1.ValidatingWebhookDie) {
+						d.Rules()
+					}),
+				serviceBinding,
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				allowSelfSubjectAccessReviewFor("apps", "deployments", "update"),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(webhook, scheme, corev1.EventTypeNormal, "Updated", "Updated ValidatingWebhookConfiguration %q", name),
+			},
+			ExpectCreates: []client.Object{
+				selfSubjectAccessReviewFor("apps", "deployments", "update"),
+			},
+			ExpectUpdates: []client.Object{
+				webhook,
+			},
+		},
+		"clear rules when off": {
+			Metadata: map[string]interface{}{
+				"ProjectionGuardMode": controllers.ProjectionGuardOff,
+			},
+			Request: request,
+			GivenObjects: []client.Object{
+				webhook,
+				serviceBinding,
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(webhook, scheme, corev1.EventTypeNormal, "Updated", "Updated ValidatingWebhookConfiguration %q", name),
+			},
+			ExpectUpdates: []client.Object{
+				webhook.
+					WebhookDie("guard.servicebinding.io", func(d *dieadmissionregistrationv1.ValidatingWebhookDie) {
+						d.Rules()
+					}),
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, tc *rtesting.ReconcilerTestCase, c reconcilers.Config) reconcile.Reconciler {
+		restMapper := c.RESTMapper().(*meta.DefaultRESTMapper)
+		restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+		mode := controllers.ProjectionGuardDeny
+		if m, ok := tc.Metadata["ProjectionGuardMode"].(controllers.ProjectionGuardMode); ok {
+			mode = m
+		}
+		opts := controllers.WebhookOptions{
+			Name:        name,
+			WebhookName: "guard.servicebinding.io",
+		}
+		accessChecker := rbac.NewAccessChecker(c, 0).WithVerb("update")
+		return controllers.ProjectionGuardReconciler(c, opts, mode, accessChecker, controllers.NewUnmappedKinds())
+	})
+}
+
+func TestProjectionGuardWebhook(t *testing.T) {
+	namespace := "test-namespace"
+	name := "my-workload"
+	secret := "my-binding"
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1.AddToScheme(scheme))
+
+	requestUID := types.UID("9deefaa1-2c90-4f40-9c7b-3f5c1fd75dde")
+	bindingUID := types.UID("89deaf20-7bab-4610-81db-6f8c3f7fa51d")
+
+	podSpecableMapping := `{"versions":[{"version":"*","annotations":".spec.template.metadata.annotations","containers":[{"path":".spec.template.spec.initContainers[*]","name":".name","env":".env","volumeMounts":".volumeMounts"},{"path":".spec.template.spec.containers[*]","name":".name","env":".env","volumeMounts":".volumeMounts"}],"volumes":".spec.template.spec.volumes"}]}`
+
+	workload := dieappsv1.DeploymentBlank.
+		APIVersion("apps/v1").
+		Kind("Deployment").
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(name)
+		}).
+		SpecDie(func(d *dieappsv1.DeploymentSpecDie) {
+			d.TemplateDie(func(d *diecorev1.PodTemplateSpecDie) {
+				d.SpecDie(func(d *diecorev1.PodSpecDie) {
+					d.ContainerDie("workload", func(d *diecorev1.ContainerDie) {
+						d.Image("scratch")
+					})
+				})
+			})
+		})
+	projectedWorkload := workload.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.AddAnnotation(fmt.Sprintf("projector.servicebinding.io/mapping-%s", bindingUID), podSpecableMapping)
+		}).
+		SpecDie(func(d *dieappsv1.DeploymentSpecDie) {
+			d.TemplateDie(func(d *diecorev1.PodTemplateSpecDie) {
+				d.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.AddAnnotation(fmt.Sprintf("projector.servicebinding.io/secret-%s", bindingUID), secret)
+				})
+				d.SpecDie(func(d *diecorev1.PodSpecDie) {
+					d.ContainerDie("workload", func(d *diecorev1.ContainerDie) {
+						d.EnvDie("SERVICE_BINDING_ROOT", func(d *diecorev1.EnvVarDie) {
+							d.Value("/bindings")
+						})
+						d.VolumeMountDie(fmt.Sprintf("servicebinding-%s", bindingUID), func(d *diecorev1.VolumeMountDie) {
+							d.MountPath(fmt.Sprintf("/bindings/%s", name))
+							d.ReadOnly(true)
+						})
+					})
+					d.VolumeDie(fmt.Sprintf("servicebinding-%s", bindingUID), func(d *diecorev1.VolumeDie) {
+						d.ProjectedDie(func(d *diecorev1.ProjectedVolumeSourceDie) {
+							d.DefaultMode(ptr.To(projector.VolumeDefaultMode))
+							d.SourcesDie(
+								diecorev1.VolumeProjectionBlank.SecretDie(func(d *diecorev1.SecretProjectionDie) {
+									d.LocalObjectReference(corev1.LocalObjectReference{
+										Name: secret,
+									})
+								}),
+							)
+						})
+					})
+				})
+			})
+		})
+	serviceBinding := dieservicebindingv1.ServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(name)
+			d.UID(bindingUID)
+		}).
+		SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+			d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+				d.APIVersion("apps/v1")
+				d.Kind("Deployment")
+				d.Name(name)
+			})
+		}).
+		StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+			d.BindingDie(func(d *dieservicebindingv1.ServiceBindingSecretReferenceDie) {
+				d.Name(secret)
+			})
+		})
+
+	request := dieadmissionv1.AdmissionRequestBlank.
+		UID(requestUID).
+		Operation(admissionv1.Update)
+	response := dieadmissionv1.AdmissionResponseBlank.
+		Allowed(true)
+	violation := fmt.Sprintf("ServiceBinding %q is projected into the workload, the update removes projected fields: %s", name, strings.Join([]string{
+		"/metadata/annotations",
+		"/spec/template/metadata/annotations",
+		"/spec/template/spec/containers/0/env",
+		"/spec/template/spec/containers/0/volumeMounts",
+		"/spec/template/spec/volumes",
+	}, ", "))
+
+	addWorkloadRefIndex := func(cb *fake.ClientBuilder) *fake.ClientBuilder {
+		return cb.
+			WithIndex(&servicebindingv1.ServiceBinding{}, controllers.WorkloadRefIndexKey, controllers.WorkloadRefIndexFunc).
+			WithIndex(&servicebindingv1.ClusterServiceBinding{}, controllers.WorkloadRefIndexKey, controllers.WorkloadRefIndexFunc)
+	}
+
+	wts := rtesting.AdmissionWebhookTests{
+		"no binding targeting workload": {
+			WithClientBuilder: addWorkloadRefIndex,
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(workload.DieReleaseRawExtension()).
+					OldObject(projectedWorkload.DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+			},
+		},
+		"projection kept": {
+			WithClientBuilder: addWorkloadRefIndex,
+			GivenObjects: []client.Object{
+				serviceBinding,
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(projectedWorkload.DieReleaseRawExtension()).
+					OldObject(projectedWorkload.DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+			},
+		},
+		"projection removed, warn": {
+			WithClientBuilder: addWorkloadRefIndex,
+			GivenObjects: []client.Object{
+				serviceBinding,
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(workload.DieReleaseRawExtension()).
+					OldObject(projectedWorkload.DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.
+					Warnings(violation).
+					DieRelease(),
+			},
+		},
+		"projection removed, deny": {
+			Metadata: map[string]interface{}{
+				"ProjectionGuardMode": controllers.ProjectionGuardDeny,
+			},
+			WithClientBuilder: addWorkloadRefIndex,
+			GivenObjects: []client.Object{
+				serviceBinding,
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(workload.DieReleaseRawExtension()).
+					OldObject(projectedWorkload.DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.
+					Allowed(false).
+					ResultDie(func(d *diemetav1.StatusDie) {
+						d.Status(metav1.StatusFailure)
+						d.Code(403)
+						d.Reason(metav1.StatusReasonForbidden)
+						d.Message(violation)
+					}).
+					DieRelease(),
+			},
+		},
+		"projection removed, off": {
+			Metadata: map[string]interface{}{
+				"ProjectionGuardMode": controllers.ProjectionGuardOff,
+			},
+			WithClientBuilder: addWorkloadRefIndex,
+			GivenObjects: []client.Object{
+				serviceBinding,
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(workload.DieReleaseRawExtension()).
+					OldObject(projectedWorkload.DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+			},
+		},
+		"projection removed for suspended binding": {
+			WithClientBuilder: addWorkloadRefIndex,
+			GivenObjects: []client.Object{
+				serviceBinding.SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+					d.Suspend(true)
+				}),
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(workload.DieReleaseRawExtension()).
+					OldObject(projectedWorkload.DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+			},
+		},
+		"previous workload not in sync with binding": {
+			WithClientBuilder: addWorkloadRefIndex,
+			GivenObjects: []client.Object{
+				serviceBinding.StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+					d.BindingDie(func(d *dieservicebindingv1.ServiceBindingSecretReferenceDie) {
+						d.Name("rotated-secret")
+					})
+				}),
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(workload.DieReleaseRawExtension()).
+					OldObject(projectedWorkload.DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+			},
+		},
+		"ignore create": {
+			WithClientBuilder: addWorkloadRefIndex,
+			GivenObjects: []client.Object{
+				serviceBinding,
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Operation(admissionv1.Create).
+					Object(workload.DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+			},
+		},
+	}
+	wts.Run(t, scheme, func(t *testing.T, tc *rtesting.AdmissionWebhookTestCase, c reconcilers.Config) *admission.Webhook {
+		restMapper := c.RESTMapper().(*meta.DefaultRESTMapper)
+		restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+		mode := controllers.ProjectionGuardWarn
+		if m, ok := tc.Metadata["ProjectionGuardMode"].(controllers.ProjectionGuardMode); ok {
+			mode = m
+		}
+		return controllers.ProjectionGuardWebhook(c, lifecycle.ServiceBindingHooks{}, mode).Build()
+	})
+}
+
+func TestTriggerReconciler(t *testing.T) {
+	name := "my-webhook"
+	request := reconcilers.Request{NamespacedName: types.NamespacedName{Name: name}}
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(servicebindingv1.AddToScheme(scheme))
 
 	webhook := dieadmissionregistrationv1.ValidatingWebhookConfigurationBlank.
@@ -546,6 +1714,11 @@ func TestTriggerReconciler(t *testing.T) {
 					d.Name("my-service")
 				})
 			})
+			d.NamespaceSelector(&metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: corev1.LabelMetadataName, Operator: metav1.LabelSelectorOpIn, Values: []string{"my-namespace"}},
+				},
+			})
 			d.RulesDie(
 				dieadmissionregistrationv1.RuleWithOperationsBlank.
 					APIGroups("apps").
@@ -626,6 +1799,90 @@ func TestTriggerReconciler(t *testing.T) {
 				webhook,
 			},
 		},
+		"informer mode": {
+			Metadata: map[string]interface{}{
+				"TriggerMode": "informer",
+			},
+			Request: request,
+			GivenObjects: []client.Object{
+				webhook,
+				serviceBinding,
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				allowSelfSubjectAccessReviewFor("apps", "deployments", "watch"),
+				allowSelfSubjectAccessReviewFor("example", "myservices", "watch"),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(webhook, scheme, corev1.EventTypeNormal, "Updated", "Updated ValidatingWebhookConfiguration %q", name),
+			},
+			ExpectCreates: []client.Object{
+				selfSubjectAccessReviewFor("example", "myservices", "watch"),
+				selfSubjectAccessReviewFor("apps", "deployments", "watch"),
+			},
+			ExpectUpdates: []client.Object{
+				webhook.
+					WebhookDie("trigger.servicebinding.io", func(d *dieadmissionregistrationv1.ValidatingWebhookDie) {
+						d.Rules()
+					}),
+			},
+		},
+		"manage named webhook among several": {
+			Request: request,
+			GivenObjects: []client.Object{
+				webhook.
+					WebhookDie("other.servicebinding.io", func(d *dieadmissionregistrationv1.ValidatingWebhookDie) {
+						d.FailurePolicy(ptr.To(admissionregistrationv1.Fail))
+					}).
+					WebhookDie("trigger.servicebinding.io", func(d *dieadmissionregistrationv1.ValidatingWebhookDie) {
+						d.Rules()
+					}),
+				serviceBinding,
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				allowSelfSubjectAccessReviewFor("apps", "deployments", "get"),
+				allowSelfSubjectAccessReviewFor("example", "myservices", "get"),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(webhook, scheme, corev1.EventTypeNormal, "Updated", "Updated ValidatingWebhookConfiguration %q", name),
+			},
+			ExpectCreates: []client.Object{
+				selfSubjectAccessReviewFor("apps", "deployments", "get"),
+				selfSubjectAccessReviewFor("example", "myservices", "get"),
+			},
+			ExpectUpdates: []client.Object{
+				webhook.
+					WebhookDie("other.servicebinding.io", func(d *dieadmissionregistrationv1.ValidatingWebhookDie) {
+						d.FailurePolicy(ptr.To(admissionregistrationv1.Fail))
+					}),
+			},
+		},
+		"set failure policy": {
+			Metadata: map[string]interface{}{
+				"FailurePolicy": admissionregistrationv1.Ignore,
+			},
+			Request: request,
+			GivenObjects: []client.Object{
+				webhook,
+				serviceBinding,
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				allowSelfSubjectAccessReviewFor("apps", "deployments", "get"),
+				allowSelfSubjectAccessReviewFor("example", "myservices", "get"),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(webhook, scheme, corev1.EventTypeNormal, "Updated", "Updated ValidatingWebhookConfiguration %q", name),
+			},
+			ExpectCreates: []client.Object{
+				selfSubjectAccessReviewFor("apps", "deployments", "get"),
+				selfSubjectAccessReviewFor("example", "myservices", "get"),
+			},
+			ExpectUpdates: []client.Object{
+				webhook.
+					WebhookDie("trigger.servicebinding.io", func(d *dieadmissionregistrationv1.ValidatingWebhookDie) {
+						d.FailurePolicy(ptr.To(admissionregistrationv1.Ignore))
+					}),
+			},
+		},
 		"ignore other keys": {
 			Request: reconcilers.Request{
 				NamespacedName: types.NamespacedName{
@@ -662,8 +1919,25 @@ func TestTriggerReconciler(t *testing.T) {
 		restMapper := c.RESTMapper().(*meta.DefaultRESTMapper)
 		restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
 		restMapper.Add(schema.GroupVersionKind{Group: "example", Version: "v1", Kind: "MyService"}, meta.RESTScopeNamespace)
+		opts := controllers.WebhookOptions{
+			Name:        name,
+			WebhookName: "trigger.servicebinding.io",
+		}
+		if failurePolicy, ok := tc.Metadata["FailurePolicy"].(admissionregistrationv1.FailurePolicyType); ok {
+			opts.FailurePolicy = &failurePolicy
+		}
+		if tc.Metadata["TriggerMode"] == "informer" {
+			metadataScheme := runtime.NewScheme()
+			utilruntime.Must(metav1.AddMetaToScheme(metadataScheme))
+			informers := controllers.NewTriggerInformers(metadatafake.NewSimpleMetadataClient(metadataScheme), c.Tracker, controllers.NewTriggerQueue(10, 100))
+			ctx, cancel := context.WithCancel(context.TODO())
+			t.Cleanup(cancel)
+			go informers.Start(ctx)
+			accessChecker := rbac.NewAccessChecker(c, 0).WithVerb("watch")
+			return controllers.TriggerReconciler(c, opts, accessChecker, controllers.NewUnmappedKinds(), informers)
+		}
 		accessChecker := rbac.NewAccessChecker(c, 0).WithVerb("get")
-		return controllers.TriggerReconciler(c, name, accessChecker)
+		return controllers.TriggerReconciler(c, opts, accessChecker, controllers.NewUnmappedKinds(), nil)
 	})
 }
 
@@ -683,6 +1957,10 @@ func TestTriggerWebhook(t *testing.T) {
 			d.Namespace(namespace)
 			d.Name(bindingName)
 		})
+	clusterServiceBinding := dieservicebindingv1.ClusterServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(bindingName)
+		})
 
 	workload := dieappsv1.DeploymentBlank.
 		APIVersion("apps/v1").
@@ -719,7 +1997,7 @@ func TestTriggerWebhook(t *testing.T) {
 				AdmissionResponse: response.DieRelease(),
 			},
 			Metadata: map[string]interface{}{
-				"queue":            workqueue.New(),
+				"queue":            workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]()),
 				"expectedRequests": []reconcile.Request{},
 			},
 		},
@@ -736,10 +2014,34 @@ func TestTriggerWebhook(t *testing.T) {
 				AdmissionResponse: response.DieRelease(),
 			},
 			Metadata: map[string]interface{}{
-				"queue": workqueue.New(),
+				"queue": workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]()),
+				"expectedRequests": []reconcile.Request{
+					{NamespacedName: types.NamespacedName{Namespace: namespace, Name: bindingName}},
+				},
+			},
+		},
+		"enqueue tracked cluster service binding": {
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(workload.DieReleaseRawExtension()).
+					DieRelease(),
+			},
+			GivenTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(serviceBinding, workload, scheme),
+				rtesting.NewTrackRequest(clusterServiceBinding, workload, scheme),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.DieRelease(),
+			},
+			Metadata: map[string]interface{}{
+				"queue": workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]()),
 				"expectedRequests": []reconcile.Request{
 					{NamespacedName: types.NamespacedName{Namespace: namespace, Name: bindingName}},
 				},
+				"clusterQueue": workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]()),
+				"expectedClusterRequests": []reconcile.Request{
+					{NamespacedName: types.NamespacedName{Name: bindingName}},
+				},
 			},
 		},
 	}
@@ -748,30 +2050,178 @@ func TestTriggerWebhook(t *testing.T) {
 			tc.Metadata = map[string]interface{}{}
 		}
 		tc.CleanUp = func(t *testing.T, ctx context.Context, tc *rtesting.AdmissionWebhookTestCase) error {
-			queue, ok := tc.Metadata["queue"].(workqueue.TypedInterface[reconcile.Request])
-			if !ok {
-				return nil
-			}
-			actualRequests := []reconcile.Request{}
-			for len(actualRequests) < queue.Len() {
-				request, _ := queue.Get()
-				actualRequests = append(actualRequests, request)
-			}
-			expectedRequests := tc.Metadata["expectedRequests"].([]reconcile.Request)
-			if diff := cmp.Diff(expectedRequests, actualRequests); diff != "" {
-				t.Errorf("enqueued request (-expected, +actual): %s", diff)
+			for queueKey, expectedKey := range map[string]string{"queue": "expectedRequests", "clusterQueue": "expectedClusterRequests"} {
+				queue, ok := tc.Metadata[queueKey].(workqueue.TypedInterface[reconcile.Request])
+				if !ok {
+					continue
+				}
+				actualRequests := []reconcile.Request{}
+				for len(actualRequests) < queue.Len() {
+					request, _ := queue.Get()
+					actualRequests = append(actualRequests, request)
+				}
+				expectedRequests := tc.Metadata[expectedKey].([]reconcile.Request)
+				if diff := cmp.Diff(expectedRequests, actualRequests); diff != "" {
+					t.Errorf("enqueued %s request (-expected, +actual): %s", queueKey, diff)
+				}
 			}
 			return nil
 		}
 
-		queue, _ := tc.Metadata["queue"].(workqueue.TypedInterface[reconcile.Request])
-		ctrl := &mockController{
-			Queue: queue,
+		triggerQueue := controllers.NewTriggerQueue(10, 100)
+		// sources are only started for controllers with a queue
+		if queue, ok := tc.Metadata["queue"].(workqueue.TypedRateLimitingInterface[reconcile.Request]); ok {
+			if err := triggerQueue.ServiceBindingSource().Start(context.TODO(), queue); err != nil {
+				t.Fatalf("unable to start source: %v", err)
+			}
+		}
+		if clusterQueue, ok := tc.Metadata["clusterQueue"].(workqueue.TypedRateLimitingInterface[reconcile.Request]); ok {
+			if err := triggerQueue.ClusterServiceBindingSource().Start(context.TODO(), clusterQueue); err != nil {
+				t.Fatalf("unable to start source: %v", err)
+			}
 		}
-		return controllers.TriggerWebhook(c, ctrl).Build()
+		return controllers.TriggerWebhook(c, triggerQueue).Build()
 	})
 }
 
+func TestTriggerQueue(t *testing.T) {
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "my-namespace", Name: "my-binding"}}
+	otherRequest := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "my-namespace", Name: "other-binding"}}
+	clusterRequest := reconcile.Request{NamespacedName: types.NamespacedName{Name: "my-binding"}}
+
+	tests := []struct {
+		name                    string
+		burst                   int
+		notStarted              bool
+		requests                []reconcile.Request
+		expectedEnqueued        bool
+		expectedRequests        []reconcile.Request
+		expectedClusterRequests []reconcile.Request
+	}{
+		{
+			name:                    "not started",
+			burst:                   10,
+			notStarted:              true,
+			requests:                []reconcile.Request{request, clusterRequest},
+			expectedEnqueued:        false,
+			expectedRequests:        []reconcile.Request{},
+			expectedClusterRequests: []reconcile.Request{},
+		},
+		{
+			name:                    "enqueue by scope",
+			burst:                   10,
+			requests:                []reconcile.Request{request, clusterRequest},
+			expectedEnqueued:        true,
+			expectedRequests:        []reconcile.Request{request},
+			expectedClusterRequests: []reconcile.Request{clusterRequest},
+		},
+		{
+			name:                    "dedupe requests",
+			burst:                   10,
+			requests:                []reconcile.Request{request, request, request},
+			expectedEnqueued:        true,
+			expectedRequests:        []reconcile.Request{request},
+			expectedClusterRequests: []reconcile.Request{},
+		},
+		{
+			name:                    "rate limit bursts",
+			burst:                   1,
+			requests:                []reconcile.Request{request, otherRequest},
+			expectedEnqueued:        true,
+			expectedRequests:        []reconcile.Request{request},
+			expectedClusterRequests: []reconcile.Request{},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			queue := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+			defer queue.ShutDown()
+			clusterQueue := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+			defer clusterQueue.ShutDown()
+
+			// one request per minute after the burst holds back requests beyond the burst for the test
+			triggerQueue := controllers.NewTriggerQueue(1.0/60, c.burst)
+			if !c.notStarted {
+				if err := triggerQueue.ServiceBindingSource().Start(ctx, queue); err != nil {
+					t.Fatalf("unable to start source: %v", err)
+				}
+				if err := triggerQueue.ClusterServiceBindingSource().Start(ctx, clusterQueue); err != nil {
+					t.Fatalf("unable to start source: %v", err)
+				}
+			}
+
+			for _, request := range c.requests {
+				if enqueued := triggerQueue.Enqueue(request); enqueued != c.expectedEnqueued {
+					t.Errorf("Enqueue() expected enqueued %v, actual %v", c.expectedEnqueued, enqueued)
+				}
+			}
+
+			for _, q := range []struct {
+				name     string
+				queue    workqueue.TypedRateLimitingInterface[reconcile.Request]
+				expected []reconcile.Request
+			}{
+				{name: "queue", queue: queue, expected: c.expectedRequests},
+				{name: "clusterQueue", queue: clusterQueue, expected: c.expectedClusterRequests},
+			} {
+				actual := []reconcile.Request{}
+				for q.queue.Len() > 0 {
+					request, _ := q.queue.Get()
+					q.queue.Done(request)
+					actual = append(actual, request)
+				}
+				if diff := cmp.Diff(q.expected, actual); diff != "" {
+					t.Errorf("enqueued %s request (-expected, +actual): %s", q.name, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestTriggerQueueDelayIsBounded(t *testing.T) {
+	ctx := context.TODO()
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "my-namespace", Name: "my-binding"}}
+	otherRequest := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "my-namespace", Name: "other-binding"}}
+
+	queue := &delayRecordingQueue{
+		TypedRateLimitingInterface: workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]()),
+		delays:                     map[reconcile.Request][]time.Duration{},
+	}
+	defer queue.ShutDown()
+
+	// one request per minute after a burst of one
+	triggerQueue := controllers.NewTriggerQueue(1.0/60, 1)
+	if err := triggerQueue.ServiceBindingSource().Start(ctx, queue); err != nil {
+		t.Fatalf("unable to start source: %v", err)
+	}
+
+	triggerQueue.Enqueue(otherRequest)
+	for i := 0; i < 100; i++ {
+		triggerQueue.Enqueue(request)
+	}
+
+	delays := queue.delays[request]
+	if len(delays) != 1 {
+		t.Fatalf("expected a single delayed add, actual %v", delays)
+	}
+	if delays[0] <= 0 || delays[0] > time.Minute {
+		t.Errorf("expected a delay of at most a minute, actual %s", delays[0])
+	}
+}
+
+type delayRecordingQueue struct {
+	workqueue.TypedRateLimitingInterface[reconcile.Request]
+	delays map[reconcile.Request][]time.Duration
+}
+
+func (q *delayRecordingQueue) AddAfter(item reconcile.Request, duration time.Duration) {
+	q.delays[item] = append(q.delays[item], duration)
+	q.TypedRateLimitingInterface.AddAfter(item, duration)
+}
+
 func TestLoadServiceBindings(t *testing.T) {
 	scheme := runtime.NewScheme()
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
@@ -796,34 +2246,151 @@ func TestLoadServiceBindings(t *testing.T) {
 				d.Name("my-workload")
 			})
 		})
+	clusterServiceBinding := dieservicebindingv1.ClusterServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("my-cluster-binding")
+		}).
+		SpecDie(func(d *dieservicebindingv1.ClusterServiceBindingSpecDie) {
+			d.ServiceBindingSpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+				d.ServiceDie(func(d *dieservicebindingv1.ServiceBindingServiceReferenceDie) {
+					d.APIVersion("example/v1")
+					d.Kind("MyClusterService")
+					d.Name("my-service")
+				})
+				d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+					d.APIVersion("batch/v1")
+					d.Kind("Job")
+					d.Name("my-workload")
+				})
+			})
+			d.NamespaceSelectorDie(func(d *diemetav1.LabelSelectorDie) {})
+		})
+
+	rts := rtesting.SubReconcilerTests[client.Object]{
+		"list all servicebindings": {
+			Resource: webhook.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				serviceBinding,
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ServiceBindingsStashKey: []servicebindingv1.ServiceBinding{
+					serviceBinding.DieRelease(),
+				},
+			},
+		},
+		"list all clusterservicebindings": {
+			Resource: webhook.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				serviceBinding,
+				clusterServiceBinding,
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ServiceBindingsStashKey: []servicebindingv1.ServiceBinding{
+					serviceBinding.DieRelease(),
+				},
+				controllers.ClusterServiceBindingsStashKey: []servicebindingv1.ClusterServiceBinding{
+					clusterServiceBinding.DieRelease(),
+				},
+			},
+		},
+		"error listing all servicebindings": {
+			Resource: webhook.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				serviceBinding,
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("list", "ServiceBindingList"),
+			},
+			ShouldErr: true,
+		},
+		"error listing all clusterservicebindings": {
+			Resource: webhook.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				serviceBinding,
+				clusterServiceBinding,
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("list", "ClusterServiceBindingList"),
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ServiceBindingsStashKey: []servicebindingv1.ServiceBinding{
+					serviceBinding.DieRelease(),
+				},
+			},
+			ShouldErr: true,
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, tc *rtesting.SubReconcilerTestCase[client.Object], c reconcilers.Config) reconcilers.SubReconciler[client.Object] {
+		req := reconcile.Request{NamespacedName: types.NamespacedName{Name: "my-webhook"}}
+		return controllers.LoadServiceBindings(req)
+	})
+}
+
+func TestWebhookNamespaces(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1.AddToScheme(scheme))
+
+	webhook := dieadmissionregistrationv1.ValidatingWebhookConfigurationBlank
+
+	serviceBinding := dieservicebindingv1.ServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace("my-namespace")
+			d.Name("my-binding")
+		})
+	clusterServiceBinding := dieservicebindingv1.ClusterServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("my-cluster-binding")
+		}).
+		StatusDie(func(d *dieservicebindingv1.ClusterServiceBindingStatusDie) {
+			d.Namespaces(
+				servicebindingv1.ClusterServiceBindingNamespaceStatus{Namespace: "other-namespace"},
+				servicebindingv1.ClusterServiceBindingNamespaceStatus{Namespace: "my-namespace"},
+			)
+		})
 
 	rts := rtesting.SubReconcilerTests[client.Object]{
-		"list all servicebindings": {
-			Resource: webhook.DieReleasePtr(),
-			GivenObjects: []client.Object{
-				serviceBinding,
-			},
+		"no bindings": {
+			Resource: webhook,
 			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WebhookNamespacesStashKey: []string{},
+			},
+		},
+		"collect binding namespaces": {
+			Resource: webhook,
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
 				controllers.ServiceBindingsStashKey: []servicebindingv1.ServiceBinding{
 					serviceBinding.DieRelease(),
+					serviceBinding.
+						MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.Name("other-binding")
+						}).
+						DieRelease(),
 				},
 			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WebhookNamespacesStashKey: []string{"my-namespace"},
+			},
 		},
-		"error listing all servicebindings": {
-			Resource: webhook.DieReleasePtr(),
-			GivenObjects: []client.Object{
-				serviceBinding,
+		"collect cluster binding namespaces": {
+			Resource: webhook,
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ServiceBindingsStashKey: []servicebindingv1.ServiceBinding{
+					serviceBinding.DieRelease(),
+				},
+				controllers.ClusterServiceBindingsStashKey: []servicebindingv1.ClusterServiceBinding{
+					clusterServiceBinding.DieRelease(),
+				},
 			},
-			WithReactors: []rtesting.ReactionFunc{
-				rtesting.InduceFailure("list", "ServiceBindingList"),
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WebhookNamespacesStashKey: []string{"my-namespace", "other-namespace"},
 			},
-			ShouldErr: true,
 		},
 	}
 
 	rts.Run(t, scheme, func(t *testing.T, tc *rtesting.SubReconcilerTestCase[client.Object], c reconcilers.Config) reconcilers.SubReconciler[client.Object] {
-		req := reconcile.Request{NamespacedName: types.NamespacedName{Name: "my-webhook"}}
-		return controllers.LoadServiceBindings(req)
+		return controllers.WebhookNamespaces()
 	})
 }
 
@@ -851,6 +2418,25 @@ func TestInterceptGVKs(t *testing.T) {
 				d.Name("my-workload")
 			})
 		})
+	clusterServiceBinding := dieservicebindingv1.ClusterServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("my-cluster-binding")
+		}).
+		SpecDie(func(d *dieservicebindingv1.ClusterServiceBindingSpecDie) {
+			d.ServiceBindingSpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+				d.ServiceDie(func(d *dieservicebindingv1.ServiceBindingServiceReferenceDie) {
+					d.APIVersion("example/v1")
+					d.Kind("MyClusterService")
+					d.Name("my-service")
+				})
+				d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+					d.APIVersion("batch/v1")
+					d.Kind("Job")
+					d.Name("my-workload")
+				})
+			})
+			d.NamespaceSelectorDie(func(d *diemetav1.LabelSelectorDie) {})
+		})
 
 	rts := rtesting.SubReconcilerTests[client.Object]{
 		"collect workload gvks": {
@@ -866,6 +2452,23 @@ func TestInterceptGVKs(t *testing.T) {
 				},
 			},
 		},
+		"collect cluster workload gvks": {
+			Resource: webhook,
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ServiceBindingsStashKey: []servicebindingv1.ServiceBinding{
+					serviceBinding.DieRelease(),
+				},
+				controllers.ClusterServiceBindingsStashKey: []servicebindingv1.ClusterServiceBinding{
+					clusterServiceBinding.DieRelease(),
+				},
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ObservedGVKsStashKey: []schema.GroupVersionKind{
+					{Group: "apps", Version: "v1", Kind: "Deployment"},
+					{Group: "batch", Version: "v1", Kind: "Job"},
+				},
+			},
+		},
 		"append workload gvks": {
 			Resource: webhook,
 			GivenStashedValues: map[reconcilers.StashKey]interface{}{
@@ -916,6 +2519,25 @@ func TestTriggerGVKs(t *testing.T) {
 				d.Name("my-workload")
 			})
 		})
+	clusterServiceBinding := dieservicebindingv1.ClusterServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("my-cluster-binding")
+		}).
+		SpecDie(func(d *dieservicebindingv1.ClusterServiceBindingSpecDie) {
+			d.ServiceBindingSpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+				d.ServiceDie(func(d *dieservicebindingv1.ServiceBindingServiceReferenceDie) {
+					d.APIVersion("example/v1")
+					d.Kind("MyClusterService")
+					d.Name("my-service")
+				})
+				d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+					d.APIVersion("batch/v1")
+					d.Kind("Job")
+					d.Name("my-workload")
+				})
+			})
+			d.NamespaceSelectorDie(func(d *diemetav1.LabelSelectorDie) {})
+		})
 
 	rts := rtesting.SubReconcilerTests[client.Object]{
 		"collect service gvks": {
@@ -931,6 +2553,23 @@ func TestTriggerGVKs(t *testing.T) {
 				},
 			},
 		},
+		"collect cluster service gvks": {
+			Resource: webhook,
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ServiceBindingsStashKey: []servicebindingv1.ServiceBinding{
+					serviceBinding.DieRelease(),
+				},
+				controllers.ClusterServiceBindingsStashKey: []servicebindingv1.ClusterServiceBinding{
+					clusterServiceBinding.DieRelease(),
+				},
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ObservedGVKsStashKey: []schema.GroupVersionKind{
+					{Group: "example", Version: "v1", Kind: "MyService"},
+					{Group: "example", Version: "v1", Kind: "MyClusterService"},
+				},
+			},
+		},
 		"append service gvks": {
 			Resource: webhook,
 			GivenStashedValues: map[reconcilers.StashKey]interface{}{
@@ -986,6 +2625,24 @@ func TestWebhookRules(t *testing.T) {
 		admissionregistrationv1.Connect,
 	}
 
+	serviceBinding := dieservicebindingv1.ServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace("my-namespace")
+			d.Name("my-binding")
+		}).
+		SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+			d.ServiceDie(func(d *dieservicebindingv1.ServiceBindingServiceReferenceDie) {
+				d.APIVersion("v1")
+				d.Kind("Secret")
+				d.Name("my-secret")
+			})
+			d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+				d.APIVersion("foo/v1")
+				d.Kind("Bar")
+				d.Name("my-workload")
+			})
+		})
+
 	rts := rtesting.SubReconcilerTests[client.Object]{
 		"empty": {
 			Resource: webhook,
@@ -1115,14 +2772,68 @@ func TestWebhookRules(t *testing.T) {
 				selfSubjectAccessReviewFor("batch", "jobs", "get"),
 			},
 		},
-		"error on unknown resource": {
+		"skip unknown resource": {
 			Resource: webhook,
 			GivenStashedValues: map[reconcilers.StashKey]interface{}{
 				controllers.ObservedGVKsStashKey: []schema.GroupVersionKind{
 					{Group: "foo", Version: "v1", Kind: "Bar"},
+					{Group: "apps", Version: "v1", Kind: "Deployment"},
+					{Group: "foo", Version: "v1", Kind: "Bar"},
 				},
 			},
-			ShouldErr: true,
+			WithReactors: []rtesting.ReactionFunc{
+				allowSelfSubjectAccessReviewFor("apps", "deployments", "get"),
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WebhookRulesStashKey: []admissionregistrationv1.RuleWithOperations{
+					{
+						Operations: operations,
+						Rule: admissionregistrationv1.Rule{
+							APIGroups:   []string{"apps"},
+							APIVersions: []string{"*"},
+							Resources:   []string{"deployments", "deployments/status"},
+						},
+					},
+				},
+				controllers.UnmappedGVKsStashKey: []schema.GroupVersionKind{
+					{Group: "foo", Version: "v1", Kind: "Bar"},
+				},
+			},
+			ExpectCreates: []client.Object{
+				selfSubjectAccessReviewFor("apps", "deployments", "get"),
+			},
+		},
+		"report unknown resource on bindings": {
+			Resource: webhook,
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ServiceBindingsStashKey: []servicebindingv1.ServiceBinding{
+					serviceBinding.DieRelease(),
+					serviceBinding.
+						MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.Name("other-binding")
+						}).
+						SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+							d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+								d.APIVersion("apps/v1")
+								d.Kind("Deployment")
+							})
+						}).
+						DieRelease(),
+				},
+				controllers.ObservedGVKsStashKey: []schema.GroupVersionKind{
+					{Group: "foo", Version: "v1", Kind: "Bar"},
+				},
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WebhookRulesStashKey: []admissionregistrationv1.RuleWithOperations{},
+				controllers.UnmappedGVKsStashKey: []schema.GroupVersionKind{
+					{Group: "foo", Version: "v1", Kind: "Bar"},
+				},
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(serviceBinding, scheme, corev1.EventTypeWarning, "UnmappedKind",
+					"Kind %s in %s is not served by the API server and is ignored by the webhooks until it is installed", "Bar", "foo/v1"),
+			},
 		},
 		"drop denied resources": {
 			Resource: webhook,
@@ -1164,7 +2875,184 @@ func TestWebhookRules(t *testing.T) {
 		restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "StatefulSet"}, meta.RESTScopeNamespace)
 		restMapper.Add(schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "Job"}, meta.RESTScopeNamespace)
 		accessChecker := rbac.NewAccessChecker(c, 0).WithVerb("get")
-		return controllers.WebhookRules(operations, []string{"status"}, accessChecker)
+		return controllers.WebhookRules(operations, []string{"status"}, accessChecker, controllers.NewUnmappedKinds())
+	})
+}
+
+func TestPodRules(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1.AddToScheme(scheme))
+
+	webhook := dieadmissionregistrationv1.MutatingWebhookConfigurationBlank.
+		APIVersion("admissionregistration.k8s.io").
+		Kind("MutatingWebhookConfiguration")
+
+	podRule := admissionregistrationv1.RuleWithOperations{
+		Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create},
+		Rule: admissionregistrationv1.Rule{
+			APIGroups:   []string{""},
+			APIVersions: []string{"v1"},
+			Resources:   []string{"pods"},
+		},
+	}
+	deploymentRule := admissionregistrationv1.RuleWithOperations{
+		Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update},
+		Rule: admissionregistrationv1.Rule{
+			APIGroups:   []string{"apps"},
+			APIVersions: []string{"*"},
+			Resources:   []string{"deployments"},
+		},
+	}
+
+	serviceBinding := dieservicebindingv1.ServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace("my-namespace")
+			d.Name("my-binding")
+		})
+	podProjectedServiceBinding := serviceBinding.
+		StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+			d.WorkloadsDie(
+				dieservicebindingv1.ServiceBindingWorkloadStatusBlank.
+					APIVersion("apps/v1").
+					Kind("Deployment").
+					Name("my-workload").
+					Result(servicebindingv1.ServiceBindingWorkloadSkipped).
+					Reason("PodProjection"),
+			)
+		})
+	clusterServiceBinding := dieservicebindingv1.ClusterServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("my-binding")
+		}).
+		StatusDie(func(d *dieservicebindingv1.ClusterServiceBindingStatusDie) {
+			d.NamespaceDie("my-namespace", func(d *dieservicebindingv1.ClusterServiceBindingNamespaceStatusDie) {})
+		})
+
+	rts := rtesting.SubReconcilerTests[client.Object]{
+		"empty": {
+			Metadata: map[string]interface{}{
+				"SchedulingGate": true,
+			},
+			Resource: webhook,
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WebhookNamespacesStashKey: []string{"my-namespace"},
+				controllers.WebhookRulesStashKey:      []admissionregistrationv1.RuleWithOperations{},
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WebhookNamespacesStashKey: []string{"my-namespace"},
+				controllers.WebhookRulesStashKey: []admissionregistrationv1.RuleWithOperations{
+					podRule,
+				},
+			},
+		},
+		"append pod rule": {
+			Metadata: map[string]interface{}{
+				"SchedulingGate": true,
+			},
+			Resource: webhook,
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WebhookNamespacesStashKey: []string{"my-namespace"},
+				controllers.WebhookRulesStashKey: []admissionregistrationv1.RuleWithOperations{
+					deploymentRule,
+				},
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WebhookNamespacesStashKey: []string{"my-namespace"},
+				controllers.WebhookRulesStashKey: []admissionregistrationv1.RuleWithOperations{
+					deploymentRule,
+					podRule,
+				},
+			},
+		},
+		"skip pod rule without namespaces": {
+			Metadata: map[string]interface{}{
+				"SchedulingGate": true,
+				"ReadinessGate":  true,
+				"PodProjection":  true,
+			},
+			Resource: webhook,
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WebhookNamespacesStashKey: []string{},
+				controllers.WebhookRulesStashKey:      []admissionregistrationv1.RuleWithOperations{},
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WebhookNamespacesStashKey: []string{},
+				controllers.WebhookRulesStashKey:      []admissionregistrationv1.RuleWithOperations{},
+			},
+		},
+		"pod projected binding": {
+			Metadata: map[string]interface{}{
+				"PodProjection": true,
+			},
+			Resource: webhook,
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ServiceBindingsStashKey: []servicebindingv1.ServiceBinding{
+					podProjectedServiceBinding.DieRelease(),
+				},
+				controllers.WebhookNamespacesStashKey: []string{"my-namespace"},
+				controllers.WebhookRulesStashKey:      []admissionregistrationv1.RuleWithOperations{},
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ServiceBindingsStashKey: []servicebindingv1.ServiceBinding{
+					podProjectedServiceBinding.DieRelease(),
+				},
+				controllers.WebhookNamespacesStashKey: []string{"my-namespace"},
+				controllers.WebhookRulesStashKey: []admissionregistrationv1.RuleWithOperations{
+					podRule,
+				},
+			},
+		},
+		"skip pod rule without pod projected bindings": {
+			Metadata: map[string]interface{}{
+				"PodProjection": true,
+			},
+			Resource: webhook,
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ServiceBindingsStashKey: []servicebindingv1.ServiceBinding{
+					serviceBinding.DieRelease(),
+				},
+				controllers.WebhookNamespacesStashKey: []string{"my-namespace"},
+				controllers.WebhookRulesStashKey:      []admissionregistrationv1.RuleWithOperations{},
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ServiceBindingsStashKey: []servicebindingv1.ServiceBinding{
+					serviceBinding.DieRelease(),
+				},
+				controllers.WebhookNamespacesStashKey: []string{"my-namespace"},
+				controllers.WebhookRulesStashKey:      []admissionregistrationv1.RuleWithOperations{},
+			},
+		},
+		"bound cluster binding": {
+			Metadata: map[string]interface{}{
+				"PodProjection": true,
+			},
+			Resource: webhook,
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ClusterServiceBindingsStashKey: []servicebindingv1.ClusterServiceBinding{
+					clusterServiceBinding.DieRelease(),
+				},
+				controllers.WebhookNamespacesStashKey: []string{"my-namespace"},
+				controllers.WebhookRulesStashKey:      []admissionregistrationv1.RuleWithOperations{},
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ClusterServiceBindingsStashKey: []servicebindingv1.ClusterServiceBinding{
+					clusterServiceBinding.DieRelease(),
+				},
+				controllers.WebhookNamespacesStashKey: []string{"my-namespace"},
+				controllers.WebhookRulesStashKey: []admissionregistrationv1.RuleWithOperations{
+					podRule,
+				},
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, tc *rtesting.SubReconcilerTestCase[client.Object], c reconcilers.Config) reconcilers.SubReconciler[client.Object] {
+		pods := controllers.PodAdmission{}
+		pods.Projection, _ = tc.Metadata["PodProjection"].(bool)
+		pods.SchedulingGate, _ = tc.Metadata["SchedulingGate"].(bool)
+		pods.ReadinessGate, _ = tc.Metadata["ReadinessGate"].(bool)
+		return controllers.PodRules(pods)
 	})
 }
 
@@ -1197,25 +3085,3 @@ func allowSelfSubjectAccessReviewFor(group, resource, verb string) rtesting.Reac
 		return false, nil, nil
 	}
 }
-
-var _ controller.Controller = (*mockController)(nil)
-
-type mockController struct {
-	Queue workqueue.TypedInterface[reconcile.Request]
-}
-
-func (c *mockController) Reconcile(context.Context, reconcile.Request) (reconcile.Result, error) {
-	return reconcile.Result{}, nil
-}
-
-func (c *mockController) Watch(src source.Source) error {
-	return nil
-}
-
-func (c *mockController) Start(ctx context.Context) error {
-	return nil
-}
-
-func (c *mockController) GetLogger() logr.Logger {
-	return logr.Discard()
-}
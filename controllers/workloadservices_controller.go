@@ -0,0 +1,146 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"reconciler.io/runtime/reconcilers"
+
+	servicebindingv1 "github.com/servicebinding/runtime/apis/v1"
+)
+
+// ServicesAnnotationKey is the workload annotation listing the services the workload is bound to. The value is a
+// comma separated list of services in the workload's namespace, each either the name of a Secret or a fully
+// qualified `<apiVersion>/<kind>/<name>` reference to a provisioned service.
+const ServicesAnnotationKey = "servicebinding.io/services"
+
+// WorkloadServicesReconciler reconciles workloads of the kind that declare the services they consume with the
+// servicebinding.io/services annotation. A ServiceBinding owned by the workload is generated for each service and is
+// then bound by the ServiceBinding controller.
+func WorkloadServicesReconciler(c reconcilers.Config, gvk schema.GroupVersionKind) *reconcilers.ResourceReconciler[*unstructured.Unstructured] {
+	workload := &unstructured.Unstructured{}
+	workload.SetGroupVersionKind(gvk)
+
+	return &reconcilers.ResourceReconciler[*unstructured.Unstructured]{
+		Name:       fmt.Sprintf("%sServices", gvk.Kind),
+		Type:       workload,
+		Reconciler: GenerateServiceBindings(),
+		// the workload's status is owned by another controller
+		SkipStatusUpdate: true,
+
+		Config: c,
+	}
+}
+
+// GenerateServiceBindings creates, updates and deletes the ServiceBindings owned by the workload to match the services
+// listed in the servicebinding.io/services annotation. Removing the annotation deletes every generated binding.
+func GenerateServiceBindings() reconcilers.SubReconciler[*unstructured.Unstructured] {
+	return &reconcilers.ChildSetReconciler[*unstructured.Unstructured, *servicebindingv1.ServiceBinding, *servicebindingv1.ServiceBindingList]{
+		Name: "GenerateServiceBindings",
+		DesiredChildren: func(ctx context.Context, resource *unstructured.Unstructured) ([]*servicebindingv1.ServiceBinding, error) {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+
+			services, err := ParseServicesAnnotation(resource.GetAnnotations()[ServicesAnnotationKey])
+			if err != nil {
+				// the annotation is authored by hand, report the problem on the workload and keep the existing
+				// bindings until the annotation is fixed
+				c.Recorder.Eventf(resource, corev1.EventTypeWarning, "InvalidServices", "Invalid %s annotation: %s", ServicesAnnotationKey, err)
+				return nil, reconcilers.ErrHaltSubReconcilers
+			}
+
+			children := make([]*servicebindingv1.ServiceBinding, len(services))
+			for i, service := range services {
+				children[i] = &servicebindingv1.ServiceBinding{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: resource.GetNamespace(),
+						Name:      fmt.Sprintf("%s-%s", resource.GetName(), service.Name),
+					},
+					Spec: servicebindingv1.ServiceBindingSpec{
+						Name:    service.Name,
+						Service: service,
+						Workload: servicebindingv1.ServiceBindingWorkloadReference{
+							APIVersion: resource.GetAPIVersion(),
+							Kind:       resource.GetKind(),
+							Name:       resource.GetName(),
+						},
+					},
+				}
+			}
+
+			return children, nil
+		},
+		IdentifyChild: func(child *servicebindingv1.ServiceBinding) string {
+			service := child.Spec.Service
+			return fmt.Sprintf("%s/%s/%s", service.APIVersion, service.Kind, service.Name)
+		},
+		ChildObjectManager: &reconcilers.UpdatingObjectManager[*servicebindingv1.ServiceBinding]{
+			MergeBeforeUpdate: func(current, desired *servicebindingv1.ServiceBinding) {
+				current.Spec = desired.Spec
+			},
+		},
+		ReflectChildrenStatusOnParent: func(ctx context.Context, parent *unstructured.Unstructured, result reconcilers.ChildSetResult[*servicebindingv1.ServiceBinding]) {
+			// workloads have no status for the generated bindings, each binding reports its own status
+		},
+	}
+}
+
+// ParseServicesAnnotation parses the value of the servicebinding.io/services annotation into service references.
+// Entries are separated by commas. An entry that is a bare name refers to a Secret, otherwise the entry is an
+// `<apiVersion>/<kind>/<name>` reference. Empty and duplicate entries are ignored.
+func ParseServicesAnnotation(value string) ([]servicebindingv1.ServiceBindingServiceReference, error) {
+	services := []servicebindingv1.ServiceBindingServiceReference{}
+	seen := map[servicebindingv1.ServiceBindingServiceReference]bool{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		service := servicebindingv1.ServiceBindingServiceReference{
+			APIVersion: "v1",
+			Kind:       "Secret",
+			Name:       entry,
+		}
+		if parts := strings.Split(entry, "/"); len(parts) > 1 {
+			if len(parts) < 3 {
+				return nil, fmt.Errorf("service %q must be a name or an <apiVersion>/<kind>/<name> reference", entry)
+			}
+			service = servicebindingv1.ServiceBindingServiceReference{
+				APIVersion: strings.Join(parts[:len(parts)-2], "/"),
+				Kind:       parts[len(parts)-2],
+				Name:       parts[len(parts)-1],
+			}
+		}
+		if service.APIVersion == "" || service.Kind == "" || service.Name == "" {
+			return nil, fmt.Errorf("service %q must be a name or an <apiVersion>/<kind>/<name> reference", entry)
+		}
+
+		if seen[service] {
+			continue
+		}
+		seen[service] = true
+		services = append(services, service)
+	}
+	return services, nil
+}
@@ -0,0 +1,270 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	dieappsv1 "reconciler.io/dies/apis/apps/v1"
+	diemetav1 "reconciler.io/dies/apis/meta/v1"
+	"reconciler.io/runtime/reconcilers"
+	rtesting "reconciler.io/runtime/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	servicebindingv1 "github.com/servicebinding/runtime/apis/v1"
+	"github.com/servicebinding/runtime/controllers"
+	dieservicebindingv1 "github.com/servicebinding/runtime/dies/v1"
+)
+
+func TestWorkloadServicesReconciler(t *testing.T) {
+	namespace := "test-namespace"
+	name := "my-workload"
+	uid := types.UID("5a3c2f0e-8d6b-4b0e-9f4a-1c7e2d9b6a31")
+	request := reconcilers.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}}
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1.AddToScheme(scheme))
+
+	workload := dieappsv1.DeploymentBlank.
+		APIVersion("apps/v1").
+		Kind("Deployment").
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(name)
+			d.UID(uid)
+		})
+
+	serviceBinding := dieservicebindingv1.ServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.OwnerReferences(metav1.OwnerReference{
+				APIVersion:         "apps/v1",
+				Kind:               "Deployment",
+				Name:               name,
+				UID:                uid,
+				Controller:         ptr.To(true),
+				BlockOwnerDeletion: ptr.To(true),
+			})
+		}).
+		SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+			d.WorkloadDie(func(d *dieservicebindingv1.ServiceBindingWorkloadReferenceDie) {
+				d.APIVersion("apps/v1")
+				d.Kind("Deployment")
+				d.Name(name)
+			})
+		})
+	postgresBinding := serviceBinding.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(fmt.Sprintf("%s-postgres-main", name))
+		}).
+		SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+			d.Name("postgres-main")
+			d.ServiceDie(func(d *dieservicebindingv1.ServiceBindingServiceReferenceDie) {
+				d.APIVersion("v1")
+				d.Kind("Secret")
+				d.Name("postgres-main")
+			})
+		})
+	redisBinding := serviceBinding.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(fmt.Sprintf("%s-redis-cache", name))
+		}).
+		SpecDie(func(d *dieservicebindingv1.ServiceBindingSpecDie) {
+			d.Name("redis-cache")
+			d.ServiceDie(func(d *dieservicebindingv1.ServiceBindingServiceReferenceDie) {
+				d.APIVersion("cache.example.com/v1")
+				d.Kind("Redis")
+				d.Name("redis-cache")
+			})
+		})
+
+	rts := rtesting.ReconcilerTests{
+		"no annotation": {
+			Request: request,
+			GivenObjects: []client.Object{
+				workload,
+			},
+		},
+		"generate bindings for annotated services": {
+			Request: request,
+			GivenObjects: []client.Object{
+				workload.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddAnnotation(controllers.ServicesAnnotationKey, "postgres-main, cache.example.com/v1/Redis/redis-cache")
+					}),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeNormal, "Created", "Created ServiceBinding %q", postgresBinding.GetName()),
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeNormal, "Created", "Created ServiceBinding %q", redisBinding.GetName()),
+			},
+			ExpectCreates: []client.Object{
+				postgresBinding,
+				redisBinding,
+			},
+		},
+		"in sync": {
+			Request: request,
+			GivenObjects: []client.Object{
+				workload.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddAnnotation(controllers.ServicesAnnotationKey, "postgres-main,cache.example.com/v1/Redis/redis-cache")
+					}),
+				postgresBinding,
+				redisBinding,
+			},
+		},
+		"delete bindings for removed services": {
+			Request: request,
+			GivenObjects: []client.Object{
+				workload.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddAnnotation(controllers.ServicesAnnotationKey, "postgres-main")
+					}),
+				postgresBinding,
+				redisBinding,
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeNormal, "Deleted", "Deleted ServiceBinding %q", redisBinding.GetName()),
+			},
+			ExpectDeletes: []rtesting.DeleteRef{
+				rtesting.NewDeleteRefFromObject(redisBinding, scheme),
+			},
+		},
+		"delete bindings when the annotation is removed": {
+			Request: request,
+			GivenObjects: []client.Object{
+				workload,
+				postgresBinding,
+				redisBinding,
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeNormal, "Deleted", "Deleted ServiceBinding %q", postgresBinding.GetName()),
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeNormal, "Deleted", "Deleted ServiceBinding %q", redisBinding.GetName()),
+			},
+			ExpectDeletes: []rtesting.DeleteRef{
+				rtesting.NewDeleteRefFromObject(postgresBinding, scheme),
+				rtesting.NewDeleteRefFromObject(redisBinding, scheme),
+			},
+		},
+		"ignore bindings not owned by the workload": {
+			Request: request,
+			GivenObjects: []client.Object{
+				workload,
+				postgresBinding.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.OwnerReferences()
+					}),
+			},
+		},
+		"keep bindings when the annotation is invalid": {
+			Request: request,
+			GivenObjects: []client.Object{
+				workload.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddAnnotation(controllers.ServicesAnnotationKey, "Redis/redis-cache")
+					}),
+				postgresBinding,
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeWarning, "InvalidServices", "Invalid %s annotation: %s", controllers.ServicesAnnotationKey,
+					`service "Redis/redis-cache" must be a name or an <apiVersion>/<kind>/<name> reference`),
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, tc *rtesting.ReconcilerTestCase, c reconcilers.Config) reconcile.Reconciler {
+		return controllers.WorkloadServicesReconciler(c, schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"})
+	})
+}
+
+func TestParseServicesAnnotation(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expected    []servicebindingv1.ServiceBindingServiceReference
+		expectedErr string
+	}{
+		{
+			name:     "empty",
+			value:    "",
+			expected: []servicebindingv1.ServiceBindingServiceReference{},
+		},
+		{
+			name:  "secret names",
+			value: "postgres-main,redis-cache",
+			expected: []servicebindingv1.ServiceBindingServiceReference{
+				{APIVersion: "v1", Kind: "Secret", Name: "postgres-main"},
+				{APIVersion: "v1", Kind: "Secret", Name: "redis-cache"},
+			},
+		},
+		{
+			name:  "provisioned services",
+			value: "cache.example.com/v1/Redis/redis-cache, v1/Secret/postgres-main",
+			expected: []servicebindingv1.ServiceBindingServiceReference{
+				{APIVersion: "cache.example.com/v1", Kind: "Redis", Name: "redis-cache"},
+				{APIVersion: "v1", Kind: "Secret", Name: "postgres-main"},
+			},
+		},
+		{
+			name:  "ignore empty and duplicate entries",
+			value: " postgres-main,, v1/Secret/postgres-main ,",
+			expected: []servicebindingv1.ServiceBindingServiceReference{
+				{APIVersion: "v1", Kind: "Secret", Name: "postgres-main"},
+			},
+		},
+		{
+			name:        "missing kind",
+			value:       "Redis/redis-cache",
+			expectedErr: `service "Redis/redis-cache" must be a name or an <apiVersion>/<kind>/<name> reference`,
+		},
+		{
+			name:        "empty name",
+			value:       "cache.example.com/v1/Redis/",
+			expectedErr: `service "cache.example.com/v1/Redis/" must be a name or an <apiVersion>/<kind>/<name> reference`,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual, err := controllers.ParseServicesAnnotation(c.value)
+			if c.expectedErr != "" {
+				if err == nil || err.Error() != c.expectedErr {
+					t.Errorf("expected error %q, got %v", c.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("(-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	diemetav1 "reconciler.io/dies/apis/meta/v1"
+
+	servicebindingv1 "github.com/servicebinding/runtime/apis/v1"
+)
+
+// +die:object=true
+type _ = servicebindingv1.ClusterServiceBinding
+
+// +die
+// +die:field:name=ServiceBindingSpec,die=ServiceBindingSpecDie
+// +die:field:name=NamespaceSelector,package=_/meta/v1,die=LabelSelectorDie,pointer=true
+type _ = servicebindingv1.ClusterServiceBindingSpec
+
+// +die
+// +die:field:name=Conditions,package=_/meta/v1,die=ConditionDie,listType=atomic
+// +die:field:name=Namespaces,die=ClusterServiceBindingNamespaceStatusDie,listMapKey=Namespace
+type _ = servicebindingv1.ClusterServiceBindingStatus
+
+var ClusterServiceBindingConditionReady = diemetav1.ConditionBlank.Type(servicebindingv1.ClusterServiceBindingConditionReady).Unknown().Reason("Initializing")
+var ClusterServiceBindingConditionNamespacesReady = diemetav1.ConditionBlank.Type(servicebindingv1.ClusterServiceBindingConditionNamespacesReady).Unknown().Reason("Initializing")
+
+// +die
+// +die:field:name=Conditions,package=_/meta/v1,die=ConditionDie,listType=atomic
+// +die:field:name=Binding,die=ServiceBindingSecretReferenceDie,pointer=true
+type _ = servicebindingv1.ClusterServiceBindingNamespaceStatus
@@ -29,26 +29,46 @@ type _ = servicebindingv1.ServiceBinding
 // +die:field:name=Workload,die=ServiceBindingWorkloadReferenceDie
 // +die:field:name=Service,die=ServiceBindingServiceReferenceDie
 // +die:field:name=Env,die=EnvMappingDie,listType=map
+// +die:field:name=Rollout,die=ServiceBindingRolloutDie,pointer=true
 type _ = servicebindingv1.ServiceBindingSpec
 
 // +die
 // +die:field:name=Selector,package=_/meta/v1,die=LabelSelectorDie,pointer=true
+// +die:field:name=Owner,die=ServiceBindingWorkloadOwnerReferenceDie,pointer=true
 type _ = servicebindingv1.ServiceBindingWorkloadReference
 
+// +die
+type _ = servicebindingv1.ServiceBindingWorkloadOwnerReference
+
 // +die
 type _ = servicebindingv1.ServiceBindingServiceReference
 
 // +die
 type _ = servicebindingv1.EnvMapping
 
+// +die
+type _ = servicebindingv1.ServiceBindingRollout
+
 // +die
 // +die:field:name=Conditions,package=_/meta/v1,die=ConditionDie,listType=atomic
 // +die:field:name=Binding,die=ServiceBindingSecretReferenceDie,pointer=true
+// +die:field:name=Workloads,die=ServiceBindingWorkloadStatusDie,listType=atomic
+// +die:field:name=Rollout,die=ServiceBindingRolloutStatusDie,pointer=true
 type _ = servicebindingv1.ServiceBindingStatus
 
 var ServiceBindingConditionReady = diemetav1.ConditionBlank.Type(servicebindingv1.ServiceBindingConditionReady).Unknown().Reason("Initializing")
 var ServiceBindingConditionServiceAvailable = diemetav1.ConditionBlank.Type(servicebindingv1.ServiceBindingConditionServiceAvailable).Unknown().Reason("Initializing")
+var ServiceBindingConditionSecretValid = diemetav1.ConditionBlank.Type(servicebindingv1.ServiceBindingConditionSecretValid).Unknown().Reason("Initializing")
 var ServiceBindingConditionWorkloadProjected = diemetav1.ConditionBlank.Type(servicebindingv1.ServiceBindingConditionWorkloadProjected).Unknown().Reason("Initializing")
+var ServiceBindingConditionWorkloadReady = diemetav1.ConditionBlank.Type(servicebindingv1.ServiceBindingConditionWorkloadReady).Unknown().Reason("Initializing")
+var ServiceBindingConditionSuspended = diemetav1.ConditionBlank.Type(servicebindingv1.ServiceBindingConditionSuspended).Unknown().Reason("Initializing")
+var ServiceBindingConditionTypeResolved = diemetav1.ConditionBlank.Type(servicebindingv1.ServiceBindingConditionTypeResolved).Unknown().Reason("Initializing")
 
 // +die
 type _ = servicebindingv1.ServiceBindingSecretReference
+
+// +die
+type _ = servicebindingv1.ServiceBindingWorkloadStatus
+
+// +die
+type _ = servicebindingv1.ServiceBindingRolloutStatus
@@ -32,6 +32,7 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 	json "k8s.io/apimachinery/pkg/util/json"
 	jsonpath "k8s.io/client-go/util/jsonpath"
 	metav1 "reconciler.io/dies/apis/meta/v1"
@@ -41,17 +42,17 @@ import (
 	apisv1 "github.com/servicebinding/runtime/apis/v1"
 )
 
-var ClusterWorkloadResourceMappingBlank = (&ClusterWorkloadResourceMappingDie{}).DieFeed(apisv1.ClusterWorkloadResourceMapping{})
+var ClusterServiceBindingBlank = (&ClusterServiceBindingDie{}).DieFeed(apisv1.ClusterServiceBinding{})
 
-type ClusterWorkloadResourceMappingDie struct {
+type ClusterServiceBindingDie struct {
 	metav1.FrozenObjectMeta
 	mutable bool
-	r       apisv1.ClusterWorkloadResourceMapping
-	seal    apisv1.ClusterWorkloadResourceMapping
+	r       apisv1.ClusterServiceBinding
+	seal    apisv1.ClusterServiceBinding
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ClusterWorkloadResourceMappingDie) DieImmutable(immutable bool) *ClusterWorkloadResourceMappingDie {
+func (d *ClusterServiceBindingDie) DieImmutable(immutable bool) *ClusterServiceBindingDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -61,13 +62,13 @@ func (d *ClusterWorkloadResourceMappingDie) DieImmutable(immutable bool) *Cluste
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ClusterWorkloadResourceMappingDie) DieFeed(r apisv1.ClusterWorkloadResourceMapping) *ClusterWorkloadResourceMappingDie {
+func (d *ClusterServiceBindingDie) DieFeed(r apisv1.ClusterServiceBinding) *ClusterServiceBindingDie {
 	if d.mutable {
 		d.FrozenObjectMeta = metav1.FreezeObjectMeta(r.ObjectMeta)
 		d.r = r
 		return d
 	}
-	return &ClusterWorkloadResourceMappingDie{
+	return &ClusterServiceBindingDie{
 		FrozenObjectMeta: metav1.FreezeObjectMeta(r.ObjectMeta),
 		mutable:          d.mutable,
 		r:                r,
@@ -76,15 +77,15 @@ func (d *ClusterWorkloadResourceMappingDie) DieFeed(r apisv1.ClusterWorkloadReso
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ClusterWorkloadResourceMappingDie) DieFeedPtr(r *apisv1.ClusterWorkloadResourceMapping) *ClusterWorkloadResourceMappingDie {
+func (d *ClusterServiceBindingDie) DieFeedPtr(r *apisv1.ClusterServiceBinding) *ClusterServiceBindingDie {
 	if r == nil {
-		r = &apisv1.ClusterWorkloadResourceMapping{}
+		r = &apisv1.ClusterServiceBinding{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *ClusterWorkloadResourceMappingDie) DieFeedDuck(v any) *ClusterWorkloadResourceMappingDie {
+func (d *ClusterServiceBindingDie) DieFeedDuck(v any) *ClusterServiceBindingDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -93,8 +94,8 @@ func (d *ClusterWorkloadResourceMappingDie) DieFeedDuck(v any) *ClusterWorkloadR
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *ClusterWorkloadResourceMappingDie) DieFeedJSON(j []byte) *ClusterWorkloadResourceMappingDie {
-	r := apisv1.ClusterWorkloadResourceMapping{}
+func (d *ClusterServiceBindingDie) DieFeedJSON(j []byte) *ClusterServiceBindingDie {
+	r := apisv1.ClusterServiceBinding{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -102,8 +103,8 @@ func (d *ClusterWorkloadResourceMappingDie) DieFeedJSON(j []byte) *ClusterWorklo
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *ClusterWorkloadResourceMappingDie) DieFeedYAML(y []byte) *ClusterWorkloadResourceMappingDie {
-	r := apisv1.ClusterWorkloadResourceMapping{}
+func (d *ClusterServiceBindingDie) DieFeedYAML(y []byte) *ClusterServiceBindingDie {
+	r := apisv1.ClusterServiceBinding{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -111,7 +112,7 @@ func (d *ClusterWorkloadResourceMappingDie) DieFeedYAML(y []byte) *ClusterWorklo
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *ClusterWorkloadResourceMappingDie) DieFeedYAMLFile(name string) *ClusterWorkloadResourceMappingDie {
+func (d *ClusterServiceBindingDie) DieFeedYAMLFile(name string) *ClusterServiceBindingDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -120,7 +121,7 @@ func (d *ClusterWorkloadResourceMappingDie) DieFeedYAMLFile(name string) *Cluste
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ClusterWorkloadResourceMappingDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterWorkloadResourceMappingDie {
+func (d *ClusterServiceBindingDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterServiceBindingDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -129,7 +130,7 @@ func (d *ClusterWorkloadResourceMappingDie) DieFeedRawExtension(raw runtime.RawE
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ClusterWorkloadResourceMappingDie) DieRelease() apisv1.ClusterWorkloadResourceMapping {
+func (d *ClusterServiceBindingDie) DieRelease() apisv1.ClusterServiceBinding {
 	if d.mutable {
 		return d.r
 	}
@@ -137,13 +138,13 @@ func (d *ClusterWorkloadResourceMappingDie) DieRelease() apisv1.ClusterWorkloadR
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ClusterWorkloadResourceMappingDie) DieReleasePtr() *apisv1.ClusterWorkloadResourceMapping {
+func (d *ClusterServiceBindingDie) DieReleasePtr() *apisv1.ClusterServiceBinding {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseUnstructured returns the resource managed by the die as an unstructured object. Panics on error.
-func (d *ClusterWorkloadResourceMappingDie) DieReleaseUnstructured() *unstructured.Unstructured {
+func (d *ClusterServiceBindingDie) DieReleaseUnstructured() *unstructured.Unstructured {
 	r := d.DieReleasePtr()
 	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
 	if err != nil {
@@ -155,7 +156,7 @@ func (d *ClusterWorkloadResourceMappingDie) DieReleaseUnstructured() *unstructur
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *ClusterWorkloadResourceMappingDie) DieReleaseDuck(v any) any {
+func (d *ClusterServiceBindingDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -164,7 +165,7 @@ func (d *ClusterWorkloadResourceMappingDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *ClusterWorkloadResourceMappingDie) DieReleaseJSON() []byte {
+func (d *ClusterServiceBindingDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -174,7 +175,7 @@ func (d *ClusterWorkloadResourceMappingDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *ClusterWorkloadResourceMappingDie) DieReleaseYAML() []byte {
+func (d *ClusterServiceBindingDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -184,7 +185,7 @@ func (d *ClusterWorkloadResourceMappingDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ClusterWorkloadResourceMappingDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ClusterServiceBindingDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -194,7 +195,7 @@ func (d *ClusterWorkloadResourceMappingDie) DieReleaseRawExtension() runtime.Raw
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ClusterWorkloadResourceMappingDie) DieStamp(fn func(r *apisv1.ClusterWorkloadResourceMapping)) *ClusterWorkloadResourceMappingDie {
+func (d *ClusterServiceBindingDie) DieStamp(fn func(r *apisv1.ClusterServiceBinding)) *ClusterServiceBindingDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -203,8 +204,8 @@ func (d *ClusterWorkloadResourceMappingDie) DieStamp(fn func(r *apisv1.ClusterWo
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *ClusterWorkloadResourceMappingDie) DieStampAt(jp string, fn interface{}) *ClusterWorkloadResourceMappingDie {
-	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMapping) {
+func (d *ClusterServiceBindingDie) DieStampAt(jp string, fn interface{}) *ClusterServiceBindingDie {
+	return d.DieStamp(func(r *apisv1.ClusterServiceBinding) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -239,8 +240,8 @@ func (d *ClusterWorkloadResourceMappingDie) DieStampAt(jp string, fn interface{}
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *ClusterWorkloadResourceMappingDie) DieWith(fns ...func(d *ClusterWorkloadResourceMappingDie)) *ClusterWorkloadResourceMappingDie {
-	nd := ClusterWorkloadResourceMappingBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *ClusterServiceBindingDie) DieWith(fns ...func(d *ClusterServiceBindingDie)) *ClusterServiceBindingDie {
+	nd := ClusterServiceBindingBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -250,9 +251,9 @@ func (d *ClusterWorkloadResourceMappingDie) DieWith(fns ...func(d *ClusterWorklo
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ClusterWorkloadResourceMappingDie) DeepCopy() *ClusterWorkloadResourceMappingDie {
+func (d *ClusterServiceBindingDie) DeepCopy() *ClusterServiceBindingDie {
 	r := *d.r.DeepCopy()
-	return &ClusterWorkloadResourceMappingDie{
+	return &ClusterServiceBindingDie{
 		FrozenObjectMeta: metav1.FreezeObjectMeta(r.ObjectMeta),
 		mutable:          d.mutable,
 		r:                r,
@@ -261,12 +262,12 @@ func (d *ClusterWorkloadResourceMappingDie) DeepCopy() *ClusterWorkloadResourceM
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *ClusterWorkloadResourceMappingDie) DieSeal() *ClusterWorkloadResourceMappingDie {
+func (d *ClusterServiceBindingDie) DieSeal() *ClusterServiceBindingDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *ClusterWorkloadResourceMappingDie) DieSealFeed(r apisv1.ClusterWorkloadResourceMapping) *ClusterWorkloadResourceMappingDie {
+func (d *ClusterServiceBindingDie) DieSealFeed(r apisv1.ClusterServiceBinding) *ClusterServiceBindingDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -275,83 +276,83 @@ func (d *ClusterWorkloadResourceMappingDie) DieSealFeed(r apisv1.ClusterWorkload
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *ClusterWorkloadResourceMappingDie) DieSealFeedPtr(r *apisv1.ClusterWorkloadResourceMapping) *ClusterWorkloadResourceMappingDie {
+func (d *ClusterServiceBindingDie) DieSealFeedPtr(r *apisv1.ClusterServiceBinding) *ClusterServiceBindingDie {
 	if r == nil {
-		r = &apisv1.ClusterWorkloadResourceMapping{}
+		r = &apisv1.ClusterServiceBinding{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *ClusterWorkloadResourceMappingDie) DieSealRelease() apisv1.ClusterWorkloadResourceMapping {
+func (d *ClusterServiceBindingDie) DieSealRelease() apisv1.ClusterServiceBinding {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *ClusterWorkloadResourceMappingDie) DieSealReleasePtr() *apisv1.ClusterWorkloadResourceMapping {
+func (d *ClusterServiceBindingDie) DieSealReleasePtr() *apisv1.ClusterServiceBinding {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *ClusterWorkloadResourceMappingDie) DieDiff(opts ...cmp.Option) string {
+func (d *ClusterServiceBindingDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *ClusterWorkloadResourceMappingDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *ClusterServiceBindingDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-var _ runtime.Object = (*ClusterWorkloadResourceMappingDie)(nil)
+var _ runtime.Object = (*ClusterServiceBindingDie)(nil)
 
-func (d *ClusterWorkloadResourceMappingDie) DeepCopyObject() runtime.Object {
+func (d *ClusterServiceBindingDie) DeepCopyObject() runtime.Object {
 	return d.r.DeepCopy()
 }
 
-func (d *ClusterWorkloadResourceMappingDie) GetObjectKind() schema.ObjectKind {
+func (d *ClusterServiceBindingDie) GetObjectKind() schema.ObjectKind {
 	r := d.DieRelease()
 	return r.GetObjectKind()
 }
 
-func (d *ClusterWorkloadResourceMappingDie) MarshalJSON() ([]byte, error) {
+func (d *ClusterServiceBindingDie) MarshalJSON() ([]byte, error) {
 	return json.Marshal(d.r)
 }
 
-func (d *ClusterWorkloadResourceMappingDie) UnmarshalJSON(b []byte) error {
+func (d *ClusterServiceBindingDie) UnmarshalJSON(b []byte) error {
 	if !d.mutable {
 		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
 	}
-	resource := &apisv1.ClusterWorkloadResourceMapping{}
+	resource := &apisv1.ClusterServiceBinding{}
 	err := json.Unmarshal(b, resource)
 	*d = *d.DieFeed(*resource)
 	return err
 }
 
 // APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
-func (d *ClusterWorkloadResourceMappingDie) APIVersion(v string) *ClusterWorkloadResourceMappingDie {
-	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMapping) {
+func (d *ClusterServiceBindingDie) APIVersion(v string) *ClusterServiceBindingDie {
+	return d.DieStamp(func(r *apisv1.ClusterServiceBinding) {
 		r.APIVersion = v
 	})
 }
 
 // Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-func (d *ClusterWorkloadResourceMappingDie) Kind(v string) *ClusterWorkloadResourceMappingDie {
-	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMapping) {
+func (d *ClusterServiceBindingDie) Kind(v string) *ClusterServiceBindingDie {
+	return d.DieStamp(func(r *apisv1.ClusterServiceBinding) {
 		r.Kind = v
 	})
 }
 
 // TypeMetadata standard object's type metadata.
-func (d *ClusterWorkloadResourceMappingDie) TypeMetadata(v apismetav1.TypeMeta) *ClusterWorkloadResourceMappingDie {
-	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMapping) {
+func (d *ClusterServiceBindingDie) TypeMetadata(v apismetav1.TypeMeta) *ClusterServiceBindingDie {
+	return d.DieStamp(func(r *apisv1.ClusterServiceBinding) {
 		r.TypeMeta = v
 	})
 }
 
 // TypeMetadataDie stamps the resource's TypeMeta field with a mutable die.
-func (d *ClusterWorkloadResourceMappingDie) TypeMetadataDie(fn func(d *metav1.TypeMetaDie)) *ClusterWorkloadResourceMappingDie {
-	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMapping) {
+func (d *ClusterServiceBindingDie) TypeMetadataDie(fn func(d *metav1.TypeMetaDie)) *ClusterServiceBindingDie {
+	return d.DieStamp(func(r *apisv1.ClusterServiceBinding) {
 		d := metav1.TypeMetaBlank.DieImmutable(false).DieFeed(r.TypeMeta)
 		fn(d)
 		r.TypeMeta = d.DieRelease()
@@ -359,15 +360,15 @@ func (d *ClusterWorkloadResourceMappingDie) TypeMetadataDie(fn func(d *metav1.Ty
 }
 
 // Metadata standard object's metadata.
-func (d *ClusterWorkloadResourceMappingDie) Metadata(v apismetav1.ObjectMeta) *ClusterWorkloadResourceMappingDie {
-	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMapping) {
+func (d *ClusterServiceBindingDie) Metadata(v apismetav1.ObjectMeta) *ClusterServiceBindingDie {
+	return d.DieStamp(func(r *apisv1.ClusterServiceBinding) {
 		r.ObjectMeta = v
 	})
 }
 
 // MetadataDie stamps the resource's ObjectMeta field with a mutable die.
-func (d *ClusterWorkloadResourceMappingDie) MetadataDie(fn func(d *metav1.ObjectMetaDie)) *ClusterWorkloadResourceMappingDie {
-	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMapping) {
+func (d *ClusterServiceBindingDie) MetadataDie(fn func(d *metav1.ObjectMetaDie)) *ClusterServiceBindingDie {
+	return d.DieStamp(func(r *apisv1.ClusterServiceBinding) {
 		d := metav1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
 		fn(d)
 		r.ObjectMeta = d.DieRelease()
@@ -375,30 +376,45 @@ func (d *ClusterWorkloadResourceMappingDie) MetadataDie(fn func(d *metav1.Object
 }
 
 // SpecDie stamps the resource's spec field with a mutable die.
-func (d *ClusterWorkloadResourceMappingDie) SpecDie(fn func(d *ClusterWorkloadResourceMappingSpecDie)) *ClusterWorkloadResourceMappingDie {
-	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMapping) {
-		d := ClusterWorkloadResourceMappingSpecBlank.DieImmutable(false).DieFeed(r.Spec)
+func (d *ClusterServiceBindingDie) SpecDie(fn func(d *ClusterServiceBindingSpecDie)) *ClusterServiceBindingDie {
+	return d.DieStamp(func(r *apisv1.ClusterServiceBinding) {
+		d := ClusterServiceBindingSpecBlank.DieImmutable(false).DieFeed(r.Spec)
 		fn(d)
 		r.Spec = d.DieRelease()
 	})
 }
 
-func (d *ClusterWorkloadResourceMappingDie) Spec(v apisv1.ClusterWorkloadResourceMappingSpec) *ClusterWorkloadResourceMappingDie {
-	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMapping) {
+// StatusDie stamps the resource's status field with a mutable die.
+func (d *ClusterServiceBindingDie) StatusDie(fn func(d *ClusterServiceBindingStatusDie)) *ClusterServiceBindingDie {
+	return d.DieStamp(func(r *apisv1.ClusterServiceBinding) {
+		d := ClusterServiceBindingStatusBlank.DieImmutable(false).DieFeed(r.Status)
+		fn(d)
+		r.Status = d.DieRelease()
+	})
+}
+
+func (d *ClusterServiceBindingDie) Spec(v apisv1.ClusterServiceBindingSpec) *ClusterServiceBindingDie {
+	return d.DieStamp(func(r *apisv1.ClusterServiceBinding) {
 		r.Spec = v
 	})
 }
 
-var ClusterWorkloadResourceMappingSpecBlank = (&ClusterWorkloadResourceMappingSpecDie{}).DieFeed(apisv1.ClusterWorkloadResourceMappingSpec{})
+func (d *ClusterServiceBindingDie) Status(v apisv1.ClusterServiceBindingStatus) *ClusterServiceBindingDie {
+	return d.DieStamp(func(r *apisv1.ClusterServiceBinding) {
+		r.Status = v
+	})
+}
 
-type ClusterWorkloadResourceMappingSpecDie struct {
+var ClusterServiceBindingSpecBlank = (&ClusterServiceBindingSpecDie{}).DieFeed(apisv1.ClusterServiceBindingSpec{})
+
+type ClusterServiceBindingSpecDie struct {
 	mutable bool
-	r       apisv1.ClusterWorkloadResourceMappingSpec
-	seal    apisv1.ClusterWorkloadResourceMappingSpec
+	r       apisv1.ClusterServiceBindingSpec
+	seal    apisv1.ClusterServiceBindingSpec
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ClusterWorkloadResourceMappingSpecDie) DieImmutable(immutable bool) *ClusterWorkloadResourceMappingSpecDie {
+func (d *ClusterServiceBindingSpecDie) DieImmutable(immutable bool) *ClusterServiceBindingSpecDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -408,12 +424,12 @@ func (d *ClusterWorkloadResourceMappingSpecDie) DieImmutable(immutable bool) *Cl
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieFeed(r apisv1.ClusterWorkloadResourceMappingSpec) *ClusterWorkloadResourceMappingSpecDie {
+func (d *ClusterServiceBindingSpecDie) DieFeed(r apisv1.ClusterServiceBindingSpec) *ClusterServiceBindingSpecDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &ClusterWorkloadResourceMappingSpecDie{
+	return &ClusterServiceBindingSpecDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -421,15 +437,15 @@ func (d *ClusterWorkloadResourceMappingSpecDie) DieFeed(r apisv1.ClusterWorkload
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieFeedPtr(r *apisv1.ClusterWorkloadResourceMappingSpec) *ClusterWorkloadResourceMappingSpecDie {
+func (d *ClusterServiceBindingSpecDie) DieFeedPtr(r *apisv1.ClusterServiceBindingSpec) *ClusterServiceBindingSpecDie {
 	if r == nil {
-		r = &apisv1.ClusterWorkloadResourceMappingSpec{}
+		r = &apisv1.ClusterServiceBindingSpec{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieFeedDuck(v any) *ClusterWorkloadResourceMappingSpecDie {
+func (d *ClusterServiceBindingSpecDie) DieFeedDuck(v any) *ClusterServiceBindingSpecDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -438,8 +454,8 @@ func (d *ClusterWorkloadResourceMappingSpecDie) DieFeedDuck(v any) *ClusterWorkl
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieFeedJSON(j []byte) *ClusterWorkloadResourceMappingSpecDie {
-	r := apisv1.ClusterWorkloadResourceMappingSpec{}
+func (d *ClusterServiceBindingSpecDie) DieFeedJSON(j []byte) *ClusterServiceBindingSpecDie {
+	r := apisv1.ClusterServiceBindingSpec{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -447,8 +463,8 @@ func (d *ClusterWorkloadResourceMappingSpecDie) DieFeedJSON(j []byte) *ClusterWo
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieFeedYAML(y []byte) *ClusterWorkloadResourceMappingSpecDie {
-	r := apisv1.ClusterWorkloadResourceMappingSpec{}
+func (d *ClusterServiceBindingSpecDie) DieFeedYAML(y []byte) *ClusterServiceBindingSpecDie {
+	r := apisv1.ClusterServiceBindingSpec{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -456,7 +472,7 @@ func (d *ClusterWorkloadResourceMappingSpecDie) DieFeedYAML(y []byte) *ClusterWo
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieFeedYAMLFile(name string) *ClusterWorkloadResourceMappingSpecDie {
+func (d *ClusterServiceBindingSpecDie) DieFeedYAMLFile(name string) *ClusterServiceBindingSpecDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -465,7 +481,7 @@ func (d *ClusterWorkloadResourceMappingSpecDie) DieFeedYAMLFile(name string) *Cl
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterWorkloadResourceMappingSpecDie {
+func (d *ClusterServiceBindingSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterServiceBindingSpecDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -474,7 +490,7 @@ func (d *ClusterWorkloadResourceMappingSpecDie) DieFeedRawExtension(raw runtime.
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieRelease() apisv1.ClusterWorkloadResourceMappingSpec {
+func (d *ClusterServiceBindingSpecDie) DieRelease() apisv1.ClusterServiceBindingSpec {
 	if d.mutable {
 		return d.r
 	}
@@ -482,13 +498,13 @@ func (d *ClusterWorkloadResourceMappingSpecDie) DieRelease() apisv1.ClusterWorkl
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieReleasePtr() *apisv1.ClusterWorkloadResourceMappingSpec {
+func (d *ClusterServiceBindingSpecDie) DieReleasePtr() *apisv1.ClusterServiceBindingSpec {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieReleaseDuck(v any) any {
+func (d *ClusterServiceBindingSpecDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -497,7 +513,7 @@ func (d *ClusterWorkloadResourceMappingSpecDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieReleaseJSON() []byte {
+func (d *ClusterServiceBindingSpecDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -507,7 +523,7 @@ func (d *ClusterWorkloadResourceMappingSpecDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieReleaseYAML() []byte {
+func (d *ClusterServiceBindingSpecDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -517,7 +533,7 @@ func (d *ClusterWorkloadResourceMappingSpecDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ClusterServiceBindingSpecDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -527,7 +543,7 @@ func (d *ClusterWorkloadResourceMappingSpecDie) DieReleaseRawExtension() runtime
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieStamp(fn func(r *apisv1.ClusterWorkloadResourceMappingSpec)) *ClusterWorkloadResourceMappingSpecDie {
+func (d *ClusterServiceBindingSpecDie) DieStamp(fn func(r *apisv1.ClusterServiceBindingSpec)) *ClusterServiceBindingSpecDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -536,8 +552,8 @@ func (d *ClusterWorkloadResourceMappingSpecDie) DieStamp(fn func(r *apisv1.Clust
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieStampAt(jp string, fn interface{}) *ClusterWorkloadResourceMappingSpecDie {
-	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMappingSpec) {
+func (d *ClusterServiceBindingSpecDie) DieStampAt(jp string, fn interface{}) *ClusterServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1.ClusterServiceBindingSpec) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -572,8 +588,8 @@ func (d *ClusterWorkloadResourceMappingSpecDie) DieStampAt(jp string, fn interfa
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieWith(fns ...func(d *ClusterWorkloadResourceMappingSpecDie)) *ClusterWorkloadResourceMappingSpecDie {
-	nd := ClusterWorkloadResourceMappingSpecBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *ClusterServiceBindingSpecDie) DieWith(fns ...func(d *ClusterServiceBindingSpecDie)) *ClusterServiceBindingSpecDie {
+	nd := ClusterServiceBindingSpecBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -583,9 +599,9 @@ func (d *ClusterWorkloadResourceMappingSpecDie) DieWith(fns ...func(d *ClusterWo
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ClusterWorkloadResourceMappingSpecDie) DeepCopy() *ClusterWorkloadResourceMappingSpecDie {
+func (d *ClusterServiceBindingSpecDie) DeepCopy() *ClusterServiceBindingSpecDie {
 	r := *d.r.DeepCopy()
-	return &ClusterWorkloadResourceMappingSpecDie{
+	return &ClusterServiceBindingSpecDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -593,12 +609,12 @@ func (d *ClusterWorkloadResourceMappingSpecDie) DeepCopy() *ClusterWorkloadResou
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieSeal() *ClusterWorkloadResourceMappingSpecDie {
+func (d *ClusterServiceBindingSpecDie) DieSeal() *ClusterServiceBindingSpecDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieSealFeed(r apisv1.ClusterWorkloadResourceMappingSpec) *ClusterWorkloadResourceMappingSpecDie {
+func (d *ClusterServiceBindingSpecDie) DieSealFeed(r apisv1.ClusterServiceBindingSpec) *ClusterServiceBindingSpecDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -607,71 +623,84 @@ func (d *ClusterWorkloadResourceMappingSpecDie) DieSealFeed(r apisv1.ClusterWork
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieSealFeedPtr(r *apisv1.ClusterWorkloadResourceMappingSpec) *ClusterWorkloadResourceMappingSpecDie {
+func (d *ClusterServiceBindingSpecDie) DieSealFeedPtr(r *apisv1.ClusterServiceBindingSpec) *ClusterServiceBindingSpecDie {
 	if r == nil {
-		r = &apisv1.ClusterWorkloadResourceMappingSpec{}
+		r = &apisv1.ClusterServiceBindingSpec{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieSealRelease() apisv1.ClusterWorkloadResourceMappingSpec {
+func (d *ClusterServiceBindingSpecDie) DieSealRelease() apisv1.ClusterServiceBindingSpec {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieSealReleasePtr() *apisv1.ClusterWorkloadResourceMappingSpec {
+func (d *ClusterServiceBindingSpecDie) DieSealReleasePtr() *apisv1.ClusterServiceBindingSpec {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieDiff(opts ...cmp.Option) string {
+func (d *ClusterServiceBindingSpecDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *ClusterWorkloadResourceMappingSpecDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *ClusterServiceBindingSpecDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-// VersionDie mutates a single item in Versions matched by the nested field Version, appending a new item if no match is found.
+// ServiceBindingSpecDie mutates ServiceBindingSpec as a die.
 //
-// Versions is the collection of versions for a given resource, with mappings.
-func (d *ClusterWorkloadResourceMappingSpecDie) VersionDie(v string, fn func(d *ClusterWorkloadResourceMappingTemplateDie)) *ClusterWorkloadResourceMappingSpecDie {
-	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMappingSpec) {
-		for i := range r.Versions {
-			if v == r.Versions[i].Version {
-				d := ClusterWorkloadResourceMappingTemplateBlank.DieImmutable(false).DieFeed(r.Versions[i])
-				fn(d)
-				r.Versions[i] = d.DieRelease()
-				return
-			}
-		}
+// ServiceBindingSpec is applied in each selected namespace. The service and workloads are resolved within that
+//
+// namespace.
+func (d *ClusterServiceBindingSpecDie) ServiceBindingSpecDie(fn func(d *ServiceBindingSpecDie)) *ClusterServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1.ClusterServiceBindingSpec) {
+		d := ServiceBindingSpecBlank.DieImmutable(false).DieFeed(r.ServiceBindingSpec)
+		fn(d)
+		r.ServiceBindingSpec = d.DieRelease()
+	})
+}
 
-		d := ClusterWorkloadResourceMappingTemplateBlank.DieImmutable(false).DieFeed(apisv1.ClusterWorkloadResourceMappingTemplate{Version: v})
+// NamespaceSelectorDie mutates NamespaceSelector as a die.
+//
+// NamespaceSelector is a query that selects the namespaces to bind the service into
+func (d *ClusterServiceBindingSpecDie) NamespaceSelectorDie(fn func(d *metav1.LabelSelectorDie)) *ClusterServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1.ClusterServiceBindingSpec) {
+		d := metav1.LabelSelectorBlank.DieImmutable(false).DieFeedPtr(r.NamespaceSelector)
 		fn(d)
-		r.Versions = append(r.Versions, d.DieRelease())
+		r.NamespaceSelector = d.DieReleasePtr()
 	})
 }
 
-// Versions is the collection of versions for a given resource, with mappings.
-func (d *ClusterWorkloadResourceMappingSpecDie) Versions(v ...apisv1.ClusterWorkloadResourceMappingTemplate) *ClusterWorkloadResourceMappingSpecDie {
-	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMappingSpec) {
-		r.Versions = v
+// ServiceBindingSpec is applied in each selected namespace. The service and workloads are resolved within that
+//
+// namespace.
+func (d *ClusterServiceBindingSpecDie) ServiceBindingSpec(v apisv1.ServiceBindingSpec) *ClusterServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1.ClusterServiceBindingSpec) {
+		r.ServiceBindingSpec = v
 	})
 }
 
-var ClusterWorkloadResourceMappingTemplateBlank = (&ClusterWorkloadResourceMappingTemplateDie{}).DieFeed(apisv1.ClusterWorkloadResourceMappingTemplate{})
+// NamespaceSelector is a query that selects the namespaces to bind the service into
+func (d *ClusterServiceBindingSpecDie) NamespaceSelector(v *apismetav1.LabelSelector) *ClusterServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1.ClusterServiceBindingSpec) {
+		r.NamespaceSelector = v
+	})
+}
 
-type ClusterWorkloadResourceMappingTemplateDie struct {
+var ClusterServiceBindingStatusBlank = (&ClusterServiceBindingStatusDie{}).DieFeed(apisv1.ClusterServiceBindingStatus{})
+
+type ClusterServiceBindingStatusDie struct {
 	mutable bool
-	r       apisv1.ClusterWorkloadResourceMappingTemplate
-	seal    apisv1.ClusterWorkloadResourceMappingTemplate
+	r       apisv1.ClusterServiceBindingStatus
+	seal    apisv1.ClusterServiceBindingStatus
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieImmutable(immutable bool) *ClusterWorkloadResourceMappingTemplateDie {
+func (d *ClusterServiceBindingStatusDie) DieImmutable(immutable bool) *ClusterServiceBindingStatusDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -681,12 +710,12 @@ func (d *ClusterWorkloadResourceMappingTemplateDie) DieImmutable(immutable bool)
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeed(r apisv1.ClusterWorkloadResourceMappingTemplate) *ClusterWorkloadResourceMappingTemplateDie {
+func (d *ClusterServiceBindingStatusDie) DieFeed(r apisv1.ClusterServiceBindingStatus) *ClusterServiceBindingStatusDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &ClusterWorkloadResourceMappingTemplateDie{
+	return &ClusterServiceBindingStatusDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -694,15 +723,15 @@ func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeed(r apisv1.ClusterWork
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeedPtr(r *apisv1.ClusterWorkloadResourceMappingTemplate) *ClusterWorkloadResourceMappingTemplateDie {
+func (d *ClusterServiceBindingStatusDie) DieFeedPtr(r *apisv1.ClusterServiceBindingStatus) *ClusterServiceBindingStatusDie {
 	if r == nil {
-		r = &apisv1.ClusterWorkloadResourceMappingTemplate{}
+		r = &apisv1.ClusterServiceBindingStatus{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeedDuck(v any) *ClusterWorkloadResourceMappingTemplateDie {
+func (d *ClusterServiceBindingStatusDie) DieFeedDuck(v any) *ClusterServiceBindingStatusDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -711,8 +740,8 @@ func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeedDuck(v any) *ClusterW
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeedJSON(j []byte) *ClusterWorkloadResourceMappingTemplateDie {
-	r := apisv1.ClusterWorkloadResourceMappingTemplate{}
+func (d *ClusterServiceBindingStatusDie) DieFeedJSON(j []byte) *ClusterServiceBindingStatusDie {
+	r := apisv1.ClusterServiceBindingStatus{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -720,8 +749,8 @@ func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeedJSON(j []byte) *Clust
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeedYAML(y []byte) *ClusterWorkloadResourceMappingTemplateDie {
-	r := apisv1.ClusterWorkloadResourceMappingTemplate{}
+func (d *ClusterServiceBindingStatusDie) DieFeedYAML(y []byte) *ClusterServiceBindingStatusDie {
+	r := apisv1.ClusterServiceBindingStatus{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -729,7 +758,7 @@ func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeedYAML(y []byte) *Clust
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeedYAMLFile(name string) *ClusterWorkloadResourceMappingTemplateDie {
+func (d *ClusterServiceBindingStatusDie) DieFeedYAMLFile(name string) *ClusterServiceBindingStatusDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -738,7 +767,7 @@ func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeedYAMLFile(name string)
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterWorkloadResourceMappingTemplateDie {
+func (d *ClusterServiceBindingStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterServiceBindingStatusDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -747,7 +776,7 @@ func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeedRawExtension(raw runt
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieRelease() apisv1.ClusterWorkloadResourceMappingTemplate {
+func (d *ClusterServiceBindingStatusDie) DieRelease() apisv1.ClusterServiceBindingStatus {
 	if d.mutable {
 		return d.r
 	}
@@ -755,13 +784,13 @@ func (d *ClusterWorkloadResourceMappingTemplateDie) DieRelease() apisv1.ClusterW
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieReleasePtr() *apisv1.ClusterWorkloadResourceMappingTemplate {
+func (d *ClusterServiceBindingStatusDie) DieReleasePtr() *apisv1.ClusterServiceBindingStatus {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieReleaseDuck(v any) any {
+func (d *ClusterServiceBindingStatusDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -770,7 +799,7 @@ func (d *ClusterWorkloadResourceMappingTemplateDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieReleaseJSON() []byte {
+func (d *ClusterServiceBindingStatusDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -780,7 +809,7 @@ func (d *ClusterWorkloadResourceMappingTemplateDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieReleaseYAML() []byte {
+func (d *ClusterServiceBindingStatusDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -790,7 +819,7 @@ func (d *ClusterWorkloadResourceMappingTemplateDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ClusterServiceBindingStatusDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -800,7 +829,7 @@ func (d *ClusterWorkloadResourceMappingTemplateDie) DieReleaseRawExtension() run
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieStamp(fn func(r *apisv1.ClusterWorkloadResourceMappingTemplate)) *ClusterWorkloadResourceMappingTemplateDie {
+func (d *ClusterServiceBindingStatusDie) DieStamp(fn func(r *apisv1.ClusterServiceBindingStatus)) *ClusterServiceBindingStatusDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -809,8 +838,8 @@ func (d *ClusterWorkloadResourceMappingTemplateDie) DieStamp(fn func(r *apisv1.C
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieStampAt(jp string, fn interface{}) *ClusterWorkloadResourceMappingTemplateDie {
-	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMappingTemplate) {
+func (d *ClusterServiceBindingStatusDie) DieStampAt(jp string, fn interface{}) *ClusterServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1.ClusterServiceBindingStatus) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -845,8 +874,8 @@ func (d *ClusterWorkloadResourceMappingTemplateDie) DieStampAt(jp string, fn int
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieWith(fns ...func(d *ClusterWorkloadResourceMappingTemplateDie)) *ClusterWorkloadResourceMappingTemplateDie {
-	nd := ClusterWorkloadResourceMappingTemplateBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *ClusterServiceBindingStatusDie) DieWith(fns ...func(d *ClusterServiceBindingStatusDie)) *ClusterServiceBindingStatusDie {
+	nd := ClusterServiceBindingStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -856,9 +885,9 @@ func (d *ClusterWorkloadResourceMappingTemplateDie) DieWith(fns ...func(d *Clust
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DeepCopy() *ClusterWorkloadResourceMappingTemplateDie {
+func (d *ClusterServiceBindingStatusDie) DeepCopy() *ClusterServiceBindingStatusDie {
 	r := *d.r.DeepCopy()
-	return &ClusterWorkloadResourceMappingTemplateDie{
+	return &ClusterServiceBindingStatusDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -866,12 +895,12 @@ func (d *ClusterWorkloadResourceMappingTemplateDie) DeepCopy() *ClusterWorkloadR
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieSeal() *ClusterWorkloadResourceMappingTemplateDie {
+func (d *ClusterServiceBindingStatusDie) DieSeal() *ClusterServiceBindingStatusDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieSealFeed(r apisv1.ClusterWorkloadResourceMappingTemplate) *ClusterWorkloadResourceMappingTemplateDie {
+func (d *ClusterServiceBindingStatusDie) DieSealFeed(r apisv1.ClusterServiceBindingStatus) *ClusterServiceBindingStatusDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -880,94 +909,99 @@ func (d *ClusterWorkloadResourceMappingTemplateDie) DieSealFeed(r apisv1.Cluster
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieSealFeedPtr(r *apisv1.ClusterWorkloadResourceMappingTemplate) *ClusterWorkloadResourceMappingTemplateDie {
+func (d *ClusterServiceBindingStatusDie) DieSealFeedPtr(r *apisv1.ClusterServiceBindingStatus) *ClusterServiceBindingStatusDie {
 	if r == nil {
-		r = &apisv1.ClusterWorkloadResourceMappingTemplate{}
+		r = &apisv1.ClusterServiceBindingStatus{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieSealRelease() apisv1.ClusterWorkloadResourceMappingTemplate {
+func (d *ClusterServiceBindingStatusDie) DieSealRelease() apisv1.ClusterServiceBindingStatus {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieSealReleasePtr() *apisv1.ClusterWorkloadResourceMappingTemplate {
+func (d *ClusterServiceBindingStatusDie) DieSealReleasePtr() *apisv1.ClusterServiceBindingStatus {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieDiff(opts ...cmp.Option) string {
+func (d *ClusterServiceBindingStatusDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *ClusterServiceBindingStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-// ContainersDie replaces Containers by collecting the released value from each die passed.
-//
-// Containers is the collection of mappings to container-like fragments of the workload resource. Defaults to
+// ConditionsDie replaces Conditions by collecting the released value from each die passed.
 //
-// mappings appropriate for a PodSpecable resource.
-func (d *ClusterWorkloadResourceMappingTemplateDie) ContainersDie(v ...*ClusterWorkloadResourceMappingContainerDie) *ClusterWorkloadResourceMappingTemplateDie {
-	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMappingTemplate) {
-		r.Containers = make([]apisv1.ClusterWorkloadResourceMappingContainer, len(v))
+// Conditions are the conditions of this ClusterServiceBinding
+func (d *ClusterServiceBindingStatusDie) ConditionsDie(v ...*metav1.ConditionDie) *ClusterServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1.ClusterServiceBindingStatus) {
+		r.Conditions = make([]apismetav1.Condition, len(v))
 		for i := range v {
-			r.Containers[i] = v[i].DieRelease()
+			r.Conditions[i] = v[i].DieRelease()
 		}
 	})
 }
 
-// Version is the version of the workload resource that this mapping is for.
-func (d *ClusterWorkloadResourceMappingTemplateDie) Version(v string) *ClusterWorkloadResourceMappingTemplateDie {
-	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMappingTemplate) {
-		r.Version = v
+// NamespaceDie mutates a single item in Namespaces matched by the nested field Namespace, appending a new item if no match is found.
+//
+// Namespaces summarizes the binding within each selected namespace
+func (d *ClusterServiceBindingStatusDie) NamespaceDie(v string, fn func(d *ClusterServiceBindingNamespaceStatusDie)) *ClusterServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1.ClusterServiceBindingStatus) {
+		for i := range r.Namespaces {
+			if v == r.Namespaces[i].Namespace {
+				d := ClusterServiceBindingNamespaceStatusBlank.DieImmutable(false).DieFeed(r.Namespaces[i])
+				fn(d)
+				r.Namespaces[i] = d.DieRelease()
+				return
+			}
+		}
+
+		d := ClusterServiceBindingNamespaceStatusBlank.DieImmutable(false).DieFeed(apisv1.ClusterServiceBindingNamespaceStatus{Namespace: v})
+		fn(d)
+		r.Namespaces = append(r.Namespaces, d.DieRelease())
 	})
 }
 
-// Annotations is a Restricted JSONPath that references the annotations map within the workload resource. These
-//
-// annotations must end up in the resulting Pod, and are generally not the workload resource's annotations.
+// ObservedGeneration is the 'Generation' of the ClusterServiceBinding that
 //
-// Defaults to `.spec.template.metadata.annotations`.
-func (d *ClusterWorkloadResourceMappingTemplateDie) Annotations(v string) *ClusterWorkloadResourceMappingTemplateDie {
-	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMappingTemplate) {
-		r.Annotations = v
+// was last processed by the controller.
+func (d *ClusterServiceBindingStatusDie) ObservedGeneration(v int64) *ClusterServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1.ClusterServiceBindingStatus) {
+		r.ObservedGeneration = v
 	})
 }
 
-// Containers is the collection of mappings to container-like fragments of the workload resource. Defaults to
-//
-// mappings appropriate for a PodSpecable resource.
-func (d *ClusterWorkloadResourceMappingTemplateDie) Containers(v ...apisv1.ClusterWorkloadResourceMappingContainer) *ClusterWorkloadResourceMappingTemplateDie {
-	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMappingTemplate) {
-		r.Containers = v
+// Conditions are the conditions of this ClusterServiceBinding
+func (d *ClusterServiceBindingStatusDie) Conditions(v ...apismetav1.Condition) *ClusterServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1.ClusterServiceBindingStatus) {
+		r.Conditions = v
 	})
 }
 
-// Volumes is a Restricted JSONPath that references the slice of volumes within the workload resource. Defaults to
-//
-// `.spec.template.spec.volumes`.
-func (d *ClusterWorkloadResourceMappingTemplateDie) Volumes(v string) *ClusterWorkloadResourceMappingTemplateDie {
-	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMappingTemplate) {
-		r.Volumes = v
+// Namespaces summarizes the binding within each selected namespace
+func (d *ClusterServiceBindingStatusDie) Namespaces(v ...apisv1.ClusterServiceBindingNamespaceStatus) *ClusterServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1.ClusterServiceBindingStatus) {
+		r.Namespaces = v
 	})
 }
 
-var ClusterWorkloadResourceMappingContainerBlank = (&ClusterWorkloadResourceMappingContainerDie{}).DieFeed(apisv1.ClusterWorkloadResourceMappingContainer{})
+var ClusterServiceBindingNamespaceStatusBlank = (&ClusterServiceBindingNamespaceStatusDie{}).DieFeed(apisv1.ClusterServiceBindingNamespaceStatus{})
 
-type ClusterWorkloadResourceMappingContainerDie struct {
+type ClusterServiceBindingNamespaceStatusDie struct {
 	mutable bool
-	r       apisv1.ClusterWorkloadResourceMappingContainer
-	seal    apisv1.ClusterWorkloadResourceMappingContainer
+	r       apisv1.ClusterServiceBindingNamespaceStatus
+	seal    apisv1.ClusterServiceBindingNamespaceStatus
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ClusterWorkloadResourceMappingContainerDie) DieImmutable(immutable bool) *ClusterWorkloadResourceMappingContainerDie {
+func (d *ClusterServiceBindingNamespaceStatusDie) DieImmutable(immutable bool) *ClusterServiceBindingNamespaceStatusDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -977,12 +1011,12 @@ func (d *ClusterWorkloadResourceMappingContainerDie) DieImmutable(immutable bool
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieFeed(r apisv1.ClusterWorkloadResourceMappingContainer) *ClusterWorkloadResourceMappingContainerDie {
+func (d *ClusterServiceBindingNamespaceStatusDie) DieFeed(r apisv1.ClusterServiceBindingNamespaceStatus) *ClusterServiceBindingNamespaceStatusDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &ClusterWorkloadResourceMappingContainerDie{
+	return &ClusterServiceBindingNamespaceStatusDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -990,15 +1024,15 @@ func (d *ClusterWorkloadResourceMappingContainerDie) DieFeed(r apisv1.ClusterWor
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieFeedPtr(r *apisv1.ClusterWorkloadResourceMappingContainer) *ClusterWorkloadResourceMappingContainerDie {
+func (d *ClusterServiceBindingNamespaceStatusDie) DieFeedPtr(r *apisv1.ClusterServiceBindingNamespaceStatus) *ClusterServiceBindingNamespaceStatusDie {
 	if r == nil {
-		r = &apisv1.ClusterWorkloadResourceMappingContainer{}
+		r = &apisv1.ClusterServiceBindingNamespaceStatus{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieFeedDuck(v any) *ClusterWorkloadResourceMappingContainerDie {
+func (d *ClusterServiceBindingNamespaceStatusDie) DieFeedDuck(v any) *ClusterServiceBindingNamespaceStatusDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -1007,8 +1041,8 @@ func (d *ClusterWorkloadResourceMappingContainerDie) DieFeedDuck(v any) *Cluster
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieFeedJSON(j []byte) *ClusterWorkloadResourceMappingContainerDie {
-	r := apisv1.ClusterWorkloadResourceMappingContainer{}
+func (d *ClusterServiceBindingNamespaceStatusDie) DieFeedJSON(j []byte) *ClusterServiceBindingNamespaceStatusDie {
+	r := apisv1.ClusterServiceBindingNamespaceStatus{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -1016,8 +1050,8 @@ func (d *ClusterWorkloadResourceMappingContainerDie) DieFeedJSON(j []byte) *Clus
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieFeedYAML(y []byte) *ClusterWorkloadResourceMappingContainerDie {
-	r := apisv1.ClusterWorkloadResourceMappingContainer{}
+func (d *ClusterServiceBindingNamespaceStatusDie) DieFeedYAML(y []byte) *ClusterServiceBindingNamespaceStatusDie {
+	r := apisv1.ClusterServiceBindingNamespaceStatus{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -1025,7 +1059,7 @@ func (d *ClusterWorkloadResourceMappingContainerDie) DieFeedYAML(y []byte) *Clus
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieFeedYAMLFile(name string) *ClusterWorkloadResourceMappingContainerDie {
+func (d *ClusterServiceBindingNamespaceStatusDie) DieFeedYAMLFile(name string) *ClusterServiceBindingNamespaceStatusDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -1034,7 +1068,7 @@ func (d *ClusterWorkloadResourceMappingContainerDie) DieFeedYAMLFile(name string
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterWorkloadResourceMappingContainerDie {
+func (d *ClusterServiceBindingNamespaceStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterServiceBindingNamespaceStatusDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -1043,7 +1077,7 @@ func (d *ClusterWorkloadResourceMappingContainerDie) DieFeedRawExtension(raw run
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieRelease() apisv1.ClusterWorkloadResourceMappingContainer {
+func (d *ClusterServiceBindingNamespaceStatusDie) DieRelease() apisv1.ClusterServiceBindingNamespaceStatus {
 	if d.mutable {
 		return d.r
 	}
@@ -1051,13 +1085,13 @@ func (d *ClusterWorkloadResourceMappingContainerDie) DieRelease() apisv1.Cluster
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieReleasePtr() *apisv1.ClusterWorkloadResourceMappingContainer {
+func (d *ClusterServiceBindingNamespaceStatusDie) DieReleasePtr() *apisv1.ClusterServiceBindingNamespaceStatus {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieReleaseDuck(v any) any {
+func (d *ClusterServiceBindingNamespaceStatusDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -1066,7 +1100,7 @@ func (d *ClusterWorkloadResourceMappingContainerDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieReleaseJSON() []byte {
+func (d *ClusterServiceBindingNamespaceStatusDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -1076,7 +1110,7 @@ func (d *ClusterWorkloadResourceMappingContainerDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieReleaseYAML() []byte {
+func (d *ClusterServiceBindingNamespaceStatusDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -1086,7 +1120,7 @@ func (d *ClusterWorkloadResourceMappingContainerDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ClusterServiceBindingNamespaceStatusDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -1096,7 +1130,7 @@ func (d *ClusterWorkloadResourceMappingContainerDie) DieReleaseRawExtension() ru
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieStamp(fn func(r *apisv1.ClusterWorkloadResourceMappingContainer)) *ClusterWorkloadResourceMappingContainerDie {
+func (d *ClusterServiceBindingNamespaceStatusDie) DieStamp(fn func(r *apisv1.ClusterServiceBindingNamespaceStatus)) *ClusterServiceBindingNamespaceStatusDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -1105,8 +1139,8 @@ func (d *ClusterWorkloadResourceMappingContainerDie) DieStamp(fn func(r *apisv1.
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieStampAt(jp string, fn interface{}) *ClusterWorkloadResourceMappingContainerDie {
-	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMappingContainer) {
+func (d *ClusterServiceBindingNamespaceStatusDie) DieStampAt(jp string, fn interface{}) *ClusterServiceBindingNamespaceStatusDie {
+	return d.DieStamp(func(r *apisv1.ClusterServiceBindingNamespaceStatus) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -1141,8 +1175,8 @@ func (d *ClusterWorkloadResourceMappingContainerDie) DieStampAt(jp string, fn in
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieWith(fns ...func(d *ClusterWorkloadResourceMappingContainerDie)) *ClusterWorkloadResourceMappingContainerDie {
-	nd := ClusterWorkloadResourceMappingContainerBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *ClusterServiceBindingNamespaceStatusDie) DieWith(fns ...func(d *ClusterServiceBindingNamespaceStatusDie)) *ClusterServiceBindingNamespaceStatusDie {
+	nd := ClusterServiceBindingNamespaceStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -1152,9 +1186,9 @@ func (d *ClusterWorkloadResourceMappingContainerDie) DieWith(fns ...func(d *Clus
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ClusterWorkloadResourceMappingContainerDie) DeepCopy() *ClusterWorkloadResourceMappingContainerDie {
+func (d *ClusterServiceBindingNamespaceStatusDie) DeepCopy() *ClusterServiceBindingNamespaceStatusDie {
 	r := *d.r.DeepCopy()
-	return &ClusterWorkloadResourceMappingContainerDie{
+	return &ClusterServiceBindingNamespaceStatusDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -1162,12 +1196,12 @@ func (d *ClusterWorkloadResourceMappingContainerDie) DeepCopy() *ClusterWorkload
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieSeal() *ClusterWorkloadResourceMappingContainerDie {
+func (d *ClusterServiceBindingNamespaceStatusDie) DieSeal() *ClusterServiceBindingNamespaceStatusDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieSealFeed(r apisv1.ClusterWorkloadResourceMappingContainer) *ClusterWorkloadResourceMappingContainerDie {
+func (d *ClusterServiceBindingNamespaceStatusDie) DieSealFeed(r apisv1.ClusterServiceBindingNamespaceStatus) *ClusterServiceBindingNamespaceStatusDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -1176,83 +1210,89 @@ func (d *ClusterWorkloadResourceMappingContainerDie) DieSealFeed(r apisv1.Cluste
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieSealFeedPtr(r *apisv1.ClusterWorkloadResourceMappingContainer) *ClusterWorkloadResourceMappingContainerDie {
+func (d *ClusterServiceBindingNamespaceStatusDie) DieSealFeedPtr(r *apisv1.ClusterServiceBindingNamespaceStatus) *ClusterServiceBindingNamespaceStatusDie {
 	if r == nil {
-		r = &apisv1.ClusterWorkloadResourceMappingContainer{}
+		r = &apisv1.ClusterServiceBindingNamespaceStatus{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieSealRelease() apisv1.ClusterWorkloadResourceMappingContainer {
+func (d *ClusterServiceBindingNamespaceStatusDie) DieSealRelease() apisv1.ClusterServiceBindingNamespaceStatus {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieSealReleasePtr() *apisv1.ClusterWorkloadResourceMappingContainer {
+func (d *ClusterServiceBindingNamespaceStatusDie) DieSealReleasePtr() *apisv1.ClusterServiceBindingNamespaceStatus {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieDiff(opts ...cmp.Option) string {
+func (d *ClusterServiceBindingNamespaceStatusDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *ClusterWorkloadResourceMappingContainerDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *ClusterServiceBindingNamespaceStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-// Path is the JSONPath within the workload resource that matches an existing fragment that is container-like.
-func (d *ClusterWorkloadResourceMappingContainerDie) Path(v string) *ClusterWorkloadResourceMappingContainerDie {
-	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMappingContainer) {
-		r.Path = v
+// ConditionsDie replaces Conditions by collecting the released value from each die passed.
+//
+// Conditions are the conditions of the binding within the namespace
+func (d *ClusterServiceBindingNamespaceStatusDie) ConditionsDie(v ...*metav1.ConditionDie) *ClusterServiceBindingNamespaceStatusDie {
+	return d.DieStamp(func(r *apisv1.ClusterServiceBindingNamespaceStatus) {
+		r.Conditions = make([]apismetav1.Condition, len(v))
+		for i := range v {
+			r.Conditions[i] = v[i].DieRelease()
+		}
 	})
 }
 
-// Name is a Restricted JSONPath that references the name of the container with the container-like workload resource
+// BindingDie mutates Binding as a die.
 //
-// fragment. If not defined, container name filtering is ignored.
-func (d *ClusterWorkloadResourceMappingContainerDie) Name(v string) *ClusterWorkloadResourceMappingContainerDie {
-	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMappingContainer) {
-		r.Name = v
+// Binding exposes the projected secret for the binding within the namespace
+func (d *ClusterServiceBindingNamespaceStatusDie) BindingDie(fn func(d *ServiceBindingSecretReferenceDie)) *ClusterServiceBindingNamespaceStatusDie {
+	return d.DieStamp(func(r *apisv1.ClusterServiceBindingNamespaceStatus) {
+		d := ServiceBindingSecretReferenceBlank.DieImmutable(false).DieFeedPtr(r.Binding)
+		fn(d)
+		r.Binding = d.DieReleasePtr()
 	})
 }
 
-// Env is a Restricted JSONPath that references the slice of environment variables for the container with the
-//
-// container-like workload resource fragment. The referenced location is created if it does not exist. Defaults
-//
-// to `.envs`.
-func (d *ClusterWorkloadResourceMappingContainerDie) Env(v string) *ClusterWorkloadResourceMappingContainerDie {
-	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMappingContainer) {
-		r.Env = v
+// Namespace the binding is projected into
+func (d *ClusterServiceBindingNamespaceStatusDie) Namespace(v string) *ClusterServiceBindingNamespaceStatusDie {
+	return d.DieStamp(func(r *apisv1.ClusterServiceBindingNamespaceStatus) {
+		r.Namespace = v
 	})
 }
 
-// VolumeMounts is a Restricted JSONPath that references the slice of volume mounts for the container with the
-//
-// container-like workload resource fragment. The referenced location is created if it does not exist. Defaults
-//
-// to `.volumeMounts`.
-func (d *ClusterWorkloadResourceMappingContainerDie) VolumeMounts(v string) *ClusterWorkloadResourceMappingContainerDie {
-	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMappingContainer) {
-		r.VolumeMounts = v
+// Conditions are the conditions of the binding within the namespace
+func (d *ClusterServiceBindingNamespaceStatusDie) Conditions(v ...apismetav1.Condition) *ClusterServiceBindingNamespaceStatusDie {
+	return d.DieStamp(func(r *apisv1.ClusterServiceBindingNamespaceStatus) {
+		r.Conditions = v
 	})
 }
 
-var ServiceBindingBlank = (&ServiceBindingDie{}).DieFeed(apisv1.ServiceBinding{})
+// Binding exposes the projected secret for the binding within the namespace
+func (d *ClusterServiceBindingNamespaceStatusDie) Binding(v *apisv1.ServiceBindingSecretReference) *ClusterServiceBindingNamespaceStatusDie {
+	return d.DieStamp(func(r *apisv1.ClusterServiceBindingNamespaceStatus) {
+		r.Binding = v
+	})
+}
 
-type ServiceBindingDie struct {
+var ClusterWorkloadResourceMappingBlank = (&ClusterWorkloadResourceMappingDie{}).DieFeed(apisv1.ClusterWorkloadResourceMapping{})
+
+type ClusterWorkloadResourceMappingDie struct {
 	metav1.FrozenObjectMeta
 	mutable bool
-	r       apisv1.ServiceBinding
-	seal    apisv1.ServiceBinding
+	r       apisv1.ClusterWorkloadResourceMapping
+	seal    apisv1.ClusterWorkloadResourceMapping
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ServiceBindingDie) DieImmutable(immutable bool) *ServiceBindingDie {
+func (d *ClusterWorkloadResourceMappingDie) DieImmutable(immutable bool) *ClusterWorkloadResourceMappingDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -1262,13 +1302,13 @@ func (d *ServiceBindingDie) DieImmutable(immutable bool) *ServiceBindingDie {
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ServiceBindingDie) DieFeed(r apisv1.ServiceBinding) *ServiceBindingDie {
+func (d *ClusterWorkloadResourceMappingDie) DieFeed(r apisv1.ClusterWorkloadResourceMapping) *ClusterWorkloadResourceMappingDie {
 	if d.mutable {
 		d.FrozenObjectMeta = metav1.FreezeObjectMeta(r.ObjectMeta)
 		d.r = r
 		return d
 	}
-	return &ServiceBindingDie{
+	return &ClusterWorkloadResourceMappingDie{
 		FrozenObjectMeta: metav1.FreezeObjectMeta(r.ObjectMeta),
 		mutable:          d.mutable,
 		r:                r,
@@ -1277,15 +1317,15 @@ func (d *ServiceBindingDie) DieFeed(r apisv1.ServiceBinding) *ServiceBindingDie
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ServiceBindingDie) DieFeedPtr(r *apisv1.ServiceBinding) *ServiceBindingDie {
+func (d *ClusterWorkloadResourceMappingDie) DieFeedPtr(r *apisv1.ClusterWorkloadResourceMapping) *ClusterWorkloadResourceMappingDie {
 	if r == nil {
-		r = &apisv1.ServiceBinding{}
+		r = &apisv1.ClusterWorkloadResourceMapping{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *ServiceBindingDie) DieFeedDuck(v any) *ServiceBindingDie {
+func (d *ClusterWorkloadResourceMappingDie) DieFeedDuck(v any) *ClusterWorkloadResourceMappingDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -1294,8 +1334,8 @@ func (d *ServiceBindingDie) DieFeedDuck(v any) *ServiceBindingDie {
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *ServiceBindingDie) DieFeedJSON(j []byte) *ServiceBindingDie {
-	r := apisv1.ServiceBinding{}
+func (d *ClusterWorkloadResourceMappingDie) DieFeedJSON(j []byte) *ClusterWorkloadResourceMappingDie {
+	r := apisv1.ClusterWorkloadResourceMapping{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -1303,8 +1343,8 @@ func (d *ServiceBindingDie) DieFeedJSON(j []byte) *ServiceBindingDie {
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *ServiceBindingDie) DieFeedYAML(y []byte) *ServiceBindingDie {
-	r := apisv1.ServiceBinding{}
+func (d *ClusterWorkloadResourceMappingDie) DieFeedYAML(y []byte) *ClusterWorkloadResourceMappingDie {
+	r := apisv1.ClusterWorkloadResourceMapping{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -1312,7 +1352,7 @@ func (d *ServiceBindingDie) DieFeedYAML(y []byte) *ServiceBindingDie {
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *ServiceBindingDie) DieFeedYAMLFile(name string) *ServiceBindingDie {
+func (d *ClusterWorkloadResourceMappingDie) DieFeedYAMLFile(name string) *ClusterWorkloadResourceMappingDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -1321,7 +1361,7 @@ func (d *ServiceBindingDie) DieFeedYAMLFile(name string) *ServiceBindingDie {
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ServiceBindingDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingDie {
+func (d *ClusterWorkloadResourceMappingDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterWorkloadResourceMappingDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -1330,7 +1370,7 @@ func (d *ServiceBindingDie) DieFeedRawExtension(raw runtime.RawExtension) *Servi
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ServiceBindingDie) DieRelease() apisv1.ServiceBinding {
+func (d *ClusterWorkloadResourceMappingDie) DieRelease() apisv1.ClusterWorkloadResourceMapping {
 	if d.mutable {
 		return d.r
 	}
@@ -1338,13 +1378,13 @@ func (d *ServiceBindingDie) DieRelease() apisv1.ServiceBinding {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ServiceBindingDie) DieReleasePtr() *apisv1.ServiceBinding {
+func (d *ClusterWorkloadResourceMappingDie) DieReleasePtr() *apisv1.ClusterWorkloadResourceMapping {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseUnstructured returns the resource managed by the die as an unstructured object. Panics on error.
-func (d *ServiceBindingDie) DieReleaseUnstructured() *unstructured.Unstructured {
+func (d *ClusterWorkloadResourceMappingDie) DieReleaseUnstructured() *unstructured.Unstructured {
 	r := d.DieReleasePtr()
 	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
 	if err != nil {
@@ -1356,7 +1396,7 @@ func (d *ServiceBindingDie) DieReleaseUnstructured() *unstructured.Unstructured
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *ServiceBindingDie) DieReleaseDuck(v any) any {
+func (d *ClusterWorkloadResourceMappingDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -1365,7 +1405,7 @@ func (d *ServiceBindingDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *ServiceBindingDie) DieReleaseJSON() []byte {
+func (d *ClusterWorkloadResourceMappingDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -1375,7 +1415,7 @@ func (d *ServiceBindingDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *ServiceBindingDie) DieReleaseYAML() []byte {
+func (d *ClusterWorkloadResourceMappingDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -1385,7 +1425,7 @@ func (d *ServiceBindingDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ServiceBindingDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ClusterWorkloadResourceMappingDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -1395,7 +1435,7 @@ func (d *ServiceBindingDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ServiceBindingDie) DieStamp(fn func(r *apisv1.ServiceBinding)) *ServiceBindingDie {
+func (d *ClusterWorkloadResourceMappingDie) DieStamp(fn func(r *apisv1.ClusterWorkloadResourceMapping)) *ClusterWorkloadResourceMappingDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -1404,8 +1444,8 @@ func (d *ServiceBindingDie) DieStamp(fn func(r *apisv1.ServiceBinding)) *Service
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *ServiceBindingDie) DieStampAt(jp string, fn interface{}) *ServiceBindingDie {
-	return d.DieStamp(func(r *apisv1.ServiceBinding) {
+func (d *ClusterWorkloadResourceMappingDie) DieStampAt(jp string, fn interface{}) *ClusterWorkloadResourceMappingDie {
+	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMapping) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -1440,8 +1480,8 @@ func (d *ServiceBindingDie) DieStampAt(jp string, fn interface{}) *ServiceBindin
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *ServiceBindingDie) DieWith(fns ...func(d *ServiceBindingDie)) *ServiceBindingDie {
-	nd := ServiceBindingBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *ClusterWorkloadResourceMappingDie) DieWith(fns ...func(d *ClusterWorkloadResourceMappingDie)) *ClusterWorkloadResourceMappingDie {
+	nd := ClusterWorkloadResourceMappingBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -1451,9 +1491,9 @@ func (d *ServiceBindingDie) DieWith(fns ...func(d *ServiceBindingDie)) *ServiceB
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ServiceBindingDie) DeepCopy() *ServiceBindingDie {
+func (d *ClusterWorkloadResourceMappingDie) DeepCopy() *ClusterWorkloadResourceMappingDie {
 	r := *d.r.DeepCopy()
-	return &ServiceBindingDie{
+	return &ClusterWorkloadResourceMappingDie{
 		FrozenObjectMeta: metav1.FreezeObjectMeta(r.ObjectMeta),
 		mutable:          d.mutable,
 		r:                r,
@@ -1462,12 +1502,12 @@ func (d *ServiceBindingDie) DeepCopy() *ServiceBindingDie {
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *ServiceBindingDie) DieSeal() *ServiceBindingDie {
+func (d *ClusterWorkloadResourceMappingDie) DieSeal() *ClusterWorkloadResourceMappingDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *ServiceBindingDie) DieSealFeed(r apisv1.ServiceBinding) *ServiceBindingDie {
+func (d *ClusterWorkloadResourceMappingDie) DieSealFeed(r apisv1.ClusterWorkloadResourceMapping) *ClusterWorkloadResourceMappingDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -1476,83 +1516,83 @@ func (d *ServiceBindingDie) DieSealFeed(r apisv1.ServiceBinding) *ServiceBinding
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *ServiceBindingDie) DieSealFeedPtr(r *apisv1.ServiceBinding) *ServiceBindingDie {
+func (d *ClusterWorkloadResourceMappingDie) DieSealFeedPtr(r *apisv1.ClusterWorkloadResourceMapping) *ClusterWorkloadResourceMappingDie {
 	if r == nil {
-		r = &apisv1.ServiceBinding{}
+		r = &apisv1.ClusterWorkloadResourceMapping{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *ServiceBindingDie) DieSealRelease() apisv1.ServiceBinding {
+func (d *ClusterWorkloadResourceMappingDie) DieSealRelease() apisv1.ClusterWorkloadResourceMapping {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *ServiceBindingDie) DieSealReleasePtr() *apisv1.ServiceBinding {
+func (d *ClusterWorkloadResourceMappingDie) DieSealReleasePtr() *apisv1.ClusterWorkloadResourceMapping {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *ServiceBindingDie) DieDiff(opts ...cmp.Option) string {
+func (d *ClusterWorkloadResourceMappingDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *ServiceBindingDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *ClusterWorkloadResourceMappingDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-var _ runtime.Object = (*ServiceBindingDie)(nil)
+var _ runtime.Object = (*ClusterWorkloadResourceMappingDie)(nil)
 
-func (d *ServiceBindingDie) DeepCopyObject() runtime.Object {
+func (d *ClusterWorkloadResourceMappingDie) DeepCopyObject() runtime.Object {
 	return d.r.DeepCopy()
 }
 
-func (d *ServiceBindingDie) GetObjectKind() schema.ObjectKind {
+func (d *ClusterWorkloadResourceMappingDie) GetObjectKind() schema.ObjectKind {
 	r := d.DieRelease()
 	return r.GetObjectKind()
 }
 
-func (d *ServiceBindingDie) MarshalJSON() ([]byte, error) {
+func (d *ClusterWorkloadResourceMappingDie) MarshalJSON() ([]byte, error) {
 	return json.Marshal(d.r)
 }
 
-func (d *ServiceBindingDie) UnmarshalJSON(b []byte) error {
+func (d *ClusterWorkloadResourceMappingDie) UnmarshalJSON(b []byte) error {
 	if !d.mutable {
 		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
 	}
-	resource := &apisv1.ServiceBinding{}
+	resource := &apisv1.ClusterWorkloadResourceMapping{}
 	err := json.Unmarshal(b, resource)
 	*d = *d.DieFeed(*resource)
 	return err
 }
 
 // APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
-func (d *ServiceBindingDie) APIVersion(v string) *ServiceBindingDie {
-	return d.DieStamp(func(r *apisv1.ServiceBinding) {
+func (d *ClusterWorkloadResourceMappingDie) APIVersion(v string) *ClusterWorkloadResourceMappingDie {
+	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMapping) {
 		r.APIVersion = v
 	})
 }
 
 // Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-func (d *ServiceBindingDie) Kind(v string) *ServiceBindingDie {
-	return d.DieStamp(func(r *apisv1.ServiceBinding) {
+func (d *ClusterWorkloadResourceMappingDie) Kind(v string) *ClusterWorkloadResourceMappingDie {
+	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMapping) {
 		r.Kind = v
 	})
 }
 
 // TypeMetadata standard object's type metadata.
-func (d *ServiceBindingDie) TypeMetadata(v apismetav1.TypeMeta) *ServiceBindingDie {
-	return d.DieStamp(func(r *apisv1.ServiceBinding) {
+func (d *ClusterWorkloadResourceMappingDie) TypeMetadata(v apismetav1.TypeMeta) *ClusterWorkloadResourceMappingDie {
+	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMapping) {
 		r.TypeMeta = v
 	})
 }
 
 // TypeMetadataDie stamps the resource's TypeMeta field with a mutable die.
-func (d *ServiceBindingDie) TypeMetadataDie(fn func(d *metav1.TypeMetaDie)) *ServiceBindingDie {
-	return d.DieStamp(func(r *apisv1.ServiceBinding) {
+func (d *ClusterWorkloadResourceMappingDie) TypeMetadataDie(fn func(d *metav1.TypeMetaDie)) *ClusterWorkloadResourceMappingDie {
+	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMapping) {
 		d := metav1.TypeMetaBlank.DieImmutable(false).DieFeed(r.TypeMeta)
 		fn(d)
 		r.TypeMeta = d.DieRelease()
@@ -1560,15 +1600,15 @@ func (d *ServiceBindingDie) TypeMetadataDie(fn func(d *metav1.TypeMetaDie)) *Ser
 }
 
 // Metadata standard object's metadata.
-func (d *ServiceBindingDie) Metadata(v apismetav1.ObjectMeta) *ServiceBindingDie {
-	return d.DieStamp(func(r *apisv1.ServiceBinding) {
+func (d *ClusterWorkloadResourceMappingDie) Metadata(v apismetav1.ObjectMeta) *ClusterWorkloadResourceMappingDie {
+	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMapping) {
 		r.ObjectMeta = v
 	})
 }
 
 // MetadataDie stamps the resource's ObjectMeta field with a mutable die.
-func (d *ServiceBindingDie) MetadataDie(fn func(d *metav1.ObjectMetaDie)) *ServiceBindingDie {
-	return d.DieStamp(func(r *apisv1.ServiceBinding) {
+func (d *ClusterWorkloadResourceMappingDie) MetadataDie(fn func(d *metav1.ObjectMetaDie)) *ClusterWorkloadResourceMappingDie {
+	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMapping) {
 		d := metav1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
 		fn(d)
 		r.ObjectMeta = d.DieRelease()
@@ -1576,45 +1616,30 @@ func (d *ServiceBindingDie) MetadataDie(fn func(d *metav1.ObjectMetaDie)) *Servi
 }
 
 // SpecDie stamps the resource's spec field with a mutable die.
-func (d *ServiceBindingDie) SpecDie(fn func(d *ServiceBindingSpecDie)) *ServiceBindingDie {
-	return d.DieStamp(func(r *apisv1.ServiceBinding) {
-		d := ServiceBindingSpecBlank.DieImmutable(false).DieFeed(r.Spec)
+func (d *ClusterWorkloadResourceMappingDie) SpecDie(fn func(d *ClusterWorkloadResourceMappingSpecDie)) *ClusterWorkloadResourceMappingDie {
+	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMapping) {
+		d := ClusterWorkloadResourceMappingSpecBlank.DieImmutable(false).DieFeed(r.Spec)
 		fn(d)
 		r.Spec = d.DieRelease()
 	})
 }
 
-// StatusDie stamps the resource's status field with a mutable die.
-func (d *ServiceBindingDie) StatusDie(fn func(d *ServiceBindingStatusDie)) *ServiceBindingDie {
-	return d.DieStamp(func(r *apisv1.ServiceBinding) {
-		d := ServiceBindingStatusBlank.DieImmutable(false).DieFeed(r.Status)
-		fn(d)
-		r.Status = d.DieRelease()
-	})
-}
-
-func (d *ServiceBindingDie) Spec(v apisv1.ServiceBindingSpec) *ServiceBindingDie {
-	return d.DieStamp(func(r *apisv1.ServiceBinding) {
+func (d *ClusterWorkloadResourceMappingDie) Spec(v apisv1.ClusterWorkloadResourceMappingSpec) *ClusterWorkloadResourceMappingDie {
+	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMapping) {
 		r.Spec = v
 	})
 }
 
-func (d *ServiceBindingDie) Status(v apisv1.ServiceBindingStatus) *ServiceBindingDie {
-	return d.DieStamp(func(r *apisv1.ServiceBinding) {
-		r.Status = v
-	})
-}
-
-var ServiceBindingSpecBlank = (&ServiceBindingSpecDie{}).DieFeed(apisv1.ServiceBindingSpec{})
+var ClusterWorkloadResourceMappingSpecBlank = (&ClusterWorkloadResourceMappingSpecDie{}).DieFeed(apisv1.ClusterWorkloadResourceMappingSpec{})
 
-type ServiceBindingSpecDie struct {
+type ClusterWorkloadResourceMappingSpecDie struct {
 	mutable bool
-	r       apisv1.ServiceBindingSpec
-	seal    apisv1.ServiceBindingSpec
+	r       apisv1.ClusterWorkloadResourceMappingSpec
+	seal    apisv1.ClusterWorkloadResourceMappingSpec
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ServiceBindingSpecDie) DieImmutable(immutable bool) *ServiceBindingSpecDie {
+func (d *ClusterWorkloadResourceMappingSpecDie) DieImmutable(immutable bool) *ClusterWorkloadResourceMappingSpecDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -1624,12 +1649,12 @@ func (d *ServiceBindingSpecDie) DieImmutable(immutable bool) *ServiceBindingSpec
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ServiceBindingSpecDie) DieFeed(r apisv1.ServiceBindingSpec) *ServiceBindingSpecDie {
+func (d *ClusterWorkloadResourceMappingSpecDie) DieFeed(r apisv1.ClusterWorkloadResourceMappingSpec) *ClusterWorkloadResourceMappingSpecDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &ServiceBindingSpecDie{
+	return &ClusterWorkloadResourceMappingSpecDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -1637,15 +1662,15 @@ func (d *ServiceBindingSpecDie) DieFeed(r apisv1.ServiceBindingSpec) *ServiceBin
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ServiceBindingSpecDie) DieFeedPtr(r *apisv1.ServiceBindingSpec) *ServiceBindingSpecDie {
+func (d *ClusterWorkloadResourceMappingSpecDie) DieFeedPtr(r *apisv1.ClusterWorkloadResourceMappingSpec) *ClusterWorkloadResourceMappingSpecDie {
 	if r == nil {
-		r = &apisv1.ServiceBindingSpec{}
+		r = &apisv1.ClusterWorkloadResourceMappingSpec{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *ServiceBindingSpecDie) DieFeedDuck(v any) *ServiceBindingSpecDie {
+func (d *ClusterWorkloadResourceMappingSpecDie) DieFeedDuck(v any) *ClusterWorkloadResourceMappingSpecDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -1654,8 +1679,8 @@ func (d *ServiceBindingSpecDie) DieFeedDuck(v any) *ServiceBindingSpecDie {
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *ServiceBindingSpecDie) DieFeedJSON(j []byte) *ServiceBindingSpecDie {
-	r := apisv1.ServiceBindingSpec{}
+func (d *ClusterWorkloadResourceMappingSpecDie) DieFeedJSON(j []byte) *ClusterWorkloadResourceMappingSpecDie {
+	r := apisv1.ClusterWorkloadResourceMappingSpec{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -1663,8 +1688,8 @@ func (d *ServiceBindingSpecDie) DieFeedJSON(j []byte) *ServiceBindingSpecDie {
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *ServiceBindingSpecDie) DieFeedYAML(y []byte) *ServiceBindingSpecDie {
-	r := apisv1.ServiceBindingSpec{}
+func (d *ClusterWorkloadResourceMappingSpecDie) DieFeedYAML(y []byte) *ClusterWorkloadResourceMappingSpecDie {
+	r := apisv1.ClusterWorkloadResourceMappingSpec{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -1672,7 +1697,7 @@ func (d *ServiceBindingSpecDie) DieFeedYAML(y []byte) *ServiceBindingSpecDie {
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *ServiceBindingSpecDie) DieFeedYAMLFile(name string) *ServiceBindingSpecDie {
+func (d *ClusterWorkloadResourceMappingSpecDie) DieFeedYAMLFile(name string) *ClusterWorkloadResourceMappingSpecDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -1681,7 +1706,7 @@ func (d *ServiceBindingSpecDie) DieFeedYAMLFile(name string) *ServiceBindingSpec
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ServiceBindingSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingSpecDie {
+func (d *ClusterWorkloadResourceMappingSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterWorkloadResourceMappingSpecDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -1690,7 +1715,7 @@ func (d *ServiceBindingSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *S
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ServiceBindingSpecDie) DieRelease() apisv1.ServiceBindingSpec {
+func (d *ClusterWorkloadResourceMappingSpecDie) DieRelease() apisv1.ClusterWorkloadResourceMappingSpec {
 	if d.mutable {
 		return d.r
 	}
@@ -1698,13 +1723,13 @@ func (d *ServiceBindingSpecDie) DieRelease() apisv1.ServiceBindingSpec {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ServiceBindingSpecDie) DieReleasePtr() *apisv1.ServiceBindingSpec {
+func (d *ClusterWorkloadResourceMappingSpecDie) DieReleasePtr() *apisv1.ClusterWorkloadResourceMappingSpec {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *ServiceBindingSpecDie) DieReleaseDuck(v any) any {
+func (d *ClusterWorkloadResourceMappingSpecDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -1713,7 +1738,7 @@ func (d *ServiceBindingSpecDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *ServiceBindingSpecDie) DieReleaseJSON() []byte {
+func (d *ClusterWorkloadResourceMappingSpecDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -1723,7 +1748,7 @@ func (d *ServiceBindingSpecDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *ServiceBindingSpecDie) DieReleaseYAML() []byte {
+func (d *ClusterWorkloadResourceMappingSpecDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -1733,7 +1758,7 @@ func (d *ServiceBindingSpecDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ServiceBindingSpecDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ClusterWorkloadResourceMappingSpecDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -1743,7 +1768,7 @@ func (d *ServiceBindingSpecDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ServiceBindingSpecDie) DieStamp(fn func(r *apisv1.ServiceBindingSpec)) *ServiceBindingSpecDie {
+func (d *ClusterWorkloadResourceMappingSpecDie) DieStamp(fn func(r *apisv1.ClusterWorkloadResourceMappingSpec)) *ClusterWorkloadResourceMappingSpecDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -1752,8 +1777,8 @@ func (d *ServiceBindingSpecDie) DieStamp(fn func(r *apisv1.ServiceBindingSpec))
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *ServiceBindingSpecDie) DieStampAt(jp string, fn interface{}) *ServiceBindingSpecDie {
-	return d.DieStamp(func(r *apisv1.ServiceBindingSpec) {
+func (d *ClusterWorkloadResourceMappingSpecDie) DieStampAt(jp string, fn interface{}) *ClusterWorkloadResourceMappingSpecDie {
+	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMappingSpec) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -1788,8 +1813,8 @@ func (d *ServiceBindingSpecDie) DieStampAt(jp string, fn interface{}) *ServiceBi
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *ServiceBindingSpecDie) DieWith(fns ...func(d *ServiceBindingSpecDie)) *ServiceBindingSpecDie {
-	nd := ServiceBindingSpecBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *ClusterWorkloadResourceMappingSpecDie) DieWith(fns ...func(d *ClusterWorkloadResourceMappingSpecDie)) *ClusterWorkloadResourceMappingSpecDie {
+	nd := ClusterWorkloadResourceMappingSpecBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -1799,9 +1824,9 @@ func (d *ServiceBindingSpecDie) DieWith(fns ...func(d *ServiceBindingSpecDie)) *
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ServiceBindingSpecDie) DeepCopy() *ServiceBindingSpecDie {
+func (d *ClusterWorkloadResourceMappingSpecDie) DeepCopy() *ClusterWorkloadResourceMappingSpecDie {
 	r := *d.r.DeepCopy()
-	return &ServiceBindingSpecDie{
+	return &ClusterWorkloadResourceMappingSpecDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -1809,12 +1834,12 @@ func (d *ServiceBindingSpecDie) DeepCopy() *ServiceBindingSpecDie {
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *ServiceBindingSpecDie) DieSeal() *ServiceBindingSpecDie {
+func (d *ClusterWorkloadResourceMappingSpecDie) DieSeal() *ClusterWorkloadResourceMappingSpecDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *ServiceBindingSpecDie) DieSealFeed(r apisv1.ServiceBindingSpec) *ServiceBindingSpecDie {
+func (d *ClusterWorkloadResourceMappingSpecDie) DieSealFeed(r apisv1.ClusterWorkloadResourceMappingSpec) *ClusterWorkloadResourceMappingSpecDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -1823,128 +1848,2526 @@ func (d *ServiceBindingSpecDie) DieSealFeed(r apisv1.ServiceBindingSpec) *Servic
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *ServiceBindingSpecDie) DieSealFeedPtr(r *apisv1.ServiceBindingSpec) *ServiceBindingSpecDie {
+func (d *ClusterWorkloadResourceMappingSpecDie) DieSealFeedPtr(r *apisv1.ClusterWorkloadResourceMappingSpec) *ClusterWorkloadResourceMappingSpecDie {
 	if r == nil {
-		r = &apisv1.ServiceBindingSpec{}
+		r = &apisv1.ClusterWorkloadResourceMappingSpec{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *ServiceBindingSpecDie) DieSealRelease() apisv1.ServiceBindingSpec {
+func (d *ClusterWorkloadResourceMappingSpecDie) DieSealRelease() apisv1.ClusterWorkloadResourceMappingSpec {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *ServiceBindingSpecDie) DieSealReleasePtr() *apisv1.ServiceBindingSpec {
+func (d *ClusterWorkloadResourceMappingSpecDie) DieSealReleasePtr() *apisv1.ClusterWorkloadResourceMappingSpec {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *ServiceBindingSpecDie) DieDiff(opts ...cmp.Option) string {
+func (d *ClusterWorkloadResourceMappingSpecDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *ServiceBindingSpecDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *ClusterWorkloadResourceMappingSpecDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-// WorkloadDie mutates Workload as a die.
+// VersionDie mutates a single item in Versions matched by the nested field Version, appending a new item if no match is found.
 //
-// Workload is a reference to an object
-func (d *ServiceBindingSpecDie) WorkloadDie(fn func(d *ServiceBindingWorkloadReferenceDie)) *ServiceBindingSpecDie {
-	return d.DieStamp(func(r *apisv1.ServiceBindingSpec) {
-		d := ServiceBindingWorkloadReferenceBlank.DieImmutable(false).DieFeed(r.Workload)
-		fn(d)
-		r.Workload = d.DieRelease()
+// Versions is the collection of versions for a given resource, with mappings.
+func (d *ClusterWorkloadResourceMappingSpecDie) VersionDie(v string, fn func(d *ClusterWorkloadResourceMappingTemplateDie)) *ClusterWorkloadResourceMappingSpecDie {
+	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMappingSpec) {
+		for i := range r.Versions {
+			if v == r.Versions[i].Version {
+				d := ClusterWorkloadResourceMappingTemplateBlank.DieImmutable(false).DieFeed(r.Versions[i])
+				fn(d)
+				r.Versions[i] = d.DieRelease()
+				return
+			}
+		}
+
+		d := ClusterWorkloadResourceMappingTemplateBlank.DieImmutable(false).DieFeed(apisv1.ClusterWorkloadResourceMappingTemplate{Version: v})
+		fn(d)
+		r.Versions = append(r.Versions, d.DieRelease())
+	})
+}
+
+// Versions is the collection of versions for a given resource, with mappings.
+func (d *ClusterWorkloadResourceMappingSpecDie) Versions(v ...apisv1.ClusterWorkloadResourceMappingTemplate) *ClusterWorkloadResourceMappingSpecDie {
+	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMappingSpec) {
+		r.Versions = v
+	})
+}
+
+var ClusterWorkloadResourceMappingTemplateBlank = (&ClusterWorkloadResourceMappingTemplateDie{}).DieFeed(apisv1.ClusterWorkloadResourceMappingTemplate{})
+
+type ClusterWorkloadResourceMappingTemplateDie struct {
+	mutable bool
+	r       apisv1.ClusterWorkloadResourceMappingTemplate
+	seal    apisv1.ClusterWorkloadResourceMappingTemplate
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieImmutable(immutable bool) *ClusterWorkloadResourceMappingTemplateDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeed(r apisv1.ClusterWorkloadResourceMappingTemplate) *ClusterWorkloadResourceMappingTemplateDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ClusterWorkloadResourceMappingTemplateDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeedPtr(r *apisv1.ClusterWorkloadResourceMappingTemplate) *ClusterWorkloadResourceMappingTemplateDie {
+	if r == nil {
+		r = &apisv1.ClusterWorkloadResourceMappingTemplate{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeedDuck(v any) *ClusterWorkloadResourceMappingTemplateDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeedJSON(j []byte) *ClusterWorkloadResourceMappingTemplateDie {
+	r := apisv1.ClusterWorkloadResourceMappingTemplate{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeedYAML(y []byte) *ClusterWorkloadResourceMappingTemplateDie {
+	r := apisv1.ClusterWorkloadResourceMappingTemplate{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeedYAMLFile(name string) *ClusterWorkloadResourceMappingTemplateDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterWorkloadResourceMappingTemplateDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieRelease() apisv1.ClusterWorkloadResourceMappingTemplate {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieReleasePtr() *apisv1.ClusterWorkloadResourceMappingTemplate {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieStamp(fn func(r *apisv1.ClusterWorkloadResourceMappingTemplate)) *ClusterWorkloadResourceMappingTemplateDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieStampAt(jp string, fn interface{}) *ClusterWorkloadResourceMappingTemplateDie {
+	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMappingTemplate) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieWith(fns ...func(d *ClusterWorkloadResourceMappingTemplateDie)) *ClusterWorkloadResourceMappingTemplateDie {
+	nd := ClusterWorkloadResourceMappingTemplateBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DeepCopy() *ClusterWorkloadResourceMappingTemplateDie {
+	r := *d.r.DeepCopy()
+	return &ClusterWorkloadResourceMappingTemplateDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieSeal() *ClusterWorkloadResourceMappingTemplateDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieSealFeed(r apisv1.ClusterWorkloadResourceMappingTemplate) *ClusterWorkloadResourceMappingTemplateDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieSealFeedPtr(r *apisv1.ClusterWorkloadResourceMappingTemplate) *ClusterWorkloadResourceMappingTemplateDie {
+	if r == nil {
+		r = &apisv1.ClusterWorkloadResourceMappingTemplate{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieSealRelease() apisv1.ClusterWorkloadResourceMappingTemplate {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieSealReleasePtr() *apisv1.ClusterWorkloadResourceMappingTemplate {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// ContainersDie replaces Containers by collecting the released value from each die passed.
+//
+// Containers is the collection of mappings to container-like fragments of the workload resource. Defaults to
+//
+// mappings appropriate for a PodSpecable resource.
+func (d *ClusterWorkloadResourceMappingTemplateDie) ContainersDie(v ...*ClusterWorkloadResourceMappingContainerDie) *ClusterWorkloadResourceMappingTemplateDie {
+	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMappingTemplate) {
+		r.Containers = make([]apisv1.ClusterWorkloadResourceMappingContainer, len(v))
+		for i := range v {
+			r.Containers[i] = v[i].DieRelease()
+		}
+	})
+}
+
+// Version is the version of the workload resource that this mapping is for.
+func (d *ClusterWorkloadResourceMappingTemplateDie) Version(v string) *ClusterWorkloadResourceMappingTemplateDie {
+	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMappingTemplate) {
+		r.Version = v
+	})
+}
+
+// Annotations is a Restricted JSONPath that references the annotations map within the workload resource. These
+//
+// annotations must end up in the resulting Pod, and are generally not the workload resource's annotations.
+//
+// Defaults to `.spec.template.metadata.annotations`.
+func (d *ClusterWorkloadResourceMappingTemplateDie) Annotations(v string) *ClusterWorkloadResourceMappingTemplateDie {
+	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMappingTemplate) {
+		r.Annotations = v
+	})
+}
+
+// Containers is the collection of mappings to container-like fragments of the workload resource. Defaults to
+//
+// mappings appropriate for a PodSpecable resource.
+func (d *ClusterWorkloadResourceMappingTemplateDie) Containers(v ...apisv1.ClusterWorkloadResourceMappingContainer) *ClusterWorkloadResourceMappingTemplateDie {
+	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMappingTemplate) {
+		r.Containers = v
+	})
+}
+
+// Volumes is a Restricted JSONPath that references the slice of volumes within the workload resource. Defaults to
+//
+// `.spec.template.spec.volumes`.
+func (d *ClusterWorkloadResourceMappingTemplateDie) Volumes(v string) *ClusterWorkloadResourceMappingTemplateDie {
+	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMappingTemplate) {
+		r.Volumes = v
+	})
+}
+
+var ClusterWorkloadResourceMappingContainerBlank = (&ClusterWorkloadResourceMappingContainerDie{}).DieFeed(apisv1.ClusterWorkloadResourceMappingContainer{})
+
+type ClusterWorkloadResourceMappingContainerDie struct {
+	mutable bool
+	r       apisv1.ClusterWorkloadResourceMappingContainer
+	seal    apisv1.ClusterWorkloadResourceMappingContainer
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterWorkloadResourceMappingContainerDie) DieImmutable(immutable bool) *ClusterWorkloadResourceMappingContainerDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieFeed(r apisv1.ClusterWorkloadResourceMappingContainer) *ClusterWorkloadResourceMappingContainerDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ClusterWorkloadResourceMappingContainerDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieFeedPtr(r *apisv1.ClusterWorkloadResourceMappingContainer) *ClusterWorkloadResourceMappingContainerDie {
+	if r == nil {
+		r = &apisv1.ClusterWorkloadResourceMappingContainer{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieFeedDuck(v any) *ClusterWorkloadResourceMappingContainerDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieFeedJSON(j []byte) *ClusterWorkloadResourceMappingContainerDie {
+	r := apisv1.ClusterWorkloadResourceMappingContainer{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieFeedYAML(y []byte) *ClusterWorkloadResourceMappingContainerDie {
+	r := apisv1.ClusterWorkloadResourceMappingContainer{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieFeedYAMLFile(name string) *ClusterWorkloadResourceMappingContainerDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterWorkloadResourceMappingContainerDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieRelease() apisv1.ClusterWorkloadResourceMappingContainer {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieReleasePtr() *apisv1.ClusterWorkloadResourceMappingContainer {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieStamp(fn func(r *apisv1.ClusterWorkloadResourceMappingContainer)) *ClusterWorkloadResourceMappingContainerDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieStampAt(jp string, fn interface{}) *ClusterWorkloadResourceMappingContainerDie {
+	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMappingContainer) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieWith(fns ...func(d *ClusterWorkloadResourceMappingContainerDie)) *ClusterWorkloadResourceMappingContainerDie {
+	nd := ClusterWorkloadResourceMappingContainerBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterWorkloadResourceMappingContainerDie) DeepCopy() *ClusterWorkloadResourceMappingContainerDie {
+	r := *d.r.DeepCopy()
+	return &ClusterWorkloadResourceMappingContainerDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieSeal() *ClusterWorkloadResourceMappingContainerDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieSealFeed(r apisv1.ClusterWorkloadResourceMappingContainer) *ClusterWorkloadResourceMappingContainerDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieSealFeedPtr(r *apisv1.ClusterWorkloadResourceMappingContainer) *ClusterWorkloadResourceMappingContainerDie {
+	if r == nil {
+		r = &apisv1.ClusterWorkloadResourceMappingContainer{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieSealRelease() apisv1.ClusterWorkloadResourceMappingContainer {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieSealReleasePtr() *apisv1.ClusterWorkloadResourceMappingContainer {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ClusterWorkloadResourceMappingContainerDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// Path is the JSONPath within the workload resource that matches an existing fragment that is container-like.
+func (d *ClusterWorkloadResourceMappingContainerDie) Path(v string) *ClusterWorkloadResourceMappingContainerDie {
+	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMappingContainer) {
+		r.Path = v
+	})
+}
+
+// Name is a Restricted JSONPath that references the name of the container with the container-like workload resource
+//
+// fragment. If not defined, container name filtering is ignored.
+func (d *ClusterWorkloadResourceMappingContainerDie) Name(v string) *ClusterWorkloadResourceMappingContainerDie {
+	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMappingContainer) {
+		r.Name = v
+	})
+}
+
+// Env is a Restricted JSONPath that references the slice of environment variables for the container with the
+//
+// container-like workload resource fragment. The referenced location is created if it does not exist. Defaults
+//
+// to `.envs`.
+func (d *ClusterWorkloadResourceMappingContainerDie) Env(v string) *ClusterWorkloadResourceMappingContainerDie {
+	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMappingContainer) {
+		r.Env = v
+	})
+}
+
+// VolumeMounts is a Restricted JSONPath that references the slice of volume mounts for the container with the
+//
+// container-like workload resource fragment. The referenced location is created if it does not exist. Defaults
+//
+// to `.volumeMounts`.
+func (d *ClusterWorkloadResourceMappingContainerDie) VolumeMounts(v string) *ClusterWorkloadResourceMappingContainerDie {
+	return d.DieStamp(func(r *apisv1.ClusterWorkloadResourceMappingContainer) {
+		r.VolumeMounts = v
+	})
+}
+
+var ServiceBindingBlank = (&ServiceBindingDie{}).DieFeed(apisv1.ServiceBinding{})
+
+type ServiceBindingDie struct {
+	metav1.FrozenObjectMeta
+	mutable bool
+	r       apisv1.ServiceBinding
+	seal    apisv1.ServiceBinding
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ServiceBindingDie) DieImmutable(immutable bool) *ServiceBindingDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ServiceBindingDie) DieFeed(r apisv1.ServiceBinding) *ServiceBindingDie {
+	if d.mutable {
+		d.FrozenObjectMeta = metav1.FreezeObjectMeta(r.ObjectMeta)
+		d.r = r
+		return d
+	}
+	return &ServiceBindingDie{
+		FrozenObjectMeta: metav1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+		seal:             d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ServiceBindingDie) DieFeedPtr(r *apisv1.ServiceBinding) *ServiceBindingDie {
+	if r == nil {
+		r = &apisv1.ServiceBinding{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ServiceBindingDie) DieFeedDuck(v any) *ServiceBindingDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ServiceBindingDie) DieFeedJSON(j []byte) *ServiceBindingDie {
+	r := apisv1.ServiceBinding{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ServiceBindingDie) DieFeedYAML(y []byte) *ServiceBindingDie {
+	r := apisv1.ServiceBinding{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ServiceBindingDie) DieFeedYAMLFile(name string) *ServiceBindingDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ServiceBindingDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ServiceBindingDie) DieRelease() apisv1.ServiceBinding {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ServiceBindingDie) DieReleasePtr() *apisv1.ServiceBinding {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseUnstructured returns the resource managed by the die as an unstructured object. Panics on error.
+func (d *ServiceBindingDie) DieReleaseUnstructured() *unstructured.Unstructured {
+	r := d.DieReleasePtr()
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	if err != nil {
+		panic(err)
+	}
+	return &unstructured.Unstructured{
+		Object: u,
+	}
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ServiceBindingDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ServiceBindingDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ServiceBindingDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ServiceBindingDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ServiceBindingDie) DieStamp(fn func(r *apisv1.ServiceBinding)) *ServiceBindingDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ServiceBindingDie) DieStampAt(jp string, fn interface{}) *ServiceBindingDie {
+	return d.DieStamp(func(r *apisv1.ServiceBinding) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ServiceBindingDie) DieWith(fns ...func(d *ServiceBindingDie)) *ServiceBindingDie {
+	nd := ServiceBindingBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ServiceBindingDie) DeepCopy() *ServiceBindingDie {
+	r := *d.r.DeepCopy()
+	return &ServiceBindingDie{
+		FrozenObjectMeta: metav1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+		seal:             d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ServiceBindingDie) DieSeal() *ServiceBindingDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ServiceBindingDie) DieSealFeed(r apisv1.ServiceBinding) *ServiceBindingDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ServiceBindingDie) DieSealFeedPtr(r *apisv1.ServiceBinding) *ServiceBindingDie {
+	if r == nil {
+		r = &apisv1.ServiceBinding{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ServiceBindingDie) DieSealRelease() apisv1.ServiceBinding {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ServiceBindingDie) DieSealReleasePtr() *apisv1.ServiceBinding {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ServiceBindingDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ServiceBindingDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+var _ runtime.Object = (*ServiceBindingDie)(nil)
+
+func (d *ServiceBindingDie) DeepCopyObject() runtime.Object {
+	return d.r.DeepCopy()
+}
+
+func (d *ServiceBindingDie) GetObjectKind() schema.ObjectKind {
+	r := d.DieRelease()
+	return r.GetObjectKind()
+}
+
+func (d *ServiceBindingDie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.r)
+}
+
+func (d *ServiceBindingDie) UnmarshalJSON(b []byte) error {
+	if !d.mutable {
+		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	}
+	resource := &apisv1.ServiceBinding{}
+	err := json.Unmarshal(b, resource)
+	*d = *d.DieFeed(*resource)
+	return err
+}
+
+// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+func (d *ServiceBindingDie) APIVersion(v string) *ServiceBindingDie {
+	return d.DieStamp(func(r *apisv1.ServiceBinding) {
+		r.APIVersion = v
+	})
+}
+
+// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ServiceBindingDie) Kind(v string) *ServiceBindingDie {
+	return d.DieStamp(func(r *apisv1.ServiceBinding) {
+		r.Kind = v
+	})
+}
+
+// TypeMetadata standard object's type metadata.
+func (d *ServiceBindingDie) TypeMetadata(v apismetav1.TypeMeta) *ServiceBindingDie {
+	return d.DieStamp(func(r *apisv1.ServiceBinding) {
+		r.TypeMeta = v
+	})
+}
+
+// TypeMetadataDie stamps the resource's TypeMeta field with a mutable die.
+func (d *ServiceBindingDie) TypeMetadataDie(fn func(d *metav1.TypeMetaDie)) *ServiceBindingDie {
+	return d.DieStamp(func(r *apisv1.ServiceBinding) {
+		d := metav1.TypeMetaBlank.DieImmutable(false).DieFeed(r.TypeMeta)
+		fn(d)
+		r.TypeMeta = d.DieRelease()
+	})
+}
+
+// Metadata standard object's metadata.
+func (d *ServiceBindingDie) Metadata(v apismetav1.ObjectMeta) *ServiceBindingDie {
+	return d.DieStamp(func(r *apisv1.ServiceBinding) {
+		r.ObjectMeta = v
+	})
+}
+
+// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
+func (d *ServiceBindingDie) MetadataDie(fn func(d *metav1.ObjectMetaDie)) *ServiceBindingDie {
+	return d.DieStamp(func(r *apisv1.ServiceBinding) {
+		d := metav1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
+		fn(d)
+		r.ObjectMeta = d.DieRelease()
+	})
+}
+
+// SpecDie stamps the resource's spec field with a mutable die.
+func (d *ServiceBindingDie) SpecDie(fn func(d *ServiceBindingSpecDie)) *ServiceBindingDie {
+	return d.DieStamp(func(r *apisv1.ServiceBinding) {
+		d := ServiceBindingSpecBlank.DieImmutable(false).DieFeed(r.Spec)
+		fn(d)
+		r.Spec = d.DieRelease()
+	})
+}
+
+// StatusDie stamps the resource's status field with a mutable die.
+func (d *ServiceBindingDie) StatusDie(fn func(d *ServiceBindingStatusDie)) *ServiceBindingDie {
+	return d.DieStamp(func(r *apisv1.ServiceBinding) {
+		d := ServiceBindingStatusBlank.DieImmutable(false).DieFeed(r.Status)
+		fn(d)
+		r.Status = d.DieRelease()
+	})
+}
+
+func (d *ServiceBindingDie) Spec(v apisv1.ServiceBindingSpec) *ServiceBindingDie {
+	return d.DieStamp(func(r *apisv1.ServiceBinding) {
+		r.Spec = v
+	})
+}
+
+func (d *ServiceBindingDie) Status(v apisv1.ServiceBindingStatus) *ServiceBindingDie {
+	return d.DieStamp(func(r *apisv1.ServiceBinding) {
+		r.Status = v
+	})
+}
+
+var ServiceBindingSpecBlank = (&ServiceBindingSpecDie{}).DieFeed(apisv1.ServiceBindingSpec{})
+
+type ServiceBindingSpecDie struct {
+	mutable bool
+	r       apisv1.ServiceBindingSpec
+	seal    apisv1.ServiceBindingSpec
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ServiceBindingSpecDie) DieImmutable(immutable bool) *ServiceBindingSpecDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ServiceBindingSpecDie) DieFeed(r apisv1.ServiceBindingSpec) *ServiceBindingSpecDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ServiceBindingSpecDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ServiceBindingSpecDie) DieFeedPtr(r *apisv1.ServiceBindingSpec) *ServiceBindingSpecDie {
+	if r == nil {
+		r = &apisv1.ServiceBindingSpec{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ServiceBindingSpecDie) DieFeedDuck(v any) *ServiceBindingSpecDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ServiceBindingSpecDie) DieFeedJSON(j []byte) *ServiceBindingSpecDie {
+	r := apisv1.ServiceBindingSpec{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ServiceBindingSpecDie) DieFeedYAML(y []byte) *ServiceBindingSpecDie {
+	r := apisv1.ServiceBindingSpec{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ServiceBindingSpecDie) DieFeedYAMLFile(name string) *ServiceBindingSpecDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ServiceBindingSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingSpecDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ServiceBindingSpecDie) DieRelease() apisv1.ServiceBindingSpec {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ServiceBindingSpecDie) DieReleasePtr() *apisv1.ServiceBindingSpec {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ServiceBindingSpecDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ServiceBindingSpecDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ServiceBindingSpecDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ServiceBindingSpecDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ServiceBindingSpecDie) DieStamp(fn func(r *apisv1.ServiceBindingSpec)) *ServiceBindingSpecDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ServiceBindingSpecDie) DieStampAt(jp string, fn interface{}) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingSpec) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ServiceBindingSpecDie) DieWith(fns ...func(d *ServiceBindingSpecDie)) *ServiceBindingSpecDie {
+	nd := ServiceBindingSpecBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ServiceBindingSpecDie) DeepCopy() *ServiceBindingSpecDie {
+	r := *d.r.DeepCopy()
+	return &ServiceBindingSpecDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ServiceBindingSpecDie) DieSeal() *ServiceBindingSpecDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ServiceBindingSpecDie) DieSealFeed(r apisv1.ServiceBindingSpec) *ServiceBindingSpecDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ServiceBindingSpecDie) DieSealFeedPtr(r *apisv1.ServiceBindingSpec) *ServiceBindingSpecDie {
+	if r == nil {
+		r = &apisv1.ServiceBindingSpec{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ServiceBindingSpecDie) DieSealRelease() apisv1.ServiceBindingSpec {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ServiceBindingSpecDie) DieSealReleasePtr() *apisv1.ServiceBindingSpec {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ServiceBindingSpecDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ServiceBindingSpecDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// WorkloadDie mutates Workload as a die.
+//
+// Workload is a reference to an object
+func (d *ServiceBindingSpecDie) WorkloadDie(fn func(d *ServiceBindingWorkloadReferenceDie)) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingSpec) {
+		d := ServiceBindingWorkloadReferenceBlank.DieImmutable(false).DieFeed(r.Workload)
+		fn(d)
+		r.Workload = d.DieRelease()
+	})
+}
+
+// ServiceDie mutates Service as a die.
+//
+// Service is a reference to an object that fulfills the ProvisionedService duck type
+func (d *ServiceBindingSpecDie) ServiceDie(fn func(d *ServiceBindingServiceReferenceDie)) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingSpec) {
+		d := ServiceBindingServiceReferenceBlank.DieImmutable(false).DieFeed(r.Service)
+		fn(d)
+		r.Service = d.DieRelease()
+	})
+}
+
+// EnvDie mutates a single item in Env matched by the nested field Name, appending a new item if no match is found.
+//
+// Env is the collection of mappings from Secret entries to environment variables
+func (d *ServiceBindingSpecDie) EnvDie(v string, fn func(d *EnvMappingDie)) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingSpec) {
+		for i := range r.Env {
+			if v == r.Env[i].Name {
+				d := EnvMappingBlank.DieImmutable(false).DieFeed(r.Env[i])
+				fn(d)
+				r.Env[i] = d.DieRelease()
+				return
+			}
+		}
+
+		d := EnvMappingBlank.DieImmutable(false).DieFeed(apisv1.EnvMapping{Name: v})
+		fn(d)
+		r.Env = append(r.Env, d.DieRelease())
+	})
+}
+
+// RolloutDie mutates Rollout as a die.
+//
+// Rollout controls how a change to the binding is rolled out across the workloads. By default every workload is
+//
+// updated at once.
+func (d *ServiceBindingSpecDie) RolloutDie(fn func(d *ServiceBindingRolloutDie)) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingSpec) {
+		d := ServiceBindingRolloutBlank.DieImmutable(false).DieFeedPtr(r.Rollout)
+		fn(d)
+		r.Rollout = d.DieReleasePtr()
+	})
+}
+
+// Name is the name of the service as projected into the workload container.  Defaults to .metadata.name.
+func (d *ServiceBindingSpecDie) Name(v string) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingSpec) {
+		r.Name = v
+	})
+}
+
+// Type is the type of the service as projected into the workload container
+func (d *ServiceBindingSpecDie) Type(v string) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingSpec) {
+		r.Type = v
+	})
+}
+
+// Provider is the provider of the service as projected into the workload container
+func (d *ServiceBindingSpecDie) Provider(v string) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingSpec) {
+		r.Provider = v
+	})
+}
+
+// Workload is a reference to an object
+func (d *ServiceBindingSpecDie) Workload(v apisv1.ServiceBindingWorkloadReference) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingSpec) {
+		r.Workload = v
+	})
+}
+
+// Service is a reference to an object that fulfills the ProvisionedService duck type
+func (d *ServiceBindingSpecDie) Service(v apisv1.ServiceBindingServiceReference) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingSpec) {
+		r.Service = v
+	})
+}
+
+// Env is the collection of mappings from Secret entries to environment variables
+func (d *ServiceBindingSpecDie) Env(v ...apisv1.EnvMapping) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingSpec) {
+		r.Env = v
+	})
+}
+
+// Rollout controls how a change to the binding is rolled out across the workloads. By default every workload is
+//
+// updated at once.
+func (d *ServiceBindingSpecDie) Rollout(v *apisv1.ServiceBindingRollout) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingSpec) {
+		r.Rollout = v
+	})
+}
+
+// WaitForWorkloads reports the rollout of the bound workloads with the WorkloadReady condition and includes it in
+//
+// the Ready condition, the binding is only Ready once every bound workload is running with the binding.
+func (d *ServiceBindingSpecDie) WaitForWorkloads(v bool) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingSpec) {
+		r.WaitForWorkloads = v
+	})
+}
+
+// Suspend stops the binding from changing its workloads while the status continues to be reported. Deleting a
+//
+// suspended binding still removes it from the workloads.
+func (d *ServiceBindingSpecDie) Suspend(v bool) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingSpec) {
+		r.Suspend = v
+	})
+}
+
+// DeletionPolicy controls what happens to the workloads when the binding is deleted, one of Unproject or Orphan.
+//
+// Unproject, the default, removes the binding from the workloads. Orphan leaves the projection in place for a
+//
+// future binding of the same Secret to adopt.
+func (d *ServiceBindingSpecDie) DeletionPolicy(v apisv1.ServiceBindingDeletionPolicy) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingSpec) {
+		r.DeletionPolicy = v
+	})
+}
+
+// AdoptManualProjections converts volumes and environment variables of the workloads that reference the binding
+//
+// Secret directly into the projected binding, rather than projecting the binding alongside them. Adopted volumes
+//
+// are replaced by the projected volume, adopted environment variables are projected with the binding from then on.
+func (d *ServiceBindingSpecDie) AdoptManualProjections(v bool) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingSpec) {
+		r.AdoptManualProjections = v
+	})
+}
+
+var ServiceBindingWorkloadReferenceBlank = (&ServiceBindingWorkloadReferenceDie{}).DieFeed(apisv1.ServiceBindingWorkloadReference{})
+
+type ServiceBindingWorkloadReferenceDie struct {
+	mutable bool
+	r       apisv1.ServiceBindingWorkloadReference
+	seal    apisv1.ServiceBindingWorkloadReference
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ServiceBindingWorkloadReferenceDie) DieImmutable(immutable bool) *ServiceBindingWorkloadReferenceDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ServiceBindingWorkloadReferenceDie) DieFeed(r apisv1.ServiceBindingWorkloadReference) *ServiceBindingWorkloadReferenceDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ServiceBindingWorkloadReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ServiceBindingWorkloadReferenceDie) DieFeedPtr(r *apisv1.ServiceBindingWorkloadReference) *ServiceBindingWorkloadReferenceDie {
+	if r == nil {
+		r = &apisv1.ServiceBindingWorkloadReference{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ServiceBindingWorkloadReferenceDie) DieFeedDuck(v any) *ServiceBindingWorkloadReferenceDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ServiceBindingWorkloadReferenceDie) DieFeedJSON(j []byte) *ServiceBindingWorkloadReferenceDie {
+	r := apisv1.ServiceBindingWorkloadReference{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ServiceBindingWorkloadReferenceDie) DieFeedYAML(y []byte) *ServiceBindingWorkloadReferenceDie {
+	r := apisv1.ServiceBindingWorkloadReference{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ServiceBindingWorkloadReferenceDie) DieFeedYAMLFile(name string) *ServiceBindingWorkloadReferenceDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ServiceBindingWorkloadReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingWorkloadReferenceDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ServiceBindingWorkloadReferenceDie) DieRelease() apisv1.ServiceBindingWorkloadReference {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ServiceBindingWorkloadReferenceDie) DieReleasePtr() *apisv1.ServiceBindingWorkloadReference {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ServiceBindingWorkloadReferenceDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ServiceBindingWorkloadReferenceDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ServiceBindingWorkloadReferenceDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ServiceBindingWorkloadReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ServiceBindingWorkloadReferenceDie) DieStamp(fn func(r *apisv1.ServiceBindingWorkloadReference)) *ServiceBindingWorkloadReferenceDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ServiceBindingWorkloadReferenceDie) DieStampAt(jp string, fn interface{}) *ServiceBindingWorkloadReferenceDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingWorkloadReference) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ServiceBindingWorkloadReferenceDie) DieWith(fns ...func(d *ServiceBindingWorkloadReferenceDie)) *ServiceBindingWorkloadReferenceDie {
+	nd := ServiceBindingWorkloadReferenceBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ServiceBindingWorkloadReferenceDie) DeepCopy() *ServiceBindingWorkloadReferenceDie {
+	r := *d.r.DeepCopy()
+	return &ServiceBindingWorkloadReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ServiceBindingWorkloadReferenceDie) DieSeal() *ServiceBindingWorkloadReferenceDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ServiceBindingWorkloadReferenceDie) DieSealFeed(r apisv1.ServiceBindingWorkloadReference) *ServiceBindingWorkloadReferenceDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ServiceBindingWorkloadReferenceDie) DieSealFeedPtr(r *apisv1.ServiceBindingWorkloadReference) *ServiceBindingWorkloadReferenceDie {
+	if r == nil {
+		r = &apisv1.ServiceBindingWorkloadReference{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ServiceBindingWorkloadReferenceDie) DieSealRelease() apisv1.ServiceBindingWorkloadReference {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ServiceBindingWorkloadReferenceDie) DieSealReleasePtr() *apisv1.ServiceBindingWorkloadReference {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ServiceBindingWorkloadReferenceDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ServiceBindingWorkloadReferenceDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// SelectorDie mutates Selector as a die.
+//
+// Selector is a query that selects the workload or workloads to bind the service to
+func (d *ServiceBindingWorkloadReferenceDie) SelectorDie(fn func(d *metav1.LabelSelectorDie)) *ServiceBindingWorkloadReferenceDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingWorkloadReference) {
+		d := metav1.LabelSelectorBlank.DieImmutable(false).DieFeedPtr(r.Selector)
+		fn(d)
+		r.Selector = d.DieReleasePtr()
+	})
+}
+
+// OwnerDie mutates Owner as a die.
+//
+// Owner selects the workload or workloads controlled by the referenced object. May be combined with Selector to
+//
+// further restrict the workloads bound.
+func (d *ServiceBindingWorkloadReferenceDie) OwnerDie(fn func(d *ServiceBindingWorkloadOwnerReferenceDie)) *ServiceBindingWorkloadReferenceDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingWorkloadReference) {
+		d := ServiceBindingWorkloadOwnerReferenceBlank.DieImmutable(false).DieFeedPtr(r.Owner)
+		fn(d)
+		r.Owner = d.DieReleasePtr()
+	})
+}
+
+// API version of the referent.
+func (d *ServiceBindingWorkloadReferenceDie) APIVersion(v string) *ServiceBindingWorkloadReferenceDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingWorkloadReference) {
+		r.APIVersion = v
+	})
+}
+
+// Kind of the referent.
+//
+// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ServiceBindingWorkloadReferenceDie) Kind(v string) *ServiceBindingWorkloadReferenceDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingWorkloadReference) {
+		r.Kind = v
+	})
+}
+
+// Name of the referent.
+//
+// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+func (d *ServiceBindingWorkloadReferenceDie) Name(v string) *ServiceBindingWorkloadReferenceDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingWorkloadReference) {
+		r.Name = v
+	})
+}
+
+// Selector is a query that selects the workload or workloads to bind the service to
+func (d *ServiceBindingWorkloadReferenceDie) Selector(v *apismetav1.LabelSelector) *ServiceBindingWorkloadReferenceDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingWorkloadReference) {
+		r.Selector = v
+	})
+}
+
+// Owner selects the workload or workloads controlled by the referenced object. May be combined with Selector to
+//
+// further restrict the workloads bound.
+func (d *ServiceBindingWorkloadReferenceDie) Owner(v *apisv1.ServiceBindingWorkloadOwnerReference) *ServiceBindingWorkloadReferenceDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingWorkloadReference) {
+		r.Owner = v
+	})
+}
+
+// Containers describes which containers in a Pod should be bound to
+func (d *ServiceBindingWorkloadReferenceDie) Containers(v ...string) *ServiceBindingWorkloadReferenceDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingWorkloadReference) {
+		r.Containers = v
+	})
+}
+
+var ServiceBindingWorkloadOwnerReferenceBlank = (&ServiceBindingWorkloadOwnerReferenceDie{}).DieFeed(apisv1.ServiceBindingWorkloadOwnerReference{})
+
+type ServiceBindingWorkloadOwnerReferenceDie struct {
+	mutable bool
+	r       apisv1.ServiceBindingWorkloadOwnerReference
+	seal    apisv1.ServiceBindingWorkloadOwnerReference
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ServiceBindingWorkloadOwnerReferenceDie) DieImmutable(immutable bool) *ServiceBindingWorkloadOwnerReferenceDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ServiceBindingWorkloadOwnerReferenceDie) DieFeed(r apisv1.ServiceBindingWorkloadOwnerReference) *ServiceBindingWorkloadOwnerReferenceDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ServiceBindingWorkloadOwnerReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ServiceBindingWorkloadOwnerReferenceDie) DieFeedPtr(r *apisv1.ServiceBindingWorkloadOwnerReference) *ServiceBindingWorkloadOwnerReferenceDie {
+	if r == nil {
+		r = &apisv1.ServiceBindingWorkloadOwnerReference{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ServiceBindingWorkloadOwnerReferenceDie) DieFeedDuck(v any) *ServiceBindingWorkloadOwnerReferenceDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ServiceBindingWorkloadOwnerReferenceDie) DieFeedJSON(j []byte) *ServiceBindingWorkloadOwnerReferenceDie {
+	r := apisv1.ServiceBindingWorkloadOwnerReference{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ServiceBindingWorkloadOwnerReferenceDie) DieFeedYAML(y []byte) *ServiceBindingWorkloadOwnerReferenceDie {
+	r := apisv1.ServiceBindingWorkloadOwnerReference{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ServiceBindingWorkloadOwnerReferenceDie) DieFeedYAMLFile(name string) *ServiceBindingWorkloadOwnerReferenceDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ServiceBindingWorkloadOwnerReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingWorkloadOwnerReferenceDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ServiceBindingWorkloadOwnerReferenceDie) DieRelease() apisv1.ServiceBindingWorkloadOwnerReference {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ServiceBindingWorkloadOwnerReferenceDie) DieReleasePtr() *apisv1.ServiceBindingWorkloadOwnerReference {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ServiceBindingWorkloadOwnerReferenceDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ServiceBindingWorkloadOwnerReferenceDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ServiceBindingWorkloadOwnerReferenceDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ServiceBindingWorkloadOwnerReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ServiceBindingWorkloadOwnerReferenceDie) DieStamp(fn func(r *apisv1.ServiceBindingWorkloadOwnerReference)) *ServiceBindingWorkloadOwnerReferenceDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ServiceBindingWorkloadOwnerReferenceDie) DieStampAt(jp string, fn interface{}) *ServiceBindingWorkloadOwnerReferenceDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingWorkloadOwnerReference) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ServiceBindingWorkloadOwnerReferenceDie) DieWith(fns ...func(d *ServiceBindingWorkloadOwnerReferenceDie)) *ServiceBindingWorkloadOwnerReferenceDie {
+	nd := ServiceBindingWorkloadOwnerReferenceBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ServiceBindingWorkloadOwnerReferenceDie) DeepCopy() *ServiceBindingWorkloadOwnerReferenceDie {
+	r := *d.r.DeepCopy()
+	return &ServiceBindingWorkloadOwnerReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ServiceBindingWorkloadOwnerReferenceDie) DieSeal() *ServiceBindingWorkloadOwnerReferenceDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ServiceBindingWorkloadOwnerReferenceDie) DieSealFeed(r apisv1.ServiceBindingWorkloadOwnerReference) *ServiceBindingWorkloadOwnerReferenceDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ServiceBindingWorkloadOwnerReferenceDie) DieSealFeedPtr(r *apisv1.ServiceBindingWorkloadOwnerReference) *ServiceBindingWorkloadOwnerReferenceDie {
+	if r == nil {
+		r = &apisv1.ServiceBindingWorkloadOwnerReference{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ServiceBindingWorkloadOwnerReferenceDie) DieSealRelease() apisv1.ServiceBindingWorkloadOwnerReference {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ServiceBindingWorkloadOwnerReferenceDie) DieSealReleasePtr() *apisv1.ServiceBindingWorkloadOwnerReference {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ServiceBindingWorkloadOwnerReferenceDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ServiceBindingWorkloadOwnerReferenceDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// API version of the referent. The version is ignored when matching owner references.
+func (d *ServiceBindingWorkloadOwnerReferenceDie) APIVersion(v string) *ServiceBindingWorkloadOwnerReferenceDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingWorkloadOwnerReference) {
+		r.APIVersion = v
+	})
+}
+
+// Kind of the referent.
+//
+// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ServiceBindingWorkloadOwnerReferenceDie) Kind(v string) *ServiceBindingWorkloadOwnerReferenceDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingWorkloadOwnerReference) {
+		r.Kind = v
+	})
+}
+
+// Name of the referent.
+//
+// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+func (d *ServiceBindingWorkloadOwnerReferenceDie) Name(v string) *ServiceBindingWorkloadOwnerReferenceDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingWorkloadOwnerReference) {
+		r.Name = v
+	})
+}
+
+var ServiceBindingServiceReferenceBlank = (&ServiceBindingServiceReferenceDie{}).DieFeed(apisv1.ServiceBindingServiceReference{})
+
+type ServiceBindingServiceReferenceDie struct {
+	mutable bool
+	r       apisv1.ServiceBindingServiceReference
+	seal    apisv1.ServiceBindingServiceReference
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ServiceBindingServiceReferenceDie) DieImmutable(immutable bool) *ServiceBindingServiceReferenceDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ServiceBindingServiceReferenceDie) DieFeed(r apisv1.ServiceBindingServiceReference) *ServiceBindingServiceReferenceDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ServiceBindingServiceReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ServiceBindingServiceReferenceDie) DieFeedPtr(r *apisv1.ServiceBindingServiceReference) *ServiceBindingServiceReferenceDie {
+	if r == nil {
+		r = &apisv1.ServiceBindingServiceReference{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ServiceBindingServiceReferenceDie) DieFeedDuck(v any) *ServiceBindingServiceReferenceDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ServiceBindingServiceReferenceDie) DieFeedJSON(j []byte) *ServiceBindingServiceReferenceDie {
+	r := apisv1.ServiceBindingServiceReference{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ServiceBindingServiceReferenceDie) DieFeedYAML(y []byte) *ServiceBindingServiceReferenceDie {
+	r := apisv1.ServiceBindingServiceReference{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ServiceBindingServiceReferenceDie) DieFeedYAMLFile(name string) *ServiceBindingServiceReferenceDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ServiceBindingServiceReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingServiceReferenceDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ServiceBindingServiceReferenceDie) DieRelease() apisv1.ServiceBindingServiceReference {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ServiceBindingServiceReferenceDie) DieReleasePtr() *apisv1.ServiceBindingServiceReference {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ServiceBindingServiceReferenceDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ServiceBindingServiceReferenceDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ServiceBindingServiceReferenceDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ServiceBindingServiceReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ServiceBindingServiceReferenceDie) DieStamp(fn func(r *apisv1.ServiceBindingServiceReference)) *ServiceBindingServiceReferenceDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ServiceBindingServiceReferenceDie) DieStampAt(jp string, fn interface{}) *ServiceBindingServiceReferenceDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingServiceReference) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ServiceBindingServiceReferenceDie) DieWith(fns ...func(d *ServiceBindingServiceReferenceDie)) *ServiceBindingServiceReferenceDie {
+	nd := ServiceBindingServiceReferenceBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ServiceBindingServiceReferenceDie) DeepCopy() *ServiceBindingServiceReferenceDie {
+	r := *d.r.DeepCopy()
+	return &ServiceBindingServiceReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ServiceBindingServiceReferenceDie) DieSeal() *ServiceBindingServiceReferenceDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ServiceBindingServiceReferenceDie) DieSealFeed(r apisv1.ServiceBindingServiceReference) *ServiceBindingServiceReferenceDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ServiceBindingServiceReferenceDie) DieSealFeedPtr(r *apisv1.ServiceBindingServiceReference) *ServiceBindingServiceReferenceDie {
+	if r == nil {
+		r = &apisv1.ServiceBindingServiceReference{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ServiceBindingServiceReferenceDie) DieSealRelease() apisv1.ServiceBindingServiceReference {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ServiceBindingServiceReferenceDie) DieSealReleasePtr() *apisv1.ServiceBindingServiceReference {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ServiceBindingServiceReferenceDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ServiceBindingServiceReferenceDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// API version of the referent.
+func (d *ServiceBindingServiceReferenceDie) APIVersion(v string) *ServiceBindingServiceReferenceDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingServiceReference) {
+		r.APIVersion = v
+	})
+}
+
+// Kind of the referent.
+//
+// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ServiceBindingServiceReferenceDie) Kind(v string) *ServiceBindingServiceReferenceDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingServiceReference) {
+		r.Kind = v
 	})
 }
 
-// ServiceDie mutates Service as a die.
+// Name of the referent.
 //
-// Service is a reference to an object that fulfills the ProvisionedService duck type
-func (d *ServiceBindingSpecDie) ServiceDie(fn func(d *ServiceBindingServiceReferenceDie)) *ServiceBindingSpecDie {
-	return d.DieStamp(func(r *apisv1.ServiceBindingSpec) {
-		d := ServiceBindingServiceReferenceBlank.DieImmutable(false).DieFeed(r.Service)
-		fn(d)
-		r.Service = d.DieRelease()
+// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+func (d *ServiceBindingServiceReferenceDie) Name(v string) *ServiceBindingServiceReferenceDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingServiceReference) {
+		r.Name = v
 	})
 }
 
-// EnvDie mutates a single item in Env matched by the nested field Name, appending a new item if no match is found.
+var EnvMappingBlank = (&EnvMappingDie{}).DieFeed(apisv1.EnvMapping{})
+
+type EnvMappingDie struct {
+	mutable bool
+	r       apisv1.EnvMapping
+	seal    apisv1.EnvMapping
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *EnvMappingDie) DieImmutable(immutable bool) *EnvMappingDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *EnvMappingDie) DieFeed(r apisv1.EnvMapping) *EnvMappingDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &EnvMappingDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *EnvMappingDie) DieFeedPtr(r *apisv1.EnvMapping) *EnvMappingDie {
+	if r == nil {
+		r = &apisv1.EnvMapping{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *EnvMappingDie) DieFeedDuck(v any) *EnvMappingDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *EnvMappingDie) DieFeedJSON(j []byte) *EnvMappingDie {
+	r := apisv1.EnvMapping{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *EnvMappingDie) DieFeedYAML(y []byte) *EnvMappingDie {
+	r := apisv1.EnvMapping{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *EnvMappingDie) DieFeedYAMLFile(name string) *EnvMappingDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *EnvMappingDie) DieFeedRawExtension(raw runtime.RawExtension) *EnvMappingDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *EnvMappingDie) DieRelease() apisv1.EnvMapping {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *EnvMappingDie) DieReleasePtr() *apisv1.EnvMapping {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *EnvMappingDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *EnvMappingDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *EnvMappingDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *EnvMappingDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *EnvMappingDie) DieStamp(fn func(r *apisv1.EnvMapping)) *EnvMappingDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
-// Env is the collection of mappings from Secret entries to environment variables
-func (d *ServiceBindingSpecDie) EnvDie(v string, fn func(d *EnvMappingDie)) *ServiceBindingSpecDie {
-	return d.DieStamp(func(r *apisv1.ServiceBindingSpec) {
-		for i := range r.Env {
-			if v == r.Env[i].Name {
-				d := EnvMappingBlank.DieImmutable(false).DieFeed(r.Env[i])
-				fn(d)
-				r.Env[i] = d.DieRelease()
-				return
-			}
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *EnvMappingDie) DieStampAt(jp string, fn interface{}) *EnvMappingDie {
+	return d.DieStamp(func(r *apisv1.EnvMapping) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
 		}
 
-		d := EnvMappingBlank.DieImmutable(false).DieFeed(apisv1.EnvMapping{Name: v})
-		fn(d)
-		r.Env = append(r.Env, d.DieRelease())
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
 	})
 }
 
-// Name is the name of the service as projected into the workload container.  Defaults to .metadata.name.
-func (d *ServiceBindingSpecDie) Name(v string) *ServiceBindingSpecDie {
-	return d.DieStamp(func(r *apisv1.ServiceBindingSpec) {
-		r.Name = v
-	})
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *EnvMappingDie) DieWith(fns ...func(d *EnvMappingDie)) *EnvMappingDie {
+	nd := EnvMappingBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *EnvMappingDie) DeepCopy() *EnvMappingDie {
+	r := *d.r.DeepCopy()
+	return &EnvMappingDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *EnvMappingDie) DieSeal() *EnvMappingDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *EnvMappingDie) DieSealFeed(r apisv1.EnvMapping) *EnvMappingDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *EnvMappingDie) DieSealFeedPtr(r *apisv1.EnvMapping) *EnvMappingDie {
+	if r == nil {
+		r = &apisv1.EnvMapping{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *EnvMappingDie) DieSealRelease() apisv1.EnvMapping {
+	return *d.seal.DeepCopy()
 }
 
-// Type is the type of the service as projected into the workload container
-func (d *ServiceBindingSpecDie) Type(v string) *ServiceBindingSpecDie {
-	return d.DieStamp(func(r *apisv1.ServiceBindingSpec) {
-		r.Type = v
-	})
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *EnvMappingDie) DieSealReleasePtr() *apisv1.EnvMapping {
+	r := d.DieSealRelease()
+	return &r
 }
 
-// Provider is the provider of the service as projected into the workload container
-func (d *ServiceBindingSpecDie) Provider(v string) *ServiceBindingSpecDie {
-	return d.DieStamp(func(r *apisv1.ServiceBindingSpec) {
-		r.Provider = v
-	})
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *EnvMappingDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
 }
 
-// Workload is a reference to an object
-func (d *ServiceBindingSpecDie) Workload(v apisv1.ServiceBindingWorkloadReference) *ServiceBindingSpecDie {
-	return d.DieStamp(func(r *apisv1.ServiceBindingSpec) {
-		r.Workload = v
-	})
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *EnvMappingDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
 }
 
-// Service is a reference to an object that fulfills the ProvisionedService duck type
-func (d *ServiceBindingSpecDie) Service(v apisv1.ServiceBindingServiceReference) *ServiceBindingSpecDie {
-	return d.DieStamp(func(r *apisv1.ServiceBindingSpec) {
-		r.Service = v
+// Name is the name of the environment variable
+func (d *EnvMappingDie) Name(v string) *EnvMappingDie {
+	return d.DieStamp(func(r *apisv1.EnvMapping) {
+		r.Name = v
 	})
 }
 
-// Env is the collection of mappings from Secret entries to environment variables
-func (d *ServiceBindingSpecDie) Env(v ...apisv1.EnvMapping) *ServiceBindingSpecDie {
-	return d.DieStamp(func(r *apisv1.ServiceBindingSpec) {
-		r.Env = v
+// Key is the key in the Secret that will be exposed
+func (d *EnvMappingDie) Key(v string) *EnvMappingDie {
+	return d.DieStamp(func(r *apisv1.EnvMapping) {
+		r.Key = v
 	})
 }
 
-var ServiceBindingWorkloadReferenceBlank = (&ServiceBindingWorkloadReferenceDie{}).DieFeed(apisv1.ServiceBindingWorkloadReference{})
+var ServiceBindingRolloutBlank = (&ServiceBindingRolloutDie{}).DieFeed(apisv1.ServiceBindingRollout{})
 
-type ServiceBindingWorkloadReferenceDie struct {
+type ServiceBindingRolloutDie struct {
 	mutable bool
-	r       apisv1.ServiceBindingWorkloadReference
-	seal    apisv1.ServiceBindingWorkloadReference
+	r       apisv1.ServiceBindingRollout
+	seal    apisv1.ServiceBindingRollout
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ServiceBindingWorkloadReferenceDie) DieImmutable(immutable bool) *ServiceBindingWorkloadReferenceDie {
+func (d *ServiceBindingRolloutDie) DieImmutable(immutable bool) *ServiceBindingRolloutDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -1954,12 +4377,12 @@ func (d *ServiceBindingWorkloadReferenceDie) DieImmutable(immutable bool) *Servi
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ServiceBindingWorkloadReferenceDie) DieFeed(r apisv1.ServiceBindingWorkloadReference) *ServiceBindingWorkloadReferenceDie {
+func (d *ServiceBindingRolloutDie) DieFeed(r apisv1.ServiceBindingRollout) *ServiceBindingRolloutDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &ServiceBindingWorkloadReferenceDie{
+	return &ServiceBindingRolloutDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -1967,15 +4390,15 @@ func (d *ServiceBindingWorkloadReferenceDie) DieFeed(r apisv1.ServiceBindingWork
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ServiceBindingWorkloadReferenceDie) DieFeedPtr(r *apisv1.ServiceBindingWorkloadReference) *ServiceBindingWorkloadReferenceDie {
+func (d *ServiceBindingRolloutDie) DieFeedPtr(r *apisv1.ServiceBindingRollout) *ServiceBindingRolloutDie {
 	if r == nil {
-		r = &apisv1.ServiceBindingWorkloadReference{}
+		r = &apisv1.ServiceBindingRollout{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *ServiceBindingWorkloadReferenceDie) DieFeedDuck(v any) *ServiceBindingWorkloadReferenceDie {
+func (d *ServiceBindingRolloutDie) DieFeedDuck(v any) *ServiceBindingRolloutDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -1984,8 +4407,8 @@ func (d *ServiceBindingWorkloadReferenceDie) DieFeedDuck(v any) *ServiceBindingW
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *ServiceBindingWorkloadReferenceDie) DieFeedJSON(j []byte) *ServiceBindingWorkloadReferenceDie {
-	r := apisv1.ServiceBindingWorkloadReference{}
+func (d *ServiceBindingRolloutDie) DieFeedJSON(j []byte) *ServiceBindingRolloutDie {
+	r := apisv1.ServiceBindingRollout{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -1993,8 +4416,8 @@ func (d *ServiceBindingWorkloadReferenceDie) DieFeedJSON(j []byte) *ServiceBindi
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *ServiceBindingWorkloadReferenceDie) DieFeedYAML(y []byte) *ServiceBindingWorkloadReferenceDie {
-	r := apisv1.ServiceBindingWorkloadReference{}
+func (d *ServiceBindingRolloutDie) DieFeedYAML(y []byte) *ServiceBindingRolloutDie {
+	r := apisv1.ServiceBindingRollout{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -2002,7 +4425,7 @@ func (d *ServiceBindingWorkloadReferenceDie) DieFeedYAML(y []byte) *ServiceBindi
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *ServiceBindingWorkloadReferenceDie) DieFeedYAMLFile(name string) *ServiceBindingWorkloadReferenceDie {
+func (d *ServiceBindingRolloutDie) DieFeedYAMLFile(name string) *ServiceBindingRolloutDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -2011,7 +4434,7 @@ func (d *ServiceBindingWorkloadReferenceDie) DieFeedYAMLFile(name string) *Servi
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ServiceBindingWorkloadReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingWorkloadReferenceDie {
+func (d *ServiceBindingRolloutDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingRolloutDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -2020,7 +4443,7 @@ func (d *ServiceBindingWorkloadReferenceDie) DieFeedRawExtension(raw runtime.Raw
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ServiceBindingWorkloadReferenceDie) DieRelease() apisv1.ServiceBindingWorkloadReference {
+func (d *ServiceBindingRolloutDie) DieRelease() apisv1.ServiceBindingRollout {
 	if d.mutable {
 		return d.r
 	}
@@ -2028,13 +4451,13 @@ func (d *ServiceBindingWorkloadReferenceDie) DieRelease() apisv1.ServiceBindingW
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ServiceBindingWorkloadReferenceDie) DieReleasePtr() *apisv1.ServiceBindingWorkloadReference {
+func (d *ServiceBindingRolloutDie) DieReleasePtr() *apisv1.ServiceBindingRollout {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *ServiceBindingWorkloadReferenceDie) DieReleaseDuck(v any) any {
+func (d *ServiceBindingRolloutDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -2043,7 +4466,7 @@ func (d *ServiceBindingWorkloadReferenceDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *ServiceBindingWorkloadReferenceDie) DieReleaseJSON() []byte {
+func (d *ServiceBindingRolloutDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -2053,7 +4476,7 @@ func (d *ServiceBindingWorkloadReferenceDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *ServiceBindingWorkloadReferenceDie) DieReleaseYAML() []byte {
+func (d *ServiceBindingRolloutDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -2063,7 +4486,7 @@ func (d *ServiceBindingWorkloadReferenceDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ServiceBindingWorkloadReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ServiceBindingRolloutDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -2073,7 +4496,7 @@ func (d *ServiceBindingWorkloadReferenceDie) DieReleaseRawExtension() runtime.Ra
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ServiceBindingWorkloadReferenceDie) DieStamp(fn func(r *apisv1.ServiceBindingWorkloadReference)) *ServiceBindingWorkloadReferenceDie {
+func (d *ServiceBindingRolloutDie) DieStamp(fn func(r *apisv1.ServiceBindingRollout)) *ServiceBindingRolloutDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -2082,8 +4505,8 @@ func (d *ServiceBindingWorkloadReferenceDie) DieStamp(fn func(r *apisv1.ServiceB
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *ServiceBindingWorkloadReferenceDie) DieStampAt(jp string, fn interface{}) *ServiceBindingWorkloadReferenceDie {
-	return d.DieStamp(func(r *apisv1.ServiceBindingWorkloadReference) {
+func (d *ServiceBindingRolloutDie) DieStampAt(jp string, fn interface{}) *ServiceBindingRolloutDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingRollout) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -2118,8 +4541,8 @@ func (d *ServiceBindingWorkloadReferenceDie) DieStampAt(jp string, fn interface{
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *ServiceBindingWorkloadReferenceDie) DieWith(fns ...func(d *ServiceBindingWorkloadReferenceDie)) *ServiceBindingWorkloadReferenceDie {
-	nd := ServiceBindingWorkloadReferenceBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *ServiceBindingRolloutDie) DieWith(fns ...func(d *ServiceBindingRolloutDie)) *ServiceBindingRolloutDie {
+	nd := ServiceBindingRolloutBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -2129,9 +4552,9 @@ func (d *ServiceBindingWorkloadReferenceDie) DieWith(fns ...func(d *ServiceBindi
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ServiceBindingWorkloadReferenceDie) DeepCopy() *ServiceBindingWorkloadReferenceDie {
+func (d *ServiceBindingRolloutDie) DeepCopy() *ServiceBindingRolloutDie {
 	r := *d.r.DeepCopy()
-	return &ServiceBindingWorkloadReferenceDie{
+	return &ServiceBindingRolloutDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -2139,12 +4562,12 @@ func (d *ServiceBindingWorkloadReferenceDie) DeepCopy() *ServiceBindingWorkloadR
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *ServiceBindingWorkloadReferenceDie) DieSeal() *ServiceBindingWorkloadReferenceDie {
+func (d *ServiceBindingRolloutDie) DieSeal() *ServiceBindingRolloutDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *ServiceBindingWorkloadReferenceDie) DieSealFeed(r apisv1.ServiceBindingWorkloadReference) *ServiceBindingWorkloadReferenceDie {
+func (d *ServiceBindingRolloutDie) DieSealFeed(r apisv1.ServiceBindingRollout) *ServiceBindingRolloutDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -2153,94 +4576,55 @@ func (d *ServiceBindingWorkloadReferenceDie) DieSealFeed(r apisv1.ServiceBinding
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *ServiceBindingWorkloadReferenceDie) DieSealFeedPtr(r *apisv1.ServiceBindingWorkloadReference) *ServiceBindingWorkloadReferenceDie {
+func (d *ServiceBindingRolloutDie) DieSealFeedPtr(r *apisv1.ServiceBindingRollout) *ServiceBindingRolloutDie {
 	if r == nil {
-		r = &apisv1.ServiceBindingWorkloadReference{}
+		r = &apisv1.ServiceBindingRollout{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *ServiceBindingWorkloadReferenceDie) DieSealRelease() apisv1.ServiceBindingWorkloadReference {
+func (d *ServiceBindingRolloutDie) DieSealRelease() apisv1.ServiceBindingRollout {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *ServiceBindingWorkloadReferenceDie) DieSealReleasePtr() *apisv1.ServiceBindingWorkloadReference {
+func (d *ServiceBindingRolloutDie) DieSealReleasePtr() *apisv1.ServiceBindingRollout {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *ServiceBindingWorkloadReferenceDie) DieDiff(opts ...cmp.Option) string {
+func (d *ServiceBindingRolloutDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *ServiceBindingWorkloadReferenceDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *ServiceBindingRolloutDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-// SelectorDie mutates Selector as a die.
-//
-// Selector is a query that selects the workload or workloads to bind the service to
-func (d *ServiceBindingWorkloadReferenceDie) SelectorDie(fn func(d *metav1.LabelSelectorDie)) *ServiceBindingWorkloadReferenceDie {
-	return d.DieStamp(func(r *apisv1.ServiceBindingWorkloadReference) {
-		d := metav1.LabelSelectorBlank.DieImmutable(false).DieFeedPtr(r.Selector)
-		fn(d)
-		r.Selector = d.DieReleasePtr()
-	})
-}
-
-// API version of the referent.
-func (d *ServiceBindingWorkloadReferenceDie) APIVersion(v string) *ServiceBindingWorkloadReferenceDie {
-	return d.DieStamp(func(r *apisv1.ServiceBindingWorkloadReference) {
-		r.APIVersion = v
-	})
-}
-
-// Kind of the referent.
+// MaxUnavailable is the number, or percentage, of workloads that may be rolling out a change to the binding at
 //
-// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-func (d *ServiceBindingWorkloadReferenceDie) Kind(v string) *ServiceBindingWorkloadReferenceDie {
-	return d.DieStamp(func(r *apisv1.ServiceBindingWorkloadReference) {
-		r.Kind = v
-	})
-}
-
-// Name of the referent.
+// the same time. Further workloads are updated once the updated workloads complete their rollout. Percentages are
 //
-// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
-func (d *ServiceBindingWorkloadReferenceDie) Name(v string) *ServiceBindingWorkloadReferenceDie {
-	return d.DieStamp(func(r *apisv1.ServiceBindingWorkloadReference) {
-		r.Name = v
-	})
-}
-
-// Selector is a query that selects the workload or workloads to bind the service to
-func (d *ServiceBindingWorkloadReferenceDie) Selector(v *apismetav1.LabelSelector) *ServiceBindingWorkloadReferenceDie {
-	return d.DieStamp(func(r *apisv1.ServiceBindingWorkloadReference) {
-		r.Selector = v
-	})
-}
-
-// Containers describes which containers in a Pod should be bound to
-func (d *ServiceBindingWorkloadReferenceDie) Containers(v ...string) *ServiceBindingWorkloadReferenceDie {
-	return d.DieStamp(func(r *apisv1.ServiceBindingWorkloadReference) {
-		r.Containers = v
+// rounded up. Defaults to 1.
+func (d *ServiceBindingRolloutDie) MaxUnavailable(v *intstr.IntOrString) *ServiceBindingRolloutDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingRollout) {
+		r.MaxUnavailable = v
 	})
 }
 
-var ServiceBindingServiceReferenceBlank = (&ServiceBindingServiceReferenceDie{}).DieFeed(apisv1.ServiceBindingServiceReference{})
+var ServiceBindingStatusBlank = (&ServiceBindingStatusDie{}).DieFeed(apisv1.ServiceBindingStatus{})
 
-type ServiceBindingServiceReferenceDie struct {
+type ServiceBindingStatusDie struct {
 	mutable bool
-	r       apisv1.ServiceBindingServiceReference
-	seal    apisv1.ServiceBindingServiceReference
+	r       apisv1.ServiceBindingStatus
+	seal    apisv1.ServiceBindingStatus
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ServiceBindingServiceReferenceDie) DieImmutable(immutable bool) *ServiceBindingServiceReferenceDie {
+func (d *ServiceBindingStatusDie) DieImmutable(immutable bool) *ServiceBindingStatusDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -2250,12 +4634,12 @@ func (d *ServiceBindingServiceReferenceDie) DieImmutable(immutable bool) *Servic
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ServiceBindingServiceReferenceDie) DieFeed(r apisv1.ServiceBindingServiceReference) *ServiceBindingServiceReferenceDie {
+func (d *ServiceBindingStatusDie) DieFeed(r apisv1.ServiceBindingStatus) *ServiceBindingStatusDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &ServiceBindingServiceReferenceDie{
+	return &ServiceBindingStatusDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -2263,15 +4647,15 @@ func (d *ServiceBindingServiceReferenceDie) DieFeed(r apisv1.ServiceBindingServi
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ServiceBindingServiceReferenceDie) DieFeedPtr(r *apisv1.ServiceBindingServiceReference) *ServiceBindingServiceReferenceDie {
+func (d *ServiceBindingStatusDie) DieFeedPtr(r *apisv1.ServiceBindingStatus) *ServiceBindingStatusDie {
 	if r == nil {
-		r = &apisv1.ServiceBindingServiceReference{}
+		r = &apisv1.ServiceBindingStatus{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *ServiceBindingServiceReferenceDie) DieFeedDuck(v any) *ServiceBindingServiceReferenceDie {
+func (d *ServiceBindingStatusDie) DieFeedDuck(v any) *ServiceBindingStatusDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -2280,8 +4664,8 @@ func (d *ServiceBindingServiceReferenceDie) DieFeedDuck(v any) *ServiceBindingSe
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *ServiceBindingServiceReferenceDie) DieFeedJSON(j []byte) *ServiceBindingServiceReferenceDie {
-	r := apisv1.ServiceBindingServiceReference{}
+func (d *ServiceBindingStatusDie) DieFeedJSON(j []byte) *ServiceBindingStatusDie {
+	r := apisv1.ServiceBindingStatus{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -2289,8 +4673,8 @@ func (d *ServiceBindingServiceReferenceDie) DieFeedJSON(j []byte) *ServiceBindin
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *ServiceBindingServiceReferenceDie) DieFeedYAML(y []byte) *ServiceBindingServiceReferenceDie {
-	r := apisv1.ServiceBindingServiceReference{}
+func (d *ServiceBindingStatusDie) DieFeedYAML(y []byte) *ServiceBindingStatusDie {
+	r := apisv1.ServiceBindingStatus{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -2298,7 +4682,7 @@ func (d *ServiceBindingServiceReferenceDie) DieFeedYAML(y []byte) *ServiceBindin
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *ServiceBindingServiceReferenceDie) DieFeedYAMLFile(name string) *ServiceBindingServiceReferenceDie {
+func (d *ServiceBindingStatusDie) DieFeedYAMLFile(name string) *ServiceBindingStatusDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -2307,7 +4691,7 @@ func (d *ServiceBindingServiceReferenceDie) DieFeedYAMLFile(name string) *Servic
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ServiceBindingServiceReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingServiceReferenceDie {
+func (d *ServiceBindingStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingStatusDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -2316,7 +4700,7 @@ func (d *ServiceBindingServiceReferenceDie) DieFeedRawExtension(raw runtime.RawE
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ServiceBindingServiceReferenceDie) DieRelease() apisv1.ServiceBindingServiceReference {
+func (d *ServiceBindingStatusDie) DieRelease() apisv1.ServiceBindingStatus {
 	if d.mutable {
 		return d.r
 	}
@@ -2324,13 +4708,13 @@ func (d *ServiceBindingServiceReferenceDie) DieRelease() apisv1.ServiceBindingSe
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ServiceBindingServiceReferenceDie) DieReleasePtr() *apisv1.ServiceBindingServiceReference {
+func (d *ServiceBindingStatusDie) DieReleasePtr() *apisv1.ServiceBindingStatus {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *ServiceBindingServiceReferenceDie) DieReleaseDuck(v any) any {
+func (d *ServiceBindingStatusDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -2339,7 +4723,7 @@ func (d *ServiceBindingServiceReferenceDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *ServiceBindingServiceReferenceDie) DieReleaseJSON() []byte {
+func (d *ServiceBindingStatusDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -2349,7 +4733,7 @@ func (d *ServiceBindingServiceReferenceDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *ServiceBindingServiceReferenceDie) DieReleaseYAML() []byte {
+func (d *ServiceBindingStatusDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -2359,7 +4743,7 @@ func (d *ServiceBindingServiceReferenceDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ServiceBindingServiceReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ServiceBindingStatusDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -2369,7 +4753,7 @@ func (d *ServiceBindingServiceReferenceDie) DieReleaseRawExtension() runtime.Raw
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ServiceBindingServiceReferenceDie) DieStamp(fn func(r *apisv1.ServiceBindingServiceReference)) *ServiceBindingServiceReferenceDie {
+func (d *ServiceBindingStatusDie) DieStamp(fn func(r *apisv1.ServiceBindingStatus)) *ServiceBindingStatusDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -2378,8 +4762,8 @@ func (d *ServiceBindingServiceReferenceDie) DieStamp(fn func(r *apisv1.ServiceBi
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *ServiceBindingServiceReferenceDie) DieStampAt(jp string, fn interface{}) *ServiceBindingServiceReferenceDie {
-	return d.DieStamp(func(r *apisv1.ServiceBindingServiceReference) {
+func (d *ServiceBindingStatusDie) DieStampAt(jp string, fn interface{}) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingStatus) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -2414,8 +4798,8 @@ func (d *ServiceBindingServiceReferenceDie) DieStampAt(jp string, fn interface{}
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *ServiceBindingServiceReferenceDie) DieWith(fns ...func(d *ServiceBindingServiceReferenceDie)) *ServiceBindingServiceReferenceDie {
-	nd := ServiceBindingServiceReferenceBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *ServiceBindingStatusDie) DieWith(fns ...func(d *ServiceBindingStatusDie)) *ServiceBindingStatusDie {
+	nd := ServiceBindingStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -2425,9 +4809,9 @@ func (d *ServiceBindingServiceReferenceDie) DieWith(fns ...func(d *ServiceBindin
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ServiceBindingServiceReferenceDie) DeepCopy() *ServiceBindingServiceReferenceDie {
+func (d *ServiceBindingStatusDie) DeepCopy() *ServiceBindingStatusDie {
 	r := *d.r.DeepCopy()
-	return &ServiceBindingServiceReferenceDie{
+	return &ServiceBindingStatusDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -2435,12 +4819,12 @@ func (d *ServiceBindingServiceReferenceDie) DeepCopy() *ServiceBindingServiceRef
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *ServiceBindingServiceReferenceDie) DieSeal() *ServiceBindingServiceReferenceDie {
+func (d *ServiceBindingStatusDie) DieSeal() *ServiceBindingStatusDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *ServiceBindingServiceReferenceDie) DieSealFeed(r apisv1.ServiceBindingServiceReference) *ServiceBindingServiceReferenceDie {
+func (d *ServiceBindingStatusDie) DieSealFeed(r apisv1.ServiceBindingStatus) *ServiceBindingStatusDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -2449,69 +4833,156 @@ func (d *ServiceBindingServiceReferenceDie) DieSealFeed(r apisv1.ServiceBindingS
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *ServiceBindingServiceReferenceDie) DieSealFeedPtr(r *apisv1.ServiceBindingServiceReference) *ServiceBindingServiceReferenceDie {
+func (d *ServiceBindingStatusDie) DieSealFeedPtr(r *apisv1.ServiceBindingStatus) *ServiceBindingStatusDie {
 	if r == nil {
-		r = &apisv1.ServiceBindingServiceReference{}
+		r = &apisv1.ServiceBindingStatus{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *ServiceBindingServiceReferenceDie) DieSealRelease() apisv1.ServiceBindingServiceReference {
+func (d *ServiceBindingStatusDie) DieSealRelease() apisv1.ServiceBindingStatus {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *ServiceBindingServiceReferenceDie) DieSealReleasePtr() *apisv1.ServiceBindingServiceReference {
+func (d *ServiceBindingStatusDie) DieSealReleasePtr() *apisv1.ServiceBindingStatus {
 	r := d.DieSealRelease()
 	return &r
 }
 
-// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *ServiceBindingServiceReferenceDie) DieDiff(opts ...cmp.Option) string {
-	return cmp.Diff(d.seal, d.r, opts...)
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ServiceBindingStatusDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ServiceBindingStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// ConditionsDie replaces Conditions by collecting the released value from each die passed.
+//
+// Conditions are the conditions of this ServiceBinding
+func (d *ServiceBindingStatusDie) ConditionsDie(v ...*metav1.ConditionDie) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingStatus) {
+		r.Conditions = make([]apismetav1.Condition, len(v))
+		for i := range v {
+			r.Conditions[i] = v[i].DieRelease()
+		}
+	})
+}
+
+// BindingDie mutates Binding as a die.
+//
+// Binding exposes the projected secret for this ServiceBinding
+func (d *ServiceBindingStatusDie) BindingDie(fn func(d *ServiceBindingSecretReferenceDie)) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingStatus) {
+		d := ServiceBindingSecretReferenceBlank.DieImmutable(false).DieFeedPtr(r.Binding)
+		fn(d)
+		r.Binding = d.DieReleasePtr()
+	})
+}
+
+// WorkloadsDie replaces Workloads by collecting the released value from each die passed.
+//
+// Workloads reports the result of projecting the binding into each resolved workload. The list is capped, workloads
+//
+// that were not projected are reported before workloads that were.
+func (d *ServiceBindingStatusDie) WorkloadsDie(v ...*ServiceBindingWorkloadStatusDie) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingStatus) {
+		r.Workloads = make([]apisv1.ServiceBindingWorkloadStatus, len(v))
+		for i := range v {
+			r.Workloads[i] = v[i].DieRelease()
+		}
+	})
+}
+
+// RolloutDie mutates Rollout as a die.
+//
+// Rollout reports the progress of rolling out the binding when a rollout strategy is set
+func (d *ServiceBindingStatusDie) RolloutDie(fn func(d *ServiceBindingRolloutStatusDie)) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingStatus) {
+		d := ServiceBindingRolloutStatusBlank.DieImmutable(false).DieFeedPtr(r.Rollout)
+		fn(d)
+		r.Rollout = d.DieReleasePtr()
+	})
+}
+
+// ObservedGeneration is the 'Generation' of the ServiceBinding that
+//
+// was last processed by the controller.
+func (d *ServiceBindingStatusDie) ObservedGeneration(v int64) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingStatus) {
+		r.ObservedGeneration = v
+	})
+}
+
+// Conditions are the conditions of this ServiceBinding
+func (d *ServiceBindingStatusDie) Conditions(v ...apismetav1.Condition) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingStatus) {
+		r.Conditions = v
+	})
+}
+
+// Binding exposes the projected secret for this ServiceBinding
+func (d *ServiceBindingStatusDie) Binding(v *apisv1.ServiceBindingSecretReference) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingStatus) {
+		r.Binding = v
+	})
 }
 
-// DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *ServiceBindingServiceReferenceDie) DiePatch(patchType types.PatchType) ([]byte, error) {
-	return patch.Create(d.seal, d.r, patchType)
+// Type is the effective type of the service, .spec.type when set, otherwise the type entry of the binding Secret
+func (d *ServiceBindingStatusDie) Type(v string) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingStatus) {
+		r.Type = v
+	})
 }
 
-// API version of the referent.
-func (d *ServiceBindingServiceReferenceDie) APIVersion(v string) *ServiceBindingServiceReferenceDie {
-	return d.DieStamp(func(r *apisv1.ServiceBindingServiceReference) {
-		r.APIVersion = v
+// Provider is the effective provider of the service, .spec.provider when set, otherwise the provider entry of the
+//
+// binding Secret
+func (d *ServiceBindingStatusDie) Provider(v string) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingStatus) {
+		r.Provider = v
 	})
 }
 
-// Kind of the referent.
+// Workloads reports the result of projecting the binding into each resolved workload. The list is capped, workloads
 //
-// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-func (d *ServiceBindingServiceReferenceDie) Kind(v string) *ServiceBindingServiceReferenceDie {
-	return d.DieStamp(func(r *apisv1.ServiceBindingServiceReference) {
-		r.Kind = v
+// that were not projected are reported before workloads that were.
+func (d *ServiceBindingStatusDie) Workloads(v ...apisv1.ServiceBindingWorkloadStatus) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingStatus) {
+		r.Workloads = v
 	})
 }
 
-// Name of the referent.
+// BoundWorkloads is the number of workloads the binding is projected into, including workloads omitted from
 //
-// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
-func (d *ServiceBindingServiceReferenceDie) Name(v string) *ServiceBindingServiceReferenceDie {
-	return d.DieStamp(func(r *apisv1.ServiceBindingServiceReference) {
-		r.Name = v
+// Workloads
+func (d *ServiceBindingStatusDie) BoundWorkloads(v int32) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingStatus) {
+		r.BoundWorkloads = v
 	})
 }
 
-var EnvMappingBlank = (&EnvMappingDie{}).DieFeed(apisv1.EnvMapping{})
+// Rollout reports the progress of rolling out the binding when a rollout strategy is set
+func (d *ServiceBindingStatusDie) Rollout(v *apisv1.ServiceBindingRolloutStatus) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingStatus) {
+		r.Rollout = v
+	})
+}
 
-type EnvMappingDie struct {
+var ServiceBindingSecretReferenceBlank = (&ServiceBindingSecretReferenceDie{}).DieFeed(apisv1.ServiceBindingSecretReference{})
+
+type ServiceBindingSecretReferenceDie struct {
 	mutable bool
-	r       apisv1.EnvMapping
-	seal    apisv1.EnvMapping
+	r       apisv1.ServiceBindingSecretReference
+	seal    apisv1.ServiceBindingSecretReference
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *EnvMappingDie) DieImmutable(immutable bool) *EnvMappingDie {
+func (d *ServiceBindingSecretReferenceDie) DieImmutable(immutable bool) *ServiceBindingSecretReferenceDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -2521,12 +4992,12 @@ func (d *EnvMappingDie) DieImmutable(immutable bool) *EnvMappingDie {
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *EnvMappingDie) DieFeed(r apisv1.EnvMapping) *EnvMappingDie {
+func (d *ServiceBindingSecretReferenceDie) DieFeed(r apisv1.ServiceBindingSecretReference) *ServiceBindingSecretReferenceDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &EnvMappingDie{
+	return &ServiceBindingSecretReferenceDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -2534,15 +5005,15 @@ func (d *EnvMappingDie) DieFeed(r apisv1.EnvMapping) *EnvMappingDie {
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *EnvMappingDie) DieFeedPtr(r *apisv1.EnvMapping) *EnvMappingDie {
+func (d *ServiceBindingSecretReferenceDie) DieFeedPtr(r *apisv1.ServiceBindingSecretReference) *ServiceBindingSecretReferenceDie {
 	if r == nil {
-		r = &apisv1.EnvMapping{}
+		r = &apisv1.ServiceBindingSecretReference{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *EnvMappingDie) DieFeedDuck(v any) *EnvMappingDie {
+func (d *ServiceBindingSecretReferenceDie) DieFeedDuck(v any) *ServiceBindingSecretReferenceDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -2551,8 +5022,8 @@ func (d *EnvMappingDie) DieFeedDuck(v any) *EnvMappingDie {
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *EnvMappingDie) DieFeedJSON(j []byte) *EnvMappingDie {
-	r := apisv1.EnvMapping{}
+func (d *ServiceBindingSecretReferenceDie) DieFeedJSON(j []byte) *ServiceBindingSecretReferenceDie {
+	r := apisv1.ServiceBindingSecretReference{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -2560,8 +5031,8 @@ func (d *EnvMappingDie) DieFeedJSON(j []byte) *EnvMappingDie {
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *EnvMappingDie) DieFeedYAML(y []byte) *EnvMappingDie {
-	r := apisv1.EnvMapping{}
+func (d *ServiceBindingSecretReferenceDie) DieFeedYAML(y []byte) *ServiceBindingSecretReferenceDie {
+	r := apisv1.ServiceBindingSecretReference{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -2569,7 +5040,7 @@ func (d *EnvMappingDie) DieFeedYAML(y []byte) *EnvMappingDie {
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *EnvMappingDie) DieFeedYAMLFile(name string) *EnvMappingDie {
+func (d *ServiceBindingSecretReferenceDie) DieFeedYAMLFile(name string) *ServiceBindingSecretReferenceDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -2578,7 +5049,7 @@ func (d *EnvMappingDie) DieFeedYAMLFile(name string) *EnvMappingDie {
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *EnvMappingDie) DieFeedRawExtension(raw runtime.RawExtension) *EnvMappingDie {
+func (d *ServiceBindingSecretReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingSecretReferenceDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -2587,7 +5058,7 @@ func (d *EnvMappingDie) DieFeedRawExtension(raw runtime.RawExtension) *EnvMappin
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *EnvMappingDie) DieRelease() apisv1.EnvMapping {
+func (d *ServiceBindingSecretReferenceDie) DieRelease() apisv1.ServiceBindingSecretReference {
 	if d.mutable {
 		return d.r
 	}
@@ -2595,13 +5066,13 @@ func (d *EnvMappingDie) DieRelease() apisv1.EnvMapping {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *EnvMappingDie) DieReleasePtr() *apisv1.EnvMapping {
+func (d *ServiceBindingSecretReferenceDie) DieReleasePtr() *apisv1.ServiceBindingSecretReference {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *EnvMappingDie) DieReleaseDuck(v any) any {
+func (d *ServiceBindingSecretReferenceDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -2610,7 +5081,7 @@ func (d *EnvMappingDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *EnvMappingDie) DieReleaseJSON() []byte {
+func (d *ServiceBindingSecretReferenceDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -2620,7 +5091,7 @@ func (d *EnvMappingDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *EnvMappingDie) DieReleaseYAML() []byte {
+func (d *ServiceBindingSecretReferenceDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -2630,7 +5101,7 @@ func (d *EnvMappingDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *EnvMappingDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ServiceBindingSecretReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -2640,7 +5111,7 @@ func (d *EnvMappingDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *EnvMappingDie) DieStamp(fn func(r *apisv1.EnvMapping)) *EnvMappingDie {
+func (d *ServiceBindingSecretReferenceDie) DieStamp(fn func(r *apisv1.ServiceBindingSecretReference)) *ServiceBindingSecretReferenceDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -2649,8 +5120,8 @@ func (d *EnvMappingDie) DieStamp(fn func(r *apisv1.EnvMapping)) *EnvMappingDie {
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *EnvMappingDie) DieStampAt(jp string, fn interface{}) *EnvMappingDie {
-	return d.DieStamp(func(r *apisv1.EnvMapping) {
+func (d *ServiceBindingSecretReferenceDie) DieStampAt(jp string, fn interface{}) *ServiceBindingSecretReferenceDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingSecretReference) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -2685,8 +5156,8 @@ func (d *EnvMappingDie) DieStampAt(jp string, fn interface{}) *EnvMappingDie {
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *EnvMappingDie) DieWith(fns ...func(d *EnvMappingDie)) *EnvMappingDie {
-	nd := EnvMappingBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *ServiceBindingSecretReferenceDie) DieWith(fns ...func(d *ServiceBindingSecretReferenceDie)) *ServiceBindingSecretReferenceDie {
+	nd := ServiceBindingSecretReferenceBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -2696,9 +5167,9 @@ func (d *EnvMappingDie) DieWith(fns ...func(d *EnvMappingDie)) *EnvMappingDie {
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *EnvMappingDie) DeepCopy() *EnvMappingDie {
+func (d *ServiceBindingSecretReferenceDie) DeepCopy() *ServiceBindingSecretReferenceDie {
 	r := *d.r.DeepCopy()
-	return &EnvMappingDie{
+	return &ServiceBindingSecretReferenceDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -2706,12 +5177,12 @@ func (d *EnvMappingDie) DeepCopy() *EnvMappingDie {
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *EnvMappingDie) DieSeal() *EnvMappingDie {
+func (d *ServiceBindingSecretReferenceDie) DieSeal() *ServiceBindingSecretReferenceDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *EnvMappingDie) DieSealFeed(r apisv1.EnvMapping) *EnvMappingDie {
+func (d *ServiceBindingSecretReferenceDie) DieSealFeed(r apisv1.ServiceBindingSecretReference) *ServiceBindingSecretReferenceDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -2720,58 +5191,53 @@ func (d *EnvMappingDie) DieSealFeed(r apisv1.EnvMapping) *EnvMappingDie {
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *EnvMappingDie) DieSealFeedPtr(r *apisv1.EnvMapping) *EnvMappingDie {
+func (d *ServiceBindingSecretReferenceDie) DieSealFeedPtr(r *apisv1.ServiceBindingSecretReference) *ServiceBindingSecretReferenceDie {
 	if r == nil {
-		r = &apisv1.EnvMapping{}
+		r = &apisv1.ServiceBindingSecretReference{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *EnvMappingDie) DieSealRelease() apisv1.EnvMapping {
+func (d *ServiceBindingSecretReferenceDie) DieSealRelease() apisv1.ServiceBindingSecretReference {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *EnvMappingDie) DieSealReleasePtr() *apisv1.EnvMapping {
+func (d *ServiceBindingSecretReferenceDie) DieSealReleasePtr() *apisv1.ServiceBindingSecretReference {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *EnvMappingDie) DieDiff(opts ...cmp.Option) string {
+func (d *ServiceBindingSecretReferenceDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *EnvMappingDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *ServiceBindingSecretReferenceDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-// Name is the name of the environment variable
-func (d *EnvMappingDie) Name(v string) *EnvMappingDie {
-	return d.DieStamp(func(r *apisv1.EnvMapping) {
+// Name of the referent secret.
+//
+// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+func (d *ServiceBindingSecretReferenceDie) Name(v string) *ServiceBindingSecretReferenceDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingSecretReference) {
 		r.Name = v
 	})
 }
 
-// Key is the key in the Secret that will be exposed
-func (d *EnvMappingDie) Key(v string) *EnvMappingDie {
-	return d.DieStamp(func(r *apisv1.EnvMapping) {
-		r.Key = v
-	})
-}
-
-var ServiceBindingStatusBlank = (&ServiceBindingStatusDie{}).DieFeed(apisv1.ServiceBindingStatus{})
+var ServiceBindingWorkloadStatusBlank = (&ServiceBindingWorkloadStatusDie{}).DieFeed(apisv1.ServiceBindingWorkloadStatus{})
 
-type ServiceBindingStatusDie struct {
+type ServiceBindingWorkloadStatusDie struct {
 	mutable bool
-	r       apisv1.ServiceBindingStatus
-	seal    apisv1.ServiceBindingStatus
+	r       apisv1.ServiceBindingWorkloadStatus
+	seal    apisv1.ServiceBindingWorkloadStatus
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ServiceBindingStatusDie) DieImmutable(immutable bool) *ServiceBindingStatusDie {
+func (d *ServiceBindingWorkloadStatusDie) DieImmutable(immutable bool) *ServiceBindingWorkloadStatusDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -2781,12 +5247,12 @@ func (d *ServiceBindingStatusDie) DieImmutable(immutable bool) *ServiceBindingSt
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ServiceBindingStatusDie) DieFeed(r apisv1.ServiceBindingStatus) *ServiceBindingStatusDie {
+func (d *ServiceBindingWorkloadStatusDie) DieFeed(r apisv1.ServiceBindingWorkloadStatus) *ServiceBindingWorkloadStatusDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &ServiceBindingStatusDie{
+	return &ServiceBindingWorkloadStatusDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -2794,15 +5260,15 @@ func (d *ServiceBindingStatusDie) DieFeed(r apisv1.ServiceBindingStatus) *Servic
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ServiceBindingStatusDie) DieFeedPtr(r *apisv1.ServiceBindingStatus) *ServiceBindingStatusDie {
+func (d *ServiceBindingWorkloadStatusDie) DieFeedPtr(r *apisv1.ServiceBindingWorkloadStatus) *ServiceBindingWorkloadStatusDie {
 	if r == nil {
-		r = &apisv1.ServiceBindingStatus{}
+		r = &apisv1.ServiceBindingWorkloadStatus{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *ServiceBindingStatusDie) DieFeedDuck(v any) *ServiceBindingStatusDie {
+func (d *ServiceBindingWorkloadStatusDie) DieFeedDuck(v any) *ServiceBindingWorkloadStatusDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -2811,8 +5277,8 @@ func (d *ServiceBindingStatusDie) DieFeedDuck(v any) *ServiceBindingStatusDie {
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *ServiceBindingStatusDie) DieFeedJSON(j []byte) *ServiceBindingStatusDie {
-	r := apisv1.ServiceBindingStatus{}
+func (d *ServiceBindingWorkloadStatusDie) DieFeedJSON(j []byte) *ServiceBindingWorkloadStatusDie {
+	r := apisv1.ServiceBindingWorkloadStatus{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -2820,8 +5286,8 @@ func (d *ServiceBindingStatusDie) DieFeedJSON(j []byte) *ServiceBindingStatusDie
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *ServiceBindingStatusDie) DieFeedYAML(y []byte) *ServiceBindingStatusDie {
-	r := apisv1.ServiceBindingStatus{}
+func (d *ServiceBindingWorkloadStatusDie) DieFeedYAML(y []byte) *ServiceBindingWorkloadStatusDie {
+	r := apisv1.ServiceBindingWorkloadStatus{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -2829,7 +5295,7 @@ func (d *ServiceBindingStatusDie) DieFeedYAML(y []byte) *ServiceBindingStatusDie
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *ServiceBindingStatusDie) DieFeedYAMLFile(name string) *ServiceBindingStatusDie {
+func (d *ServiceBindingWorkloadStatusDie) DieFeedYAMLFile(name string) *ServiceBindingWorkloadStatusDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -2838,7 +5304,7 @@ func (d *ServiceBindingStatusDie) DieFeedYAMLFile(name string) *ServiceBindingSt
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ServiceBindingStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingStatusDie {
+func (d *ServiceBindingWorkloadStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingWorkloadStatusDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -2847,7 +5313,7 @@ func (d *ServiceBindingStatusDie) DieFeedRawExtension(raw runtime.RawExtension)
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ServiceBindingStatusDie) DieRelease() apisv1.ServiceBindingStatus {
+func (d *ServiceBindingWorkloadStatusDie) DieRelease() apisv1.ServiceBindingWorkloadStatus {
 	if d.mutable {
 		return d.r
 	}
@@ -2855,13 +5321,13 @@ func (d *ServiceBindingStatusDie) DieRelease() apisv1.ServiceBindingStatus {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ServiceBindingStatusDie) DieReleasePtr() *apisv1.ServiceBindingStatus {
+func (d *ServiceBindingWorkloadStatusDie) DieReleasePtr() *apisv1.ServiceBindingWorkloadStatus {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *ServiceBindingStatusDie) DieReleaseDuck(v any) any {
+func (d *ServiceBindingWorkloadStatusDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -2870,7 +5336,7 @@ func (d *ServiceBindingStatusDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *ServiceBindingStatusDie) DieReleaseJSON() []byte {
+func (d *ServiceBindingWorkloadStatusDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -2880,7 +5346,7 @@ func (d *ServiceBindingStatusDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *ServiceBindingStatusDie) DieReleaseYAML() []byte {
+func (d *ServiceBindingWorkloadStatusDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -2890,7 +5356,7 @@ func (d *ServiceBindingStatusDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ServiceBindingStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ServiceBindingWorkloadStatusDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -2900,7 +5366,7 @@ func (d *ServiceBindingStatusDie) DieReleaseRawExtension() runtime.RawExtension
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ServiceBindingStatusDie) DieStamp(fn func(r *apisv1.ServiceBindingStatus)) *ServiceBindingStatusDie {
+func (d *ServiceBindingWorkloadStatusDie) DieStamp(fn func(r *apisv1.ServiceBindingWorkloadStatus)) *ServiceBindingWorkloadStatusDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -2909,8 +5375,8 @@ func (d *ServiceBindingStatusDie) DieStamp(fn func(r *apisv1.ServiceBindingStatu
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *ServiceBindingStatusDie) DieStampAt(jp string, fn interface{}) *ServiceBindingStatusDie {
-	return d.DieStamp(func(r *apisv1.ServiceBindingStatus) {
+func (d *ServiceBindingWorkloadStatusDie) DieStampAt(jp string, fn interface{}) *ServiceBindingWorkloadStatusDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingWorkloadStatus) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -2945,8 +5411,8 @@ func (d *ServiceBindingStatusDie) DieStampAt(jp string, fn interface{}) *Service
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *ServiceBindingStatusDie) DieWith(fns ...func(d *ServiceBindingStatusDie)) *ServiceBindingStatusDie {
-	nd := ServiceBindingStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *ServiceBindingWorkloadStatusDie) DieWith(fns ...func(d *ServiceBindingWorkloadStatusDie)) *ServiceBindingWorkloadStatusDie {
+	nd := ServiceBindingWorkloadStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -2956,9 +5422,9 @@ func (d *ServiceBindingStatusDie) DieWith(fns ...func(d *ServiceBindingStatusDie
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ServiceBindingStatusDie) DeepCopy() *ServiceBindingStatusDie {
+func (d *ServiceBindingWorkloadStatusDie) DeepCopy() *ServiceBindingWorkloadStatusDie {
 	r := *d.r.DeepCopy()
-	return &ServiceBindingStatusDie{
+	return &ServiceBindingWorkloadStatusDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -2966,12 +5432,12 @@ func (d *ServiceBindingStatusDie) DeepCopy() *ServiceBindingStatusDie {
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *ServiceBindingStatusDie) DieSeal() *ServiceBindingStatusDie {
+func (d *ServiceBindingWorkloadStatusDie) DieSeal() *ServiceBindingWorkloadStatusDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *ServiceBindingStatusDie) DieSealFeed(r apisv1.ServiceBindingStatus) *ServiceBindingStatusDie {
+func (d *ServiceBindingWorkloadStatusDie) DieSealFeed(r apisv1.ServiceBindingWorkloadStatus) *ServiceBindingWorkloadStatusDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -2980,90 +5446,100 @@ func (d *ServiceBindingStatusDie) DieSealFeed(r apisv1.ServiceBindingStatus) *Se
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *ServiceBindingStatusDie) DieSealFeedPtr(r *apisv1.ServiceBindingStatus) *ServiceBindingStatusDie {
+func (d *ServiceBindingWorkloadStatusDie) DieSealFeedPtr(r *apisv1.ServiceBindingWorkloadStatus) *ServiceBindingWorkloadStatusDie {
 	if r == nil {
-		r = &apisv1.ServiceBindingStatus{}
+		r = &apisv1.ServiceBindingWorkloadStatus{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *ServiceBindingStatusDie) DieSealRelease() apisv1.ServiceBindingStatus {
+func (d *ServiceBindingWorkloadStatusDie) DieSealRelease() apisv1.ServiceBindingWorkloadStatus {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *ServiceBindingStatusDie) DieSealReleasePtr() *apisv1.ServiceBindingStatus {
+func (d *ServiceBindingWorkloadStatusDie) DieSealReleasePtr() *apisv1.ServiceBindingWorkloadStatus {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *ServiceBindingStatusDie) DieDiff(opts ...cmp.Option) string {
+func (d *ServiceBindingWorkloadStatusDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *ServiceBindingStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *ServiceBindingWorkloadStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-// ConditionsDie replaces Conditions by collecting the released value from each die passed.
-//
-// Conditions are the conditions of this ServiceBinding
-func (d *ServiceBindingStatusDie) ConditionsDie(v ...*metav1.ConditionDie) *ServiceBindingStatusDie {
-	return d.DieStamp(func(r *apisv1.ServiceBindingStatus) {
-		r.Conditions = make([]apismetav1.Condition, len(v))
-		for i := range v {
-			r.Conditions[i] = v[i].DieRelease()
-		}
+// API version of the workload.
+func (d *ServiceBindingWorkloadStatusDie) APIVersion(v string) *ServiceBindingWorkloadStatusDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingWorkloadStatus) {
+		r.APIVersion = v
 	})
 }
 
-// BindingDie mutates Binding as a die.
-//
-// Binding exposes the projected secret for this ServiceBinding
-func (d *ServiceBindingStatusDie) BindingDie(fn func(d *ServiceBindingSecretReferenceDie)) *ServiceBindingStatusDie {
-	return d.DieStamp(func(r *apisv1.ServiceBindingStatus) {
-		d := ServiceBindingSecretReferenceBlank.DieImmutable(false).DieFeedPtr(r.Binding)
-		fn(d)
-		r.Binding = d.DieReleasePtr()
+// Kind of the workload.
+func (d *ServiceBindingWorkloadStatusDie) Kind(v string) *ServiceBindingWorkloadStatusDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingWorkloadStatus) {
+		r.Kind = v
 	})
 }
 
-// ObservedGeneration is the 'Generation' of the ServiceBinding that
-//
-// was last processed by the controller.
-func (d *ServiceBindingStatusDie) ObservedGeneration(v int64) *ServiceBindingStatusDie {
-	return d.DieStamp(func(r *apisv1.ServiceBindingStatus) {
+// Name of the workload.
+func (d *ServiceBindingWorkloadStatusDie) Name(v string) *ServiceBindingWorkloadStatusDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingWorkloadStatus) {
+		r.Name = v
+	})
+}
+
+// UID of the workload.
+func (d *ServiceBindingWorkloadStatusDie) UID(v types.UID) *ServiceBindingWorkloadStatusDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingWorkloadStatus) {
+		r.UID = v
+	})
+}
+
+// ObservedGeneration is the 'Generation' of the workload the binding was projected into.
+func (d *ServiceBindingWorkloadStatusDie) ObservedGeneration(v int64) *ServiceBindingWorkloadStatusDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingWorkloadStatus) {
 		r.ObservedGeneration = v
 	})
 }
 
-// Conditions are the conditions of this ServiceBinding
-func (d *ServiceBindingStatusDie) Conditions(v ...apismetav1.Condition) *ServiceBindingStatusDie {
-	return d.DieStamp(func(r *apisv1.ServiceBindingStatus) {
-		r.Conditions = v
+// Result of projecting the binding into the workload, one of Projected, Unprojected, Skipped, Failed or Pending.
+func (d *ServiceBindingWorkloadStatusDie) Result(v apisv1.ServiceBindingWorkloadResult) *ServiceBindingWorkloadStatusDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingWorkloadStatus) {
+		r.Result = v
 	})
 }
 
-// Binding exposes the projected secret for this ServiceBinding
-func (d *ServiceBindingStatusDie) Binding(v *apisv1.ServiceBindingSecretReference) *ServiceBindingStatusDie {
-	return d.DieStamp(func(r *apisv1.ServiceBindingStatus) {
-		r.Binding = v
+// Reason is a machine readable explanation of the result.
+func (d *ServiceBindingWorkloadStatusDie) Reason(v string) *ServiceBindingWorkloadStatusDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingWorkloadStatus) {
+		r.Reason = v
 	})
 }
 
-var ServiceBindingSecretReferenceBlank = (&ServiceBindingSecretReferenceDie{}).DieFeed(apisv1.ServiceBindingSecretReference{})
+// Message is a human readable explanation of the result.
+func (d *ServiceBindingWorkloadStatusDie) Message(v string) *ServiceBindingWorkloadStatusDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingWorkloadStatus) {
+		r.Message = v
+	})
+}
 
-type ServiceBindingSecretReferenceDie struct {
+var ServiceBindingRolloutStatusBlank = (&ServiceBindingRolloutStatusDie{}).DieFeed(apisv1.ServiceBindingRolloutStatus{})
+
+type ServiceBindingRolloutStatusDie struct {
 	mutable bool
-	r       apisv1.ServiceBindingSecretReference
-	seal    apisv1.ServiceBindingSecretReference
+	r       apisv1.ServiceBindingRolloutStatus
+	seal    apisv1.ServiceBindingRolloutStatus
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ServiceBindingSecretReferenceDie) DieImmutable(immutable bool) *ServiceBindingSecretReferenceDie {
+func (d *ServiceBindingRolloutStatusDie) DieImmutable(immutable bool) *ServiceBindingRolloutStatusDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -3073,12 +5549,12 @@ func (d *ServiceBindingSecretReferenceDie) DieImmutable(immutable bool) *Service
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ServiceBindingSecretReferenceDie) DieFeed(r apisv1.ServiceBindingSecretReference) *ServiceBindingSecretReferenceDie {
+func (d *ServiceBindingRolloutStatusDie) DieFeed(r apisv1.ServiceBindingRolloutStatus) *ServiceBindingRolloutStatusDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &ServiceBindingSecretReferenceDie{
+	return &ServiceBindingRolloutStatusDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -3086,15 +5562,15 @@ func (d *ServiceBindingSecretReferenceDie) DieFeed(r apisv1.ServiceBindingSecret
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ServiceBindingSecretReferenceDie) DieFeedPtr(r *apisv1.ServiceBindingSecretReference) *ServiceBindingSecretReferenceDie {
+func (d *ServiceBindingRolloutStatusDie) DieFeedPtr(r *apisv1.ServiceBindingRolloutStatus) *ServiceBindingRolloutStatusDie {
 	if r == nil {
-		r = &apisv1.ServiceBindingSecretReference{}
+		r = &apisv1.ServiceBindingRolloutStatus{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *ServiceBindingSecretReferenceDie) DieFeedDuck(v any) *ServiceBindingSecretReferenceDie {
+func (d *ServiceBindingRolloutStatusDie) DieFeedDuck(v any) *ServiceBindingRolloutStatusDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -3103,8 +5579,8 @@ func (d *ServiceBindingSecretReferenceDie) DieFeedDuck(v any) *ServiceBindingSec
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *ServiceBindingSecretReferenceDie) DieFeedJSON(j []byte) *ServiceBindingSecretReferenceDie {
-	r := apisv1.ServiceBindingSecretReference{}
+func (d *ServiceBindingRolloutStatusDie) DieFeedJSON(j []byte) *ServiceBindingRolloutStatusDie {
+	r := apisv1.ServiceBindingRolloutStatus{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -3112,8 +5588,8 @@ func (d *ServiceBindingSecretReferenceDie) DieFeedJSON(j []byte) *ServiceBinding
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *ServiceBindingSecretReferenceDie) DieFeedYAML(y []byte) *ServiceBindingSecretReferenceDie {
-	r := apisv1.ServiceBindingSecretReference{}
+func (d *ServiceBindingRolloutStatusDie) DieFeedYAML(y []byte) *ServiceBindingRolloutStatusDie {
+	r := apisv1.ServiceBindingRolloutStatus{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -3121,7 +5597,7 @@ func (d *ServiceBindingSecretReferenceDie) DieFeedYAML(y []byte) *ServiceBinding
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *ServiceBindingSecretReferenceDie) DieFeedYAMLFile(name string) *ServiceBindingSecretReferenceDie {
+func (d *ServiceBindingRolloutStatusDie) DieFeedYAMLFile(name string) *ServiceBindingRolloutStatusDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -3130,7 +5606,7 @@ func (d *ServiceBindingSecretReferenceDie) DieFeedYAMLFile(name string) *Service
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ServiceBindingSecretReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingSecretReferenceDie {
+func (d *ServiceBindingRolloutStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingRolloutStatusDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -3139,7 +5615,7 @@ func (d *ServiceBindingSecretReferenceDie) DieFeedRawExtension(raw runtime.RawEx
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ServiceBindingSecretReferenceDie) DieRelease() apisv1.ServiceBindingSecretReference {
+func (d *ServiceBindingRolloutStatusDie) DieRelease() apisv1.ServiceBindingRolloutStatus {
 	if d.mutable {
 		return d.r
 	}
@@ -3147,13 +5623,13 @@ func (d *ServiceBindingSecretReferenceDie) DieRelease() apisv1.ServiceBindingSec
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ServiceBindingSecretReferenceDie) DieReleasePtr() *apisv1.ServiceBindingSecretReference {
+func (d *ServiceBindingRolloutStatusDie) DieReleasePtr() *apisv1.ServiceBindingRolloutStatus {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *ServiceBindingSecretReferenceDie) DieReleaseDuck(v any) any {
+func (d *ServiceBindingRolloutStatusDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -3162,7 +5638,7 @@ func (d *ServiceBindingSecretReferenceDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *ServiceBindingSecretReferenceDie) DieReleaseJSON() []byte {
+func (d *ServiceBindingRolloutStatusDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -3172,7 +5648,7 @@ func (d *ServiceBindingSecretReferenceDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *ServiceBindingSecretReferenceDie) DieReleaseYAML() []byte {
+func (d *ServiceBindingRolloutStatusDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -3182,7 +5658,7 @@ func (d *ServiceBindingSecretReferenceDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ServiceBindingSecretReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ServiceBindingRolloutStatusDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -3192,7 +5668,7 @@ func (d *ServiceBindingSecretReferenceDie) DieReleaseRawExtension() runtime.RawE
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ServiceBindingSecretReferenceDie) DieStamp(fn func(r *apisv1.ServiceBindingSecretReference)) *ServiceBindingSecretReferenceDie {
+func (d *ServiceBindingRolloutStatusDie) DieStamp(fn func(r *apisv1.ServiceBindingRolloutStatus)) *ServiceBindingRolloutStatusDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -3201,8 +5677,8 @@ func (d *ServiceBindingSecretReferenceDie) DieStamp(fn func(r *apisv1.ServiceBin
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *ServiceBindingSecretReferenceDie) DieStampAt(jp string, fn interface{}) *ServiceBindingSecretReferenceDie {
-	return d.DieStamp(func(r *apisv1.ServiceBindingSecretReference) {
+func (d *ServiceBindingRolloutStatusDie) DieStampAt(jp string, fn interface{}) *ServiceBindingRolloutStatusDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingRolloutStatus) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -3237,8 +5713,8 @@ func (d *ServiceBindingSecretReferenceDie) DieStampAt(jp string, fn interface{})
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *ServiceBindingSecretReferenceDie) DieWith(fns ...func(d *ServiceBindingSecretReferenceDie)) *ServiceBindingSecretReferenceDie {
-	nd := ServiceBindingSecretReferenceBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *ServiceBindingRolloutStatusDie) DieWith(fns ...func(d *ServiceBindingRolloutStatusDie)) *ServiceBindingRolloutStatusDie {
+	nd := ServiceBindingRolloutStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -3248,9 +5724,9 @@ func (d *ServiceBindingSecretReferenceDie) DieWith(fns ...func(d *ServiceBinding
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ServiceBindingSecretReferenceDie) DeepCopy() *ServiceBindingSecretReferenceDie {
+func (d *ServiceBindingRolloutStatusDie) DeepCopy() *ServiceBindingRolloutStatusDie {
 	r := *d.r.DeepCopy()
-	return &ServiceBindingSecretReferenceDie{
+	return &ServiceBindingRolloutStatusDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -3258,12 +5734,12 @@ func (d *ServiceBindingSecretReferenceDie) DeepCopy() *ServiceBindingSecretRefer
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *ServiceBindingSecretReferenceDie) DieSeal() *ServiceBindingSecretReferenceDie {
+func (d *ServiceBindingRolloutStatusDie) DieSeal() *ServiceBindingRolloutStatusDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *ServiceBindingSecretReferenceDie) DieSealFeed(r apisv1.ServiceBindingSecretReference) *ServiceBindingSecretReferenceDie {
+func (d *ServiceBindingRolloutStatusDie) DieSealFeed(r apisv1.ServiceBindingRolloutStatus) *ServiceBindingRolloutStatusDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -3272,39 +5748,51 @@ func (d *ServiceBindingSecretReferenceDie) DieSealFeed(r apisv1.ServiceBindingSe
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *ServiceBindingSecretReferenceDie) DieSealFeedPtr(r *apisv1.ServiceBindingSecretReference) *ServiceBindingSecretReferenceDie {
+func (d *ServiceBindingRolloutStatusDie) DieSealFeedPtr(r *apisv1.ServiceBindingRolloutStatus) *ServiceBindingRolloutStatusDie {
 	if r == nil {
-		r = &apisv1.ServiceBindingSecretReference{}
+		r = &apisv1.ServiceBindingRolloutStatus{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *ServiceBindingSecretReferenceDie) DieSealRelease() apisv1.ServiceBindingSecretReference {
+func (d *ServiceBindingRolloutStatusDie) DieSealRelease() apisv1.ServiceBindingRolloutStatus {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *ServiceBindingSecretReferenceDie) DieSealReleasePtr() *apisv1.ServiceBindingSecretReference {
+func (d *ServiceBindingRolloutStatusDie) DieSealReleasePtr() *apisv1.ServiceBindingRolloutStatus {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *ServiceBindingSecretReferenceDie) DieDiff(opts ...cmp.Option) string {
+func (d *ServiceBindingRolloutStatusDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *ServiceBindingSecretReferenceDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *ServiceBindingRolloutStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-// Name of the referent secret.
-//
-// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
-func (d *ServiceBindingSecretReferenceDie) Name(v string) *ServiceBindingSecretReferenceDie {
-	return d.DieStamp(func(r *apisv1.ServiceBindingSecretReference) {
-		r.Name = v
+// UpdatedWorkloads is the number of workloads the binding is rolled out to
+func (d *ServiceBindingRolloutStatusDie) UpdatedWorkloads(v int32) *ServiceBindingRolloutStatusDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingRolloutStatus) {
+		r.UpdatedWorkloads = v
+	})
+}
+
+// ReadyWorkloads is the number of updated workloads that completed their rollout
+func (d *ServiceBindingRolloutStatusDie) ReadyWorkloads(v int32) *ServiceBindingRolloutStatusDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingRolloutStatus) {
+		r.ReadyWorkloads = v
+	})
+}
+
+// PendingWorkloads is the number of workloads waiting for the binding to be rolled out to them
+func (d *ServiceBindingRolloutStatusDie) PendingWorkloads(v int32) *ServiceBindingRolloutStatusDie {
+	return d.DieStamp(func(r *apisv1.ServiceBindingRolloutStatus) {
+		r.PendingWorkloads = v
 	})
 }
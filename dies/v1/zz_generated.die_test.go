@@ -27,6 +27,42 @@ import (
 	testing "reconciler.io/dies/testing"
 )
 
+func TestClusterServiceBindingDie_MissingMethods(t *testingx.T) {
+	die := ClusterServiceBindingBlank
+	ignore := []string{"TypeMeta", "ObjectMeta"}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterServiceBindingDie: %s", diff.List())
+	}
+}
+
+func TestClusterServiceBindingSpecDie_MissingMethods(t *testingx.T) {
+	die := ClusterServiceBindingSpecBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterServiceBindingSpecDie: %s", diff.List())
+	}
+}
+
+func TestClusterServiceBindingStatusDie_MissingMethods(t *testingx.T) {
+	die := ClusterServiceBindingStatusBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterServiceBindingStatusDie: %s", diff.List())
+	}
+}
+
+func TestClusterServiceBindingNamespaceStatusDie_MissingMethods(t *testingx.T) {
+	die := ClusterServiceBindingNamespaceStatusBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterServiceBindingNamespaceStatusDie: %s", diff.List())
+	}
+}
+
 func TestClusterWorkloadResourceMappingDie_MissingMethods(t *testingx.T) {
 	die := ClusterWorkloadResourceMappingBlank
 	ignore := []string{"TypeMeta", "ObjectMeta"}
@@ -90,6 +126,15 @@ func TestServiceBindingWorkloadReferenceDie_MissingMethods(t *testingx.T) {
 	}
 }
 
+func TestServiceBindingWorkloadOwnerReferenceDie_MissingMethods(t *testingx.T) {
+	die := ServiceBindingWorkloadOwnerReferenceBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ServiceBindingWorkloadOwnerReferenceDie: %s", diff.List())
+	}
+}
+
 func TestServiceBindingServiceReferenceDie_MissingMethods(t *testingx.T) {
 	die := ServiceBindingServiceReferenceBlank
 	ignore := []string{}
@@ -108,6 +153,15 @@ func TestEnvMappingDie_MissingMethods(t *testingx.T) {
 	}
 }
 
+func TestServiceBindingRolloutDie_MissingMethods(t *testingx.T) {
+	die := ServiceBindingRolloutBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ServiceBindingRolloutDie: %s", diff.List())
+	}
+}
+
 func TestServiceBindingStatusDie_MissingMethods(t *testingx.T) {
 	die := ServiceBindingStatusBlank
 	ignore := []string{}
@@ -125,3 +179,21 @@ func TestServiceBindingSecretReferenceDie_MissingMethods(t *testingx.T) {
 		t.Errorf("found missing fields for ServiceBindingSecretReferenceDie: %s", diff.List())
 	}
 }
+
+func TestServiceBindingWorkloadStatusDie_MissingMethods(t *testingx.T) {
+	die := ServiceBindingWorkloadStatusBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ServiceBindingWorkloadStatusDie: %s", diff.List())
+	}
+}
+
+func TestServiceBindingRolloutStatusDie_MissingMethods(t *testingx.T) {
+	die := ServiceBindingRolloutStatusBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ServiceBindingRolloutStatusDie: %s", diff.List())
+	}
+}
@@ -27,6 +27,10 @@ import (
 	"github.com/servicebinding/runtime/resolver"
 )
 
+// DefaultWorkloadStatusLimit is the number of workloads reported in a
+// ServiceBinding's status when ServiceBindingHooks.WorkloadStatusLimit is not set.
+const DefaultWorkloadStatusLimit = 100
+
 type ServiceBindingHooks struct {
 	// ResolverFactory returns a resolver which is used to lookup binding
 	// related values.
@@ -34,6 +38,13 @@ type ServiceBindingHooks struct {
 	// +optional
 	ResolverFactory func(client.Client) resolver.Resolver
 
+	// ResolverStrategies return strategies that are consulted, in order,
+	// before the resolver returned by the ResolverFactory. Service and
+	// workload kinds not handled by a strategy fall through to that resolver.
+	//
+	// +optional
+	ResolverStrategies []func(client.Client) resolver.Strategy
+
 	// ProjectorFactory returns a projector which is used to bind/unbind the
 	// service to/from the workload.
 	//
@@ -63,13 +74,38 @@ type ServiceBindingHooks struct {
 	//
 	// +optional
 	WorkloadPostProjection func(ctx context.Context, workload runtime.Object) error
+
+	// WorkloadStatusLimit caps the number of workloads reported in the
+	// ServiceBinding's status. Defaults to DefaultWorkloadStatusLimit.
+	//
+	// +optional
+	WorkloadStatusLimit int
+
+	// WorkloadConcurrency is the number of workloads of a ServiceBinding that
+	// are projected and patched concurrently. The WorkloadPreProjection and
+	// WorkloadPostProjection hooks are never called concurrently, the
+	// projector must be safe for concurrent use when greater than one.
+	// Defaults to one, processing the workloads one after another.
+	//
+	// +optional
+	WorkloadConcurrency int
 }
 
 func (h *ServiceBindingHooks) GetResolver(c client.Client) resolver.Resolver {
+	var r resolver.Resolver
 	if h.ResolverFactory == nil {
-		return resolver.New(c)
+		r = resolver.New(c)
+	} else {
+		r = h.ResolverFactory(c)
+	}
+	if len(h.ResolverStrategies) == 0 {
+		return r
+	}
+	strategies := make([]resolver.Strategy, len(h.ResolverStrategies))
+	for i, f := range h.ResolverStrategies {
+		strategies[i] = f(c)
 	}
-	return h.ResolverFactory(c)
+	return resolver.NewChain(r, strategies...)
 }
 
 func (h *ServiceBindingHooks) GetProjector(r projector.MappingSource) projector.ServiceBindingProjector {
@@ -78,3 +114,17 @@ func (h *ServiceBindingHooks) GetProjector(r projector.MappingSource) projector.
 	}
 	return h.ProjectorFactory(r)
 }
+
+func (h *ServiceBindingHooks) GetWorkloadStatusLimit() int {
+	if h.WorkloadStatusLimit <= 0 {
+		return DefaultWorkloadStatusLimit
+	}
+	return h.WorkloadStatusLimit
+}
+
+func (h *ServiceBindingHooks) GetWorkloadConcurrency() int {
+	if h.WorkloadConcurrency <= 0 {
+		return 1
+	}
+	return h.WorkloadConcurrency
+}
@@ -77,9 +77,12 @@ func TestServiceBindingHooks(t *testing.T) {
 			d.BindingDie(func(d *dieservicebindingv1.ServiceBindingSecretReferenceDie) {
 				d.Name(secretName)
 			})
+			d.Type("mysql")
 			d.ConditionsDie(
 				dieservicebindingv1.ServiceBindingConditionReady.True().Reason("ServiceBound"),
+				dieservicebindingv1.ServiceBindingConditionSecretValid.True().Reason("SecretValid"),
 				dieservicebindingv1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+				dieservicebindingv1.ServiceBindingConditionTypeResolved.True().Reason("TypeResolved"),
 				dieservicebindingv1.ServiceBindingConditionWorkloadProjected.True().Reason("ResolvedBindingSecret"),
 			)
 		})
@@ -102,6 +105,15 @@ func TestServiceBindingHooks(t *testing.T) {
 			})
 		})
 
+	secret := diecorev1.SecretBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(secretName)
+		}).
+		Data(map[string][]byte{
+			"type": []byte("mysql"),
+		})
+
 	workload := dieappsv1.DeploymentBlank.
 		APIVersion("apps/v1").
 		Kind("Deployment").
@@ -149,6 +161,11 @@ func TestServiceBindingHooks(t *testing.T) {
 				d.AddLabel("test.servicebinding.io", "workload")
 			})
 
+		workloadStatus := dieservicebindingv1.ServiceBindingWorkloadStatusBlank.
+			APIVersion("apps/v1").
+			Kind("Deployment").
+			Result(servicebindingv1.ServiceBindingWorkloadUnprojected)
+
 		rts := rtesting.SubReconcilerTests[*servicebindingv1.ServiceBinding]{
 			"controller binding by name": {
 				Metadata: map[string]interface{}{
@@ -167,9 +184,19 @@ func TestServiceBindingHooks(t *testing.T) {
 				},
 				Resource: serviceBindingByName.DieReleasePtr(),
 				GivenObjects: []client.Object{
+					secret.DieReleasePtr(),
 					workload.DieReleasePtr(),
 				},
+				ExpectResource: serviceBindingByName.
+					StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+						// the mock projector does not project the workload
+						d.WorkloadsDie(
+							workloadStatus.Name(workload.GetName()).UID(workload.GetUID()),
+						)
+					}).
+					DieReleasePtr(),
 				ExpectTracks: []rtesting.TrackRequest{
+					rtesting.NewTrackRequest(secret, serviceBinding, scheme),
 					rtesting.NewTrackRequest(workload, serviceBinding, scheme),
 				},
 			},
@@ -193,10 +220,21 @@ func TestServiceBindingHooks(t *testing.T) {
 				},
 				Resource: serviceBindingBySelector.DieReleasePtr(),
 				GivenObjects: []client.Object{
+					secret.DieReleasePtr(),
 					workload1.DieReleasePtr(),
 					workload2.DieReleasePtr(),
 				},
+				ExpectResource: serviceBindingBySelector.
+					StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
+						// the mock projector does not project the workloads
+						d.WorkloadsDie(
+							workloadStatus.Name(workload1.GetName()).UID(workload1.GetUID()),
+							workloadStatus.Name(workload2.GetName()).UID(workload2.GetUID()),
+						)
+					}).
+					DieReleasePtr(),
 				ExpectTracks: []rtesting.TrackRequest{
+					rtesting.NewTrackRequest(secret, serviceBinding, scheme),
 					{
 						Tracker: types.NamespacedName{Namespace: namespace, Name: name},
 						TrackedReference: tracker.Reference{
@@ -292,6 +330,11 @@ func (p *mockProjector) Unproject(ctx context.Context, binding *servicebindingv1
 	return p.m.MethodCalled("Projector.Unproject", *p.i, ctx, binding, workload).Error(0)
 }
 
+func (p *mockProjector) Orphan(ctx context.Context, binding *servicebindingv1.ServiceBinding, workload runtime.Object) error {
+	*p.i = *p.i + 1
+	return p.m.MethodCalled("Projector.Orphan", *p.i, ctx, binding, workload).Error(0)
+}
+
 func (p *mockProjector) IsProjected(ctx context.Context, binding *servicebindingv1.ServiceBinding, workload runtime.Object) bool {
 	annotations := workload.(metav1.Object).GetAnnotations()
 	if len(annotations) == 0 {
@@ -87,6 +87,16 @@ func TestMigrationHooks_Controller(t *testing.T) {
 			})
 		})
 
+	secret := diecorev1.SecretBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(secretName)
+		}).
+		Data(map[string][]byte{
+			"type":     []byte("mysql"),
+			"password": []byte("secret"),
+		})
+
 	workloadMapping := dieservicebindingv1.ClusterWorkloadResourceMappingBlank.
 		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
 			d.Name("deployments.apps")
@@ -213,6 +223,12 @@ func TestMigrationHooks_Controller(t *testing.T) {
 			})
 		})
 
+	workloadStatus := dieservicebindingv1.ServiceBindingWorkloadStatusBlank.
+		APIVersion("apps/v1").
+		Kind("Deployment").
+		Name(workload.GetName()).
+		Result(servicebindingv1.ServiceBindingWorkloadProjected)
+
 	rts := rtesting.ReconcilerTests{
 		"in sync": {
 			Request: request,
@@ -227,16 +243,23 @@ func TestMigrationHooks_Controller(t *testing.T) {
 					StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
 						d.ConditionsDie(
 							dieservicebindingv1.ServiceBindingConditionReady.True().Reason("ServiceBound"),
+							dieservicebindingv1.ServiceBindingConditionSecretValid.True().Reason("SecretValid"),
 							dieservicebindingv1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+							dieservicebindingv1.ServiceBindingConditionTypeResolved.True().Reason("TypeResolved"),
 							dieservicebindingv1.ServiceBindingConditionWorkloadProjected.True().Reason("WorkloadProjected"),
 						)
 						d.BindingDie(func(d *dieservicebindingv1.ServiceBindingSecretReferenceDie) {
 							d.Name(secretName)
 						})
+						d.Type("mysql")
+						d.WorkloadsDie(workloadStatus)
+						d.BoundWorkloads(1)
 					}),
+				secret,
 				projectedWorkload,
 			},
 			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
 				rtesting.NewTrackRequest(projectedWorkload, serviceBinding, scheme),
 				rtesting.NewTrackRequest(workloadMapping, serviceBinding, scheme),
 			},
@@ -258,10 +281,12 @@ func TestMigrationHooks_Controller(t *testing.T) {
 							d.Name(secretName)
 						})
 					}),
+				secret,
 				vmwareServiceBindingProjection,
 				vmwareWorkload,
 			},
 			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
 				rtesting.NewTrackRequest(projectedWorkload, serviceBinding, scheme),
 				rtesting.NewTrackRequest(workloadMapping, serviceBinding, scheme),
 			},
@@ -289,12 +314,17 @@ func TestMigrationHooks_Controller(t *testing.T) {
 					StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
 						d.ConditionsDie(
 							dieservicebindingv1.ServiceBindingConditionReady.True().Reason("ServiceBound"),
+							dieservicebindingv1.ServiceBindingConditionSecretValid.True().Reason("SecretValid"),
 							dieservicebindingv1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+							dieservicebindingv1.ServiceBindingConditionTypeResolved.True().Reason("TypeResolved"),
 							dieservicebindingv1.ServiceBindingConditionWorkloadProjected.True().Reason("WorkloadProjected"),
 						)
 						d.BindingDie(func(d *dieservicebindingv1.ServiceBindingSecretReferenceDie) {
 							d.Name(secretName)
 						})
+						d.Type("mysql")
+						d.WorkloadsDie(workloadStatus)
+						d.BoundWorkloads(1)
 					}),
 			},
 		},
@@ -328,6 +358,7 @@ func TestMigrationHooks_Controller(t *testing.T) {
 							d.Name(secretName)
 						})
 					}),
+				secret,
 				vmwareServiceBindingProjection,
 				vmwareWorkload.
 					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
@@ -404,6 +435,7 @@ func TestMigrationHooks_Controller(t *testing.T) {
 					}),
 			},
 			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
 				rtesting.NewTrackRequest(projectedWorkload, serviceBinding, scheme),
 				rtesting.NewTrackRequest(workloadMapping, serviceBinding, scheme),
 			},
@@ -500,12 +532,18 @@ func TestMigrationHooks_Controller(t *testing.T) {
 					StatusDie(func(d *dieservicebindingv1.ServiceBindingStatusDie) {
 						d.ConditionsDie(
 							dieservicebindingv1.ServiceBindingConditionReady.True().Reason("ServiceBound"),
+							dieservicebindingv1.ServiceBindingConditionSecretValid.True().Reason("SecretValid"),
 							dieservicebindingv1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+							dieservicebindingv1.ServiceBindingConditionTypeResolved.True().Reason("TypeResolved"),
 							dieservicebindingv1.ServiceBindingConditionWorkloadProjected.True().Reason("WorkloadProjected"),
 						)
 						d.BindingDie(func(d *dieservicebindingv1.ServiceBindingSecretReferenceDie) {
 							d.Name(secretName)
 						})
+						d.Type("overridden-type")
+						d.Provider("overridden-provider")
+						d.WorkloadsDie(workloadStatus)
+						d.BoundWorkloads(1)
 					}),
 			},
 		},
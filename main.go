@@ -19,19 +19,27 @@ package main
 import (
 	"crypto/tls"
 	"flag"
+	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/metadata"
 	"reconciler.io/runtime/reconcilers"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
@@ -44,6 +52,7 @@ import (
 	"github.com/servicebinding/runtime/lifecycle"
 	"github.com/servicebinding/runtime/lifecycle/vmware"
 	"github.com/servicebinding/runtime/rbac"
+	"github.com/servicebinding/runtime/resolver"
 	//+kubebuilder:scaffold:imports
 )
 
@@ -66,11 +75,56 @@ func disableHTTP2(t *tls.Config) {
 	t.NextProtos = []string{"http/1.1"}
 }
 
+// envOrDefault returns the value of the environment variable, or the default value when the variable is not set
+func envOrDefault(key, value string) string {
+	if v, ok := os.LookupEnv(key); ok {
+		return v
+	}
+	return value
+}
+
+// webhookOptions parses the failure policy of the webhook options, an empty failure policy is left as configured
+func webhookOptions(name, webhookName, failurePolicy string) (controllers.WebhookOptions, error) {
+	opts := controllers.WebhookOptions{
+		Name:        name,
+		WebhookName: webhookName,
+	}
+	switch policy := admissionregistrationv1.FailurePolicyType(failurePolicy); policy {
+	case "":
+	case admissionregistrationv1.Fail, admissionregistrationv1.Ignore:
+		opts.FailurePolicy = &policy
+	default:
+		return opts, fmt.Errorf("invalid failure policy %q, expected %s or %s", failurePolicy, admissionregistrationv1.Fail, admissionregistrationv1.Ignore)
+	}
+	return opts, nil
+}
+
 func main() {
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
 	var migrateFromVMware bool
+	var cacheWorkloadMappings bool
+	var annotatedWorkloads string
+	var workloadStatusLimit int
+	var workloadConcurrency int
+	var triggerMode string
+	var triggerQPS float64
+	var triggerBurst int
+	var admissionProjectorWebhookConfiguration string
+	var admissionProjectorWebhook string
+	var admissionProjectorFailurePolicy string
+	var triggerWebhookConfiguration string
+	var triggerWebhook string
+	var triggerFailurePolicy string
+	var admissionProjectorMode string
+	var projectionGuard string
+	var projectionGuardWebhookConfiguration string
+	var projectionGuardWebhook string
+	var podProjection bool
+	var schedulingGate bool
+	var schedulingGateTimeout time.Duration
+	var readinessGate bool
 	var leaseDuration time.Duration
 	var renewDeadline time.Duration
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
@@ -80,6 +134,57 @@ func main() {
 			"Enabling this will ensure there is only one active controller manager.")
 	flag.BoolVar(&migrateFromVMware, "migrate-from-vmware", false,
 		"Enable migration from the VMware implementation.")
+	flag.BoolVar(&cacheWorkloadMappings, "cache-workload-mappings", true,
+		"Cache defaulted ClusterWorkloadResourceMappings, invalidated as the resources change.")
+	flag.StringVar(&annotatedWorkloads, "annotated-workloads", "",
+		"Comma separated <apiVersion>/<kind> workloads that generate ServiceBindings from the servicebinding.io/services annotation, "+
+			"for example apps/v1/Deployment. Disabled when empty.")
+	flag.IntVar(&workloadStatusLimit, "workload-status-limit", lifecycle.DefaultWorkloadStatusLimit,
+		"The maximum number of workloads reported in a ServiceBinding's status.")
+	flag.IntVar(&workloadConcurrency, "workload-concurrency", 1,
+		"The number of workloads of a ServiceBinding that are projected and updated concurrently.")
+	flag.StringVar(&triggerMode, "trigger-mode", "webhook",
+		"How changes to services and workloads trigger ServiceBindings, one of webhook or informer. "+
+			"The informer mode watches the resources with metadata informers and does not need the API server to reach the trigger webhook.")
+	flag.Float64Var(&triggerQPS, "trigger-qps", 10,
+		"The number of ServiceBindings per second the trigger webhook enqueues for reconciliation after the initial burst.")
+	flag.IntVar(&triggerBurst, "trigger-burst", 100,
+		"The number of ServiceBindings the trigger webhook enqueues for reconciliation at once.")
+	flag.StringVar(&admissionProjectorWebhookConfiguration, "admission-projector-webhook-configuration", envOrDefault("ADMISSION_PROJECTOR_WEBHOOK_CONFIGURATION", "servicebinding-admission-projector"),
+		"The name of the MutatingWebhookConfiguration the admission projector rules are managed in.")
+	flag.StringVar(&admissionProjectorWebhook, "admission-projector-webhook", envOrDefault("ADMISSION_PROJECTOR_WEBHOOK", "interceptor.servicebinding.io"),
+		"The name of the webhook entry within the MutatingWebhookConfiguration to manage. "+
+			"Set to an empty value to manage a configuration with a single entry.")
+	flag.StringVar(&admissionProjectorFailurePolicy, "admission-projector-failure-policy", envOrDefault("ADMISSION_PROJECTOR_FAILURE_POLICY", ""),
+		"The failure policy of the admission projector webhook entry, one of Fail or Ignore. Set to an empty value to leave the policy as configured.")
+	flag.StringVar(&admissionProjectorMode, "admission-projector-mode", envOrDefault("ADMISSION_PROJECTOR_MODE", string(controllers.AdmissionModeEnforce)),
+		"Whether the admission projector mutates workloads, one of enforce or audit. "+
+			"The audit mode describes the projection with admission warnings and audit annotations without changing the workload. "+
+			"Namespaces labeled with "+controllers.AdmissionModeLabelKey+" override the mode for their workloads.")
+	flag.StringVar(&triggerWebhookConfiguration, "trigger-webhook-configuration", envOrDefault("TRIGGER_WEBHOOK_CONFIGURATION", "servicebinding-trigger"),
+		"The name of the ValidatingWebhookConfiguration the trigger rules are managed in.")
+	flag.StringVar(&triggerWebhook, "trigger-webhook", envOrDefault("TRIGGER_WEBHOOK", "trigger.servicebinding.io"),
+		"The name of the webhook entry within the ValidatingWebhookConfiguration to manage. "+
+			"Set to an empty value to manage a configuration with a single entry.")
+	flag.StringVar(&triggerFailurePolicy, "trigger-failure-policy", envOrDefault("TRIGGER_FAILURE_POLICY", ""),
+		"The failure policy of the trigger webhook entry, one of Fail or Ignore. Set to an empty value to leave the policy as configured.")
+	flag.StringVar(&projectionGuard, "projection-guard", envOrDefault("PROJECTION_GUARD", string(controllers.ProjectionGuardOff)),
+		"How workload updates that remove the projection of an active ServiceBinding are handled, one of off, warn or deny.")
+	flag.StringVar(&projectionGuardWebhookConfiguration, "projection-guard-webhook-configuration", envOrDefault("PROJECTION_GUARD_WEBHOOK_CONFIGURATION", "servicebinding-projection-guard"),
+		"The name of the ValidatingWebhookConfiguration the projection guard rules are managed in.")
+	flag.StringVar(&projectionGuardWebhook, "projection-guard-webhook", envOrDefault("PROJECTION_GUARD_WEBHOOK", "guard.servicebinding.io"),
+		"The name of the webhook entry within the projection guard ValidatingWebhookConfiguration to manage. "+
+			"Set to an empty value to manage a configuration with a single entry.")
+	flag.BoolVar(&podProjection, "pod-projection", envOrDefault("POD_PROJECTION", "false") == "true",
+		"Project bindings into the pods of workloads annotated with "+controllers.ProjectionAnnotationKey+"="+controllers.ProjectionPod+" as the pods are created, "+
+			"leaving the workload untouched.")
+	flag.BoolVar(&schedulingGate, "scheduling-gate", envOrDefault("SCHEDULING_GATE", "false") == "true",
+		"Hold pods created with bindings that are not ready with the "+controllers.SchedulingGateName+" scheduling gate until the bindings are ready.")
+	flag.DurationVar(&schedulingGateTimeout, "scheduling-gate-timeout", 5*time.Minute,
+		"How long after a pod is created the scheduling gate is removed even though its bindings are not ready.")
+	flag.BoolVar(&readinessGate, "readiness-gate", envOrDefault("READINESS_GATE", "false") == "true",
+		"Add the "+string(controllers.ReadinessGateConditionType)+" readiness gate to pods created with projected bindings, "+
+			"true only while every binding is ready and binds the Secret the pod references.")
 	// Default values taken from: https://github.com/kubernetes-sigs/controller-runtime/blob/b88ed7a3602b85b6cfd0acfe9c25033b978bdb83/pkg/manager/internal.go#L52-L53
 	flag.DurationVar(&leaseDuration, "leader-elect-lease-duration", 15*time.Second, "configure leader election lease duration")
 	flag.DurationVar(&renewDeadline, "leader-elect-renew-deadline", 10*time.Second, "configure leader election renew deadline")
@@ -91,6 +196,18 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	cacheOpts := cache.Options{
+		SyncPeriod: &syncPeriod,
+	}
+	if schedulingGate || readinessGate {
+		// the gate controllers only reconcile the pods labeled by the admission projector, other pods are not cached
+		cacheOpts.ByObject = map[client.Object]cache.ByObject{
+			&corev1.Pod{}: {
+				Label: labels.SelectorFromSet(labels.Set{controllers.GatedPodLabelKey: "true"}),
+			},
+		}
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme: scheme,
 		Metrics: server.Options{
@@ -103,9 +220,7 @@ func main() {
 				TLSOpts: []func(*tls.Config){disableHTTP2},
 			},
 		},
-		Cache: cache.Options{
-			SyncPeriod: &syncPeriod,
-		},
+		Cache:                  cacheOpts,
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       "a359ffaf.servicebinding.io",
@@ -121,7 +236,20 @@ func main() {
 	config := reconcilers.NewConfig(mgr, &servicebindingv1.ServiceBinding{}, syncPeriod)
 	accessChecker := rbac.NewAccessChecker(config, 5*time.Minute)
 
-	hooks := lifecycle.ServiceBindingHooks{}
+	hooks := lifecycle.ServiceBindingHooks{
+		WorkloadStatusLimit: workloadStatusLimit,
+		WorkloadConcurrency: workloadConcurrency,
+	}
+	if cacheWorkloadMappings {
+		mappingCache := resolver.NewMappingCache()
+		if err := mappingCache.SetupWithManager(ctx, mgr); err != nil {
+			setupLog.Error(err, "unable to set up workload mapping cache")
+			os.Exit(1)
+		}
+		hooks.ResolverFactory = func(c client.Client) resolver.Resolver {
+			return mappingCache.Resolver(resolver.New(c))
+		}
+	}
 	if migrateFromVMware {
 		setupLog.Info("Enabling VMware migration hooks.")
 		setupLog.Info("Use migration hooks only while migrating implementations. Leaving migration hooks on permanently incurs a performance penalty.")
@@ -129,6 +257,9 @@ func main() {
 	}
 	// add additional migration hooks here
 
+	// resolver strategies are consulted before the default cluster resolver, for example:
+	//   hooks.ResolverStrategies = append(hooks.ResolverStrategies, func(c client.Client) resolver.Strategy { ... })
+
 	serviceBindingController, err := controllers.ServiceBindingReconciler(
 		config,
 		hooks,
@@ -141,32 +272,158 @@ func main() {
 		setupLog.Error(err, "unable to create webhook", "webhook", "ServiceBinding v1")
 		os.Exit(1)
 	}
+	clusterServiceBindingController, err := controllers.ClusterServiceBindingReconciler(
+		config,
+		hooks,
+	).SetupWithManagerYieldingController(ctx, mgr)
+	if err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ClusterServiceBinding")
+		os.Exit(1)
+	}
+	if err = (&servicebindingv1.ClusterServiceBinding{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "ClusterServiceBinding v1")
+		os.Exit(1)
+	}
 	if err = (&servicebindingv1.ClusterWorkloadResourceMapping{}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "ClusterWorkloadResourceMapping v1")
 		os.Exit(1)
 	}
 
+	for _, workload := range strings.Split(annotatedWorkloads, ",") {
+		workload = strings.TrimSpace(workload)
+		if workload == "" {
+			continue
+		}
+		i := strings.LastIndex(workload, "/")
+		gv, err := schema.ParseGroupVersion(workload[:max(i, 0)])
+		if i < 0 || err != nil {
+			setupLog.Error(err, "invalid annotated workload, expected <apiVersion>/<kind>", "workload", workload)
+			os.Exit(1)
+		}
+		if err = controllers.WorkloadServicesReconciler(
+			config,
+			gv.WithKind(workload[i+1:]),
+		).SetupWithManager(ctx, mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "WorkloadServices", "workload", workload)
+			os.Exit(1)
+		}
+	}
+
+	podAdmission := controllers.PodAdmission{
+		Projection:     podProjection,
+		SchedulingGate: schedulingGate,
+		ReadinessGate:  readinessGate,
+	}
+	if schedulingGate {
+		if err = controllers.SchedulingGateReconciler(
+			config,
+			schedulingGateTimeout,
+		).SetupWithManager(ctx, mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "SchedulingGate")
+			os.Exit(1)
+		}
+	}
+	if readinessGate {
+		if err = controllers.ReadinessGateReconciler(
+			config,
+		).SetupWithManager(ctx, mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "ReadinessGate")
+			os.Exit(1)
+		}
+	}
+
+	// the webhook reconcilers share the kinds that are not served by the API server
+	unmappedKinds := controllers.NewUnmappedKinds()
+	admissionProjectorOpts, err := webhookOptions(admissionProjectorWebhookConfiguration, admissionProjectorWebhook, admissionProjectorFailurePolicy)
+	if err != nil {
+		setupLog.Error(err, "invalid webhook options", "webhook", "AdmissionProjector")
+		os.Exit(1)
+	}
 	if err = controllers.AdmissionProjectorReconciler(
 		config,
-		// TODO inject from env
-		"servicebinding-admission-projector",
+		admissionProjectorOpts,
 		accessChecker.WithVerb("update"),
+		unmappedKinds,
+		podAdmission,
 	).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AdmissionProjector")
 		os.Exit(1)
 	}
-	mgr.GetWebhookServer().Register("/interceptor", controllers.AdmissionProjectorWebhook(config, hooks).Build())
+	switch controllers.AdmissionMode(admissionProjectorMode) {
+	case controllers.AdmissionModeEnforce, controllers.AdmissionModeAudit:
+	default:
+		setupLog.Error(nil, "invalid admission projector mode, expected enforce or audit", "mode", admissionProjectorMode)
+		os.Exit(1)
+	}
+	mgr.GetWebhookServer().Register("/interceptor", controllers.AdmissionProjectorWebhook(config, hooks, controllers.AdmissionMode(admissionProjectorMode), podAdmission).Build())
 
+	// triggered requests are fed to the binding controllers through a source each controller watches
+	triggerQueue := controllers.NewTriggerQueue(triggerQPS, triggerBurst)
+	if err = serviceBindingController.Watch(triggerQueue.ServiceBindingSource()); err != nil {
+		setupLog.Error(err, "unable to watch trigger", "controller", "ServiceBinding")
+		os.Exit(1)
+	}
+	if err = clusterServiceBindingController.Watch(triggerQueue.ClusterServiceBindingSource()); err != nil {
+		setupLog.Error(err, "unable to watch trigger", "controller", "ClusterServiceBinding")
+		os.Exit(1)
+	}
+	var triggerInformers *controllers.TriggerInformers
+	triggerAccessChecker := accessChecker.WithVerb("get")
+	switch triggerMode {
+	case "webhook":
+		mgr.GetWebhookServer().Register("/trigger", controllers.TriggerWebhook(config, triggerQueue).Build())
+	case "informer":
+		metadataClient, err := metadata.NewForConfig(mgr.GetConfig())
+		if err != nil {
+			setupLog.Error(err, "unable to create metadata client")
+			os.Exit(1)
+		}
+		triggerInformers = controllers.NewTriggerInformers(metadataClient, config.Tracker, triggerQueue)
+		if err := mgr.Add(triggerInformers); err != nil {
+			setupLog.Error(err, "unable to set up trigger informers")
+			os.Exit(1)
+		}
+		triggerAccessChecker = accessChecker.WithVerb("watch")
+	default:
+		setupLog.Error(nil, "invalid trigger mode, expected webhook or informer", "mode", triggerMode)
+		os.Exit(1)
+	}
+	triggerOpts, err := webhookOptions(triggerWebhookConfiguration, triggerWebhook, triggerFailurePolicy)
+	if err != nil {
+		setupLog.Error(err, "invalid webhook options", "webhook", "Trigger")
+		os.Exit(1)
+	}
 	if err = controllers.TriggerReconciler(
 		config,
-		// TODO inject from env
-		"servicebinding-trigger",
-		accessChecker.WithVerb("get"),
+		triggerOpts,
+		triggerAccessChecker,
+		unmappedKinds,
+		triggerInformers,
 	).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Trigger")
 		os.Exit(1)
 	}
-	mgr.GetWebhookServer().Register("/trigger", controllers.TriggerWebhook(config, serviceBindingController).Build())
+
+	switch controllers.ProjectionGuardMode(projectionGuard) {
+	case controllers.ProjectionGuardOff, controllers.ProjectionGuardWarn, controllers.ProjectionGuardDeny:
+	default:
+		setupLog.Error(nil, "invalid projection guard, expected off, warn or deny", "mode", projectionGuard)
+		os.Exit(1)
+	}
+	if err = controllers.ProjectionGuardReconciler(
+		config,
+		controllers.WebhookOptions{
+			Name:        projectionGuardWebhookConfiguration,
+			WebhookName: projectionGuardWebhook,
+		},
+		controllers.ProjectionGuardMode(projectionGuard),
+		accessChecker.WithVerb("update"),
+		unmappedKinds,
+	).SetupWithManager(ctx, mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ProjectionGuard")
+		os.Exit(1)
+	}
+	mgr.GetWebhookServer().Register("/guard", controllers.ProjectionGuardWebhook(config, hooks, controllers.ProjectionGuardMode(projectionGuard)).Build())
 
 	//+kubebuilder:scaffold:builder
 
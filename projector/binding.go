@@ -29,6 +29,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 
@@ -43,10 +44,13 @@ const (
 	TypeAnnotationPrefix     = Group + "/type-"
 	ProviderAnnotationPrefix = Group + "/provider-"
 	MappingAnnotationPrefix  = Group + "/mapping-"
+	OrphanedAnnotationPrefix = Group + "/orphaned-"
+	AdoptedAnnotationPrefix  = Group + "/adopted-"
 	VolumeDefaultMode        = int32(0644)
 )
 
 var _ ServiceBindingProjector = (*serviceBindingProjector)(nil)
+var _ Orphaner = (*serviceBindingProjector)(nil)
 
 type serviceBindingProjector struct {
 	mappingSource MappingSource
@@ -66,6 +70,12 @@ func (p *serviceBindingProjector) Project(ctx context.Context, binding *serviceb
 		return err
 	}
 
+	// manual projections adopted by a previous projection are kept, unprojecting drops the record
+	adopted, err := RetrieveAdoptedProjections(binding, workload)
+	if err != nil {
+		return err
+	}
+
 	// rather than attempt to merge an existing binding, unproject it
 	if err := p.Unproject(ctx, binding, workload); err != nil {
 		return err
@@ -75,12 +85,25 @@ func (p *serviceBindingProjector) Project(ctx context.Context, binding *serviceb
 		return nil
 	}
 
+	// replace projections of the same secret left behind by deleted bindings
+	if err := p.adoptOrphans(ctx, binding, workload, version); err != nil {
+		return err
+	}
+
 	versionMapping := MappingVersion(version, resourceMapping)
 	mpt, err := NewMetaPodTemplate(ctx, workload, versionMapping)
 	if err != nil {
 		return err
 	}
-	p.project(binding, mpt)
+	// kept volumes are found again on each projection
+	adopted.Kept = nil
+	if binding.Spec.AdoptManualProjections {
+		p.adoptManualProjections(binding, mpt, adopted)
+	}
+	p.project(binding, mpt, adopted)
+	if err := p.stashAdoptedProjections(binding, mpt, adopted); err != nil {
+		return err
+	}
 
 	if p.secretName(binding) != "" {
 		if err := p.stashLocalMapping(binding, mpt, resourceMapping); err != nil {
@@ -124,6 +147,21 @@ func (p *serviceBindingProjector) Unproject(ctx context.Context, binding *servic
 	return nil
 }
 
+func (p *serviceBindingProjector) Orphan(ctx context.Context, binding *servicebindingv1.ServiceBinding, workload runtime.Object) error {
+	obj := workload.(metav1.Object)
+	annotations := obj.GetAnnotations()
+	data, ok := annotations[p.mappingAnnotationName(binding)]
+	if !ok {
+		return nil
+	}
+	// only the workload's annotations change, the pod template is untouched so the workload does not roll out. The
+	// mapping is retained to unproject the service when adopted.
+	delete(annotations, p.mappingAnnotationName(binding))
+	annotations[p.orphanedAnnotationName(binding)] = data
+	obj.SetAnnotations(annotations)
+	return nil
+}
+
 func (p *serviceBindingProjector) IsProjected(ctx context.Context, binding *servicebindingv1.ServiceBinding, workload runtime.Object) bool {
 	annotations := workload.(metav1.Object).GetAnnotations()
 	if len(annotations) == 0 {
@@ -171,22 +209,30 @@ func (p *serviceBindingProjector) shouldProject(binding *servicebindingv1.Servic
 	if binding.Spec.Workload.Name != "" {
 		return binding.Spec.Workload.Name == workload.(metav1.Object).GetName()
 	}
+	if binding.Spec.Workload.Selector == nil && binding.Spec.Workload.Owner == nil {
+		return false
+	}
 	if binding.Spec.Workload.Selector != nil {
 		ls, err := metav1.LabelSelectorAsSelector(binding.Spec.Workload.Selector)
 		if err != nil {
 			// should never get here
 			return false
 		}
-		return ls.Matches(labels.Set(workload.(metav1.Object).GetLabels()))
+		if !ls.Matches(labels.Set(workload.(metav1.Object).GetLabels())) {
+			return false
+		}
+	}
+	if binding.Spec.Workload.Owner != nil {
+		return binding.Spec.Workload.Owner.Controls(workload.(metav1.Object))
 	}
 
-	return false
+	return true
 }
 
-func (p *serviceBindingProjector) project(binding *servicebindingv1.ServiceBinding, mpt *metaPodTemplate) {
+func (p *serviceBindingProjector) project(binding *servicebindingv1.ServiceBinding, mpt *metaPodTemplate, adopted *AdoptedProjections) {
 	p.projectVolume(binding, mpt)
 	for i := range mpt.Containers {
-		p.projectContainer(binding, mpt, &mpt.Containers[i])
+		p.projectContainer(binding, mpt, &mpt.Containers[i], adopted)
 	}
 }
 
@@ -197,6 +243,7 @@ func (p *serviceBindingProjector) unproject(binding *servicebindingv1.ServiceBin
 	}
 
 	// cleanup annotations
+	delete(mpt.WorkloadAnnotations, p.adoptedAnnotationName(binding))
 	delete(mpt.PodTemplateAnnotations, p.secretAnnotationName(binding))
 	delete(mpt.PodTemplateAnnotations, p.typeAnnotationName(binding))
 	delete(mpt.PodTemplateAnnotations, p.providerAnnotationName(binding))
@@ -285,12 +332,12 @@ func (p *serviceBindingProjector) unprojectVolume(binding *servicebindingv1.Serv
 	mpt.Volumes = volumes
 }
 
-func (p *serviceBindingProjector) projectContainer(binding *servicebindingv1.ServiceBinding, mpt *metaPodTemplate, mc *metaContainer) {
+func (p *serviceBindingProjector) projectContainer(binding *servicebindingv1.ServiceBinding, mpt *metaPodTemplate, mc *metaContainer, adopted *AdoptedProjections) {
 	if !p.isContainerBindable(binding, mc) {
 		return
 	}
 	p.projectVolumeMount(binding, mc)
-	p.projectEnv(binding, mpt, mc)
+	p.projectEnv(binding, mpt, mc, adopted)
 }
 
 func (p *serviceBindingProjector) unprojectContainer(binding *servicebindingv1.ServiceBinding, mpt *metaPodTemplate, mc *metaContainer) {
@@ -335,8 +382,8 @@ func (p *serviceBindingProjector) unprojectVolumeMount(binding *servicebindingv1
 	mc.VolumeMounts = mounts
 }
 
-func (p *serviceBindingProjector) projectEnv(binding *servicebindingv1.ServiceBinding, mpt *metaPodTemplate, mc *metaContainer) {
-	for _, e := range binding.Spec.Env {
+func (p *serviceBindingProjector) projectEnv(binding *servicebindingv1.ServiceBinding, mpt *metaPodTemplate, mc *metaContainer, adopted *AdoptedProjections) {
+	for _, e := range p.envMappings(binding, adopted) {
 		if e.Key == "type" && binding.Spec.Type != "" {
 			mc.Env = append(mc.Env, corev1.EnvVar{
 				Name: e.Name,
@@ -433,6 +480,17 @@ func (p *serviceBindingProjector) isContainerBindable(binding *servicebindingv1.
 	return false
 }
 
+// lookupServiceBindingRoot returns the root the binding is projected under in the container, without defaulting the
+// container's environment
+func (p *serviceBindingProjector) lookupServiceBindingRoot(mc *metaContainer) string {
+	for _, e := range mc.Env {
+		if e.Name == ServiceBindingRootEnv {
+			return e.Value
+		}
+	}
+	return "/bindings"
+}
+
 func (p *serviceBindingProjector) serviceBindingRoot(mc *metaContainer) string {
 	for _, e := range mc.Env {
 		if e.Name == ServiceBindingRootEnv {
@@ -544,6 +602,216 @@ func (p *serviceBindingProjector) stashLocalMapping(binding *servicebindingv1.Se
 	return nil
 }
 
+// adoptOrphans unprojects services orphaned in the workload by deleted bindings of the same secret as the binding.
+func (p *serviceBindingProjector) adoptOrphans(ctx context.Context, binding *servicebindingv1.ServiceBinding, workload runtime.Object, version string) error {
+	obj := workload.(metav1.Object)
+	keys := []string{}
+	for key := range obj.GetAnnotations() {
+		if strings.HasPrefix(key, OrphanedAnnotationPrefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		var mapping servicebindingv1.ClusterWorkloadResourceMappingSpec
+		if err := json.Unmarshal([]byte(obj.GetAnnotations()[key]), &mapping); err != nil {
+			return err
+		}
+		orphan := &servicebindingv1.ServiceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				UID: types.UID(strings.TrimPrefix(key, OrphanedAnnotationPrefix)),
+			},
+		}
+		mpt, err := NewMetaPodTemplate(ctx, workload, MappingVersion(version, &mapping))
+		if err != nil {
+			return err
+		}
+		if mpt.PodTemplateAnnotations[p.secretAnnotationName(orphan)] != p.secretName(binding) {
+			// orphaned by a binding of a different secret
+			continue
+		}
+		p.unproject(orphan, mpt)
+		delete(mpt.WorkloadAnnotations, key)
+		if err := mpt.WriteToWorkload(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AdoptedProjections are the manual projections of the binding secret in a workload that were converted into the
+// projected binding
+type AdoptedProjections struct {
+	// Volumes are the names of the volumes of the secret that were replaced by the projected volume
+	Volumes []string `json:"volumes,omitempty"`
+	// Env are the environment variables of the secret that are projected with the binding
+	Env []servicebindingv1.EnvMapping `json:"env,omitempty"`
+	// Kept are the volumes of the secret that were not adopted because a container mounts them at a path other than
+	// the path the binding is projected at. Adopting them would move the files the application reads.
+	Kept []string `json:"kept,omitempty"`
+}
+
+// IsEmpty returns true when nothing was adopted or kept
+func (a *AdoptedProjections) IsEmpty() bool {
+	return len(a.Volumes) == 0 && len(a.Env) == 0 && len(a.Kept) == 0
+}
+
+// RetrieveAdoptedProjections returns the manual projections the binding adopted in the workload. The result is
+// empty when the binding did not adopt anything.
+func RetrieveAdoptedProjections(binding *servicebindingv1.ServiceBinding, workload runtime.Object) (*AdoptedProjections, error) {
+	adopted := &AdoptedProjections{}
+	annotations := workload.(metav1.Object).GetAnnotations()
+	if len(annotations) == 0 {
+		return adopted, nil
+	}
+	data, ok := annotations[fmt.Sprintf("%s%s", AdoptedAnnotationPrefix, binding.UID)]
+	if !ok {
+		return adopted, nil
+	}
+	if err := json.Unmarshal([]byte(data), adopted); err != nil {
+		return nil, err
+	}
+	return adopted, nil
+}
+
+// adoptManualProjections removes volumes and environment variables from the workload that reference the binding's
+// secret directly. Removed volumes, and their mounts, are replaced by the projected volume. Removed environment
+// variables that the binding does not map are recorded to be projected with the binding. Volumes mounted by containers
+// the binding is not projected into are left in place, as are volumes mounted at a path other than the path the
+// binding is projected at, which are recorded as kept.
+func (p *serviceBindingProjector) adoptManualProjections(binding *servicebindingv1.ServiceBinding, mpt *metaPodTemplate, adopted *AdoptedProjections) {
+	secret := p.secretName(binding)
+
+	retained := sets.NewString()
+	customPaths := sets.NewString()
+	for i := range mpt.Containers {
+		mc := &mpt.Containers[i]
+		if !p.isContainerBindable(binding, mc) {
+			for _, m := range mc.VolumeMounts {
+				retained.Insert(m.Name)
+			}
+			continue
+		}
+		mountPath := path.Join(p.lookupServiceBindingRoot(mc), binding.Spec.Name)
+		for _, m := range mc.VolumeMounts {
+			if path.Clean(m.MountPath) != mountPath || m.SubPath != "" || m.SubPathExpr != "" {
+				customPaths.Insert(m.Name)
+			}
+		}
+	}
+	removed := sets.NewString()
+	kept := sets.NewString()
+	volumes := []corev1.Volume{}
+	for _, v := range mpt.Volumes {
+		if !strings.HasPrefix(v.Name, VolumePrefix) && !retained.Has(v.Name) && p.isSecretVolume(v, secret) {
+			if customPaths.Has(v.Name) {
+				kept.Insert(v.Name)
+			} else {
+				removed.Insert(v.Name)
+				continue
+			}
+		}
+		volumes = append(volumes, v)
+	}
+	mpt.Volumes = volumes
+	adopted.Volumes = sets.NewString(adopted.Volumes...).Union(removed).List()
+	adopted.Kept = kept.List()
+
+	mapped := sets.NewString()
+	for _, e := range binding.Spec.Env {
+		mapped.Insert(e.Name)
+	}
+	for _, e := range adopted.Env {
+		mapped.Insert(e.Name)
+	}
+	for i := range mpt.Containers {
+		mc := &mpt.Containers[i]
+		if !p.isContainerBindable(binding, mc) {
+			continue
+		}
+		mounts := []corev1.VolumeMount{}
+		for _, m := range mc.VolumeMounts {
+			if !removed.Has(m.Name) {
+				mounts = append(mounts, m)
+			}
+		}
+		mc.VolumeMounts = mounts
+		env := []corev1.EnvVar{}
+		for _, e := range mc.Env {
+			if e.ValueFrom == nil || e.ValueFrom.SecretKeyRef == nil || e.ValueFrom.SecretKeyRef.Name != secret {
+				env = append(env, e)
+				continue
+			}
+			if !mapped.Has(e.Name) {
+				mapped.Insert(e.Name)
+				adopted.Env = append(adopted.Env, servicebindingv1.EnvMapping{
+					Name: e.Name,
+					Key:  e.ValueFrom.SecretKeyRef.Key,
+				})
+			}
+		}
+		mc.Env = env
+	}
+	sort.SliceStable(adopted.Env, func(i, j int) bool {
+		return adopted.Env[i].Name < adopted.Env[j].Name
+	})
+}
+
+// envMappings returns the env mappings of the binding followed by adopted env mappings the binding does not map itself
+func (p *serviceBindingProjector) envMappings(binding *servicebindingv1.ServiceBinding, adopted *AdoptedProjections) []servicebindingv1.EnvMapping {
+	mappings := make([]servicebindingv1.EnvMapping, 0, len(binding.Spec.Env)+len(adopted.Env))
+	mapped := sets.NewString()
+	for _, e := range binding.Spec.Env {
+		mappings = append(mappings, e)
+		mapped.Insert(e.Name)
+	}
+	for _, e := range adopted.Env {
+		if !mapped.Has(e.Name) {
+			mappings = append(mappings, e)
+		}
+	}
+	return mappings
+}
+
+// isSecretVolume returns true when the volume only exposes the secret
+func (p *serviceBindingProjector) isSecretVolume(v corev1.Volume, secret string) bool {
+	if v.Secret != nil {
+		return v.Secret.SecretName == secret
+	}
+	if v.Projected != nil && len(v.Projected.Sources) != 0 {
+		for _, s := range v.Projected.Sources {
+			if s.Secret == nil || s.Secret.Name != secret {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func (p *serviceBindingProjector) stashAdoptedProjections(binding *servicebindingv1.ServiceBinding, mpt *metaPodTemplate, adopted *AdoptedProjections) error {
+	if adopted.IsEmpty() {
+		delete(mpt.WorkloadAnnotations, p.adoptedAnnotationName(binding))
+		return nil
+	}
+	data, err := json.Marshal(adopted)
+	if err != nil {
+		return err
+	}
+	mpt.WorkloadAnnotations[p.adoptedAnnotationName(binding)] = string(data)
+	return nil
+}
+
+func (p *serviceBindingProjector) adoptedAnnotationName(binding *servicebindingv1.ServiceBinding) string {
+	return fmt.Sprintf("%s%s", AdoptedAnnotationPrefix, binding.UID)
+}
+
+func (p *serviceBindingProjector) orphanedAnnotationName(binding *servicebindingv1.ServiceBinding) string {
+	return fmt.Sprintf("%s%s", OrphanedAnnotationPrefix, binding.UID)
+}
+
 func (p *serviceBindingProjector) mappingAnnotationName(binding *servicebindingv1.ServiceBinding) string {
 	return fmt.Sprintf("%s%s", MappingAnnotationPrefix, binding.UID)
 }
@@ -1846,6 +1846,131 @@ func TestBinding(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "leave pod template alone for the type reflected from the binding secret",
+			mapping: NewStaticMapping(&servicebindingv1.ClusterWorkloadResourceMappingSpec{}, deploymentRESTMapping),
+			binding: &servicebindingv1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: uid,
+				},
+				Spec: servicebindingv1.ServiceBindingSpec{
+					Name: bindingName,
+					Env: []servicebindingv1.EnvMapping{
+						{
+							Name: "TYPE",
+							Key:  "type",
+						},
+						{
+							Name: "PROVIDER",
+							Key:  "provider",
+						},
+					},
+					Workload: servicebindingv1.ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       "my-workload",
+					},
+				},
+				// the type is reflected from the binding secret, the secret projects its own type and provider entries
+				Status: servicebindingv1.ServiceBindingStatus{
+					Binding: &servicebindingv1.ServiceBindingSecretReference{
+						Name: secretName,
+					},
+					Type: "my-type",
+				},
+			},
+			workload: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-workload",
+				},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{},
+							},
+						},
+					},
+				},
+			},
+			expected: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-workload",
+					Annotations: map[string]string{
+						"projector.servicebinding.io/mapping-26894874-4719-4802-8f43-8ceed127b4c2": podSpecableMapping,
+					},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											DefaultMode: ptr.To(VolumeDefaultMode),
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: secretName,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+										{
+											Name: "PROVIDER",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													Key: "provider",
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
+												},
+											},
+										},
+										{
+											Name: "TYPE",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													Key: "type",
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
+												},
+											},
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+											ReadOnly:  true,
+											MountPath: "/bindings/my-binding",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name:    "update service binding type and provider",
 			mapping: NewStaticMapping(&servicebindingv1.ClusterWorkloadResourceMappingSpec{}, deploymentRESTMapping),
@@ -2103,7 +2228,7 @@ func TestBinding(t *testing.T) {
 			},
 		},
 		{
-			name:    "only bind to allowed containers",
+			name:    "bind workload controlled by owner",
 			mapping: NewStaticMapping(&servicebindingv1.ClusterWorkloadResourceMappingSpec{}, deploymentRESTMapping),
 			binding: &servicebindingv1.ServiceBinding{
 				ObjectMeta: metav1.ObjectMeta{
@@ -2114,8 +2239,11 @@ func TestBinding(t *testing.T) {
 					Workload: servicebindingv1.ServiceBindingWorkloadReference{
 						APIVersion: "apps/v1",
 						Kind:       "Deployment",
-						Name:       "my-workload",
-						Containers: []string{"bind"},
+						Owner: &servicebindingv1.ServiceBindingWorkloadOwnerReference{
+							APIVersion: "app.local/v1",
+							Kind:       "MyApp",
+							Name:       "my-app",
+						},
 					},
 				},
 				Status: servicebindingv1.ServiceBindingStatus{
@@ -2127,19 +2255,21 @@ func TestBinding(t *testing.T) {
 			workload: &appsv1.Deployment{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "my-workload",
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion: "app.local/v2",
+							Kind:       "MyApp",
+							Name:       "my-app",
+							Controller: ptr.To(true),
+						},
+					},
 				},
 				Spec: appsv1.DeploymentSpec{
 					Template: corev1.PodTemplateSpec{
 						Spec: corev1.PodSpec{
 							Containers: []corev1.Container{
 								{
-									Name: "bind",
-								},
-								{
-									Name: "skip",
-								},
-								{
-									Name: "",
+									Name: "hello",
 								},
 							},
 						},
@@ -2152,6 +2282,14 @@ func TestBinding(t *testing.T) {
 					Annotations: map[string]string{
 						"projector.servicebinding.io/mapping-26894874-4719-4802-8f43-8ceed127b4c2": podSpecableMapping,
 					},
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion: "app.local/v2",
+							Kind:       "MyApp",
+							Name:       "my-app",
+							Controller: ptr.To(true),
+						},
+					},
 				},
 				Spec: appsv1.DeploymentSpec{
 					Template: corev1.PodTemplateSpec{
@@ -2182,7 +2320,7 @@ func TestBinding(t *testing.T) {
 							},
 							Containers: []corev1.Container{
 								{
-									Name: "bind",
+									Name: "hello",
 									Env: []corev1.EnvVar{
 										{
 											Name:  "SERVICE_BINDING_ROOT",
@@ -2197,13 +2335,84 @@ func TestBinding(t *testing.T) {
 										},
 									},
 								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "no binding if not controlled by owner",
+			mapping: NewStaticMapping(&servicebindingv1.ClusterWorkloadResourceMappingSpec{}, deploymentRESTMapping),
+			binding: &servicebindingv1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: uid,
+				},
+				Spec: servicebindingv1.ServiceBindingSpec{
+					Name: bindingName,
+					Workload: servicebindingv1.ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Owner: &servicebindingv1.ServiceBindingWorkloadOwnerReference{
+							APIVersion: "app.local/v1",
+							Kind:       "MyApp",
+							Name:       "my-app",
+						},
+					},
+				},
+				Status: servicebindingv1.ServiceBindingStatus{
+					Binding: &servicebindingv1.ServiceBindingSecretReference{
+						Name: secretName,
+					},
+				},
+			},
+			workload: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-workload",
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion: "app.local/v2",
+							Kind:       "MyApp",
+							Name:       "other-app",
+							Controller: ptr.To(true),
+						},
+					},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
 								{
-									Name:         "skip",
-									Env:          []corev1.EnvVar{},
-									VolumeMounts: []corev1.VolumeMount{},
+									Name: "hello",
 								},
+							},
+						},
+					},
+				},
+			},
+			expected: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "my-workload",
+					Annotations: map[string]string{},
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion: "app.local/v2",
+							Kind:       "MyApp",
+							Name:       "other-app",
+							Controller: ptr.To(true),
+						},
+					},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{},
+							Containers: []corev1.Container{
 								{
-									Name:         "",
+									Name:         "hello",
 									Env:          []corev1.EnvVar{},
 									VolumeMounts: []corev1.VolumeMount{},
 								},
@@ -2214,7 +2423,7 @@ func TestBinding(t *testing.T) {
 			},
 		},
 		{
-			name:    "preserve other bindings",
+			name:    "only bind to allowed containers",
 			mapping: NewStaticMapping(&servicebindingv1.ClusterWorkloadResourceMappingSpec{}, deploymentRESTMapping),
 			binding: &servicebindingv1.ServiceBinding{
 				ObjectMeta: metav1.ObjectMeta{
@@ -2222,16 +2431,11 @@ func TestBinding(t *testing.T) {
 				},
 				Spec: servicebindingv1.ServiceBindingSpec{
 					Name: bindingName,
-					Env: []servicebindingv1.EnvMapping{
-						{
-							Name: "FOO",
-							Key:  "foo",
-						},
-					},
 					Workload: servicebindingv1.ServiceBindingWorkloadReference{
 						APIVersion: "apps/v1",
 						Kind:       "Deployment",
 						Name:       "my-workload",
+						Containers: []string{"bind"},
 					},
 				},
 				Status: servicebindingv1.ServiceBindingStatus{
@@ -2244,31 +2448,147 @@ func TestBinding(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "my-workload",
 				},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name: "bind",
+								},
+								{
+									Name: "skip",
+								},
+								{
+									Name: "",
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-workload",
+					Annotations: map[string]string{
+						"projector.servicebinding.io/mapping-26894874-4719-4802-8f43-8ceed127b4c2": podSpecableMapping,
+					},
+				},
 				Spec: appsv1.DeploymentSpec{
 					Template: corev1.PodTemplateSpec{
 						ObjectMeta: metav1.ObjectMeta{
 							Annotations: map[string]string{
-								"projector.servicebinding.io/secret-11111111-1111-1111-1111-111111111111": "secret-1",
-								"projector.servicebinding.io/secret-22222222-2222-2222-2222-222222222222": "secret-2",
-								"projector.servicebinding.io/secret-33333333-3333-3333-3333-333333333333": "secret-3",
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
 							},
 						},
 						Spec: corev1.PodSpec{
 							Volumes: []corev1.Volume{
 								{
-									Name: "preexisting",
-									VolumeSource: corev1.VolumeSource{
-										EmptyDir: &corev1.EmptyDirVolumeSource{},
-									},
-								},
-								{
-									Name: "z_existing",
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
 									VolumeSource: corev1.VolumeSource{
-										EmptyDir: &corev1.EmptyDirVolumeSource{},
-									},
-								},
-								{
-									Name: "servicebinding-33333333-3333-3333-3333-333333333333",
+										Projected: &corev1.ProjectedVolumeSource{
+											DefaultMode: ptr.To(VolumeDefaultMode),
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: secretName,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Name: "bind",
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+											ReadOnly:  true,
+											MountPath: "/bindings/my-binding",
+										},
+									},
+								},
+								{
+									Name:         "skip",
+									Env:          []corev1.EnvVar{},
+									VolumeMounts: []corev1.VolumeMount{},
+								},
+								{
+									Name:         "",
+									Env:          []corev1.EnvVar{},
+									VolumeMounts: []corev1.VolumeMount{},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "preserve other bindings",
+			mapping: NewStaticMapping(&servicebindingv1.ClusterWorkloadResourceMappingSpec{}, deploymentRESTMapping),
+			binding: &servicebindingv1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: uid,
+				},
+				Spec: servicebindingv1.ServiceBindingSpec{
+					Name: bindingName,
+					Env: []servicebindingv1.EnvMapping{
+						{
+							Name: "FOO",
+							Key:  "foo",
+						},
+					},
+					Workload: servicebindingv1.ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       "my-workload",
+					},
+				},
+				Status: servicebindingv1.ServiceBindingStatus{
+					Binding: &servicebindingv1.ServiceBindingSecretReference{
+						Name: secretName,
+					},
+				},
+			},
+			workload: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-workload",
+				},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-11111111-1111-1111-1111-111111111111": "secret-1",
+								"projector.servicebinding.io/secret-22222222-2222-2222-2222-222222222222": "secret-2",
+								"projector.servicebinding.io/secret-33333333-3333-3333-3333-333333333333": "secret-3",
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "preexisting",
+									VolumeSource: corev1.VolumeSource{
+										EmptyDir: &corev1.EmptyDirVolumeSource{},
+									},
+								},
+								{
+									Name: "z_existing",
+									VolumeSource: corev1.VolumeSource{
+										EmptyDir: &corev1.EmptyDirVolumeSource{},
+									},
+								},
+								{
+									Name: "servicebinding-33333333-3333-3333-3333-333333333333",
 									VolumeSource: corev1.VolumeSource{
 										Projected: &corev1.ProjectedVolumeSource{
 											DefaultMode: ptr.To(VolumeDefaultMode),
@@ -2864,34 +3184,895 @@ func TestBinding(t *testing.T) {
 			expectedErr: true,
 		},
 		{
-			name: "conversion error",
-			mapping: NewStaticMapping(
-				&servicebindingv1.ClusterWorkloadResourceMappingSpec{},
-				&meta.RESTMapping{
-					GroupVersionKind: schema.GroupVersionKind{Group: "test", Version: "v1", Kind: "BadMarshalJSON"},
-					Resource:         schema.GroupVersionResource{Group: "test", Version: "v1", Resource: "badmarshaljsons"},
-					Scope:            meta.RESTScopeNamespace,
+			name:    "adopt orphaned projection of the same secret",
+			mapping: NewStaticMapping(&servicebindingv1.ClusterWorkloadResourceMappingSpec{}, deploymentRESTMapping),
+			binding: &servicebindingv1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: uid,
 				},
-			),
-			workload:    &BadMarshalJSON{},
-			expectedErr: true,
+				Spec: servicebindingv1.ServiceBindingSpec{
+					Name: bindingName,
+					Workload: servicebindingv1.ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       "my-workload",
+					},
+				},
+				Status: servicebindingv1.ServiceBindingStatus{
+					Binding: &servicebindingv1.ServiceBindingSecretReference{
+						Name: secretName,
+					},
+				},
+			},
+			workload: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-workload",
+					Annotations: map[string]string{
+						"projector.servicebinding.io/orphaned-11111111-2222-3333-4444-555555555555": podSpecableMapping,
+					},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-11111111-2222-3333-4444-555555555555": secretName,
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "servicebinding-11111111-2222-3333-4444-555555555555",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											DefaultMode: ptr.To(VolumeDefaultMode),
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: secretName,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Name: "hello",
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "servicebinding-11111111-2222-3333-4444-555555555555",
+											ReadOnly:  true,
+											MountPath: "/bindings/my-binding",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-workload",
+					Annotations: map[string]string{
+						"projector.servicebinding.io/mapping-26894874-4719-4802-8f43-8ceed127b4c2": podSpecableMapping,
+					},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											DefaultMode: ptr.To(VolumeDefaultMode),
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: secretName,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Name: "hello",
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+											ReadOnly:  true,
+											MountPath: "/bindings/my-binding",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
-	}
-
-	for _, c := range tests {
-		t.Run(c.name, func(t *testing.T) {
-			ctx := context.TODO()
-
-			actual := c.workload.DeepCopyObject()
-			err := New(c.mapping).Project(ctx, c.binding, actual)
-
-			if (err != nil) != c.expectedErr {
-				t.Errorf("Bind() expected err: %v", err)
-			}
-			if c.expectedErr {
-				return
-			}
-			if diff := cmp.Diff(c.expected, actual); diff != "" {
+		{
+			name:    "keep orphaned projection of a different secret",
+			mapping: NewStaticMapping(&servicebindingv1.ClusterWorkloadResourceMappingSpec{}, deploymentRESTMapping),
+			binding: &servicebindingv1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: uid,
+				},
+				Spec: servicebindingv1.ServiceBindingSpec{
+					Name: bindingName,
+					Workload: servicebindingv1.ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       "my-workload",
+					},
+				},
+				Status: servicebindingv1.ServiceBindingStatus{
+					Binding: &servicebindingv1.ServiceBindingSecretReference{
+						Name: secretName,
+					},
+				},
+			},
+			workload: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-workload",
+					Annotations: map[string]string{
+						"projector.servicebinding.io/orphaned-11111111-2222-3333-4444-555555555555": podSpecableMapping,
+					},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-11111111-2222-3333-4444-555555555555": "other-secret",
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "servicebinding-11111111-2222-3333-4444-555555555555",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											DefaultMode: ptr.To(VolumeDefaultMode),
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: "other-secret",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Name: "hello",
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "servicebinding-11111111-2222-3333-4444-555555555555",
+											ReadOnly:  true,
+											MountPath: "/bindings/other-binding",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-workload",
+					Annotations: map[string]string{
+						"projector.servicebinding.io/mapping-26894874-4719-4802-8f43-8ceed127b4c2":  podSpecableMapping,
+						"projector.servicebinding.io/orphaned-11111111-2222-3333-4444-555555555555": podSpecableMapping,
+					},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-11111111-2222-3333-4444-555555555555": "other-secret",
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "servicebinding-11111111-2222-3333-4444-555555555555",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											DefaultMode: ptr.To(VolumeDefaultMode),
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: "other-secret",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								{
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											DefaultMode: ptr.To(VolumeDefaultMode),
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: secretName,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Name: "hello",
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "servicebinding-11111111-2222-3333-4444-555555555555",
+											ReadOnly:  true,
+											MountPath: "/bindings/other-binding",
+										},
+										{
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+											ReadOnly:  true,
+											MountPath: "/bindings/my-binding",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "adopt manual projections",
+			mapping: NewStaticMapping(&servicebindingv1.ClusterWorkloadResourceMappingSpec{}, deploymentRESTMapping),
+			binding: &servicebindingv1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: uid,
+				},
+				Spec: servicebindingv1.ServiceBindingSpec{
+					Name: bindingName,
+					Workload: servicebindingv1.ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       "my-workload",
+					},
+					Env: []servicebindingv1.EnvMapping{
+						{
+							Name: "DB_USER",
+							Key:  "username",
+						},
+					},
+					AdoptManualProjections: true,
+				},
+				Status: servicebindingv1.ServiceBindingStatus{
+					Binding: &servicebindingv1.ServiceBindingSecretReference{
+						Name: secretName,
+					},
+				},
+			},
+			workload: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-workload",
+				},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "db",
+									VolumeSource: corev1.VolumeSource{
+										Secret: &corev1.SecretVolumeSource{
+											SecretName: secretName,
+										},
+									},
+								},
+								{
+									Name: "config",
+									VolumeSource: corev1.VolumeSource{
+										Secret: &corev1.SecretVolumeSource{
+											SecretName: "my-config",
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Name: "hello",
+									Env: []corev1.EnvVar{
+										{
+											Name:  "LOG_LEVEL",
+											Value: "debug",
+										},
+										{
+											Name: "DB_USER",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
+													Key: "username",
+												},
+											},
+										},
+										{
+											Name: "DB_PASSWORD",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
+													Key: "password",
+												},
+											},
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "db",
+											ReadOnly:  true,
+											MountPath: "/bindings/my-binding",
+										},
+										{
+											Name:      "config",
+											ReadOnly:  true,
+											MountPath: "/etc/config",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-workload",
+					Annotations: map[string]string{
+						"projector.servicebinding.io/adopted-26894874-4719-4802-8f43-8ceed127b4c2": `{"volumes":["db"],"env":[{"name":"DB_PASSWORD","key":"password"}]}`,
+						"projector.servicebinding.io/mapping-26894874-4719-4802-8f43-8ceed127b4c2": podSpecableMapping,
+					},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "config",
+									VolumeSource: corev1.VolumeSource{
+										Secret: &corev1.SecretVolumeSource{
+											SecretName: "my-config",
+										},
+									},
+								},
+								{
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											DefaultMode: ptr.To(VolumeDefaultMode),
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: secretName,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Name: "hello",
+									Env: []corev1.EnvVar{
+										{
+											Name:  "LOG_LEVEL",
+											Value: "debug",
+										},
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+										{
+											Name: "DB_PASSWORD",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
+													Key: "password",
+												},
+											},
+										},
+										{
+											Name: "DB_USER",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
+													Key: "username",
+												},
+											},
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "config",
+											ReadOnly:  true,
+											MountPath: "/etc/config",
+										},
+										{
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+											ReadOnly:  true,
+											MountPath: "/bindings/my-binding",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "keep manual projections mounted at a custom path",
+			mapping: NewStaticMapping(&servicebindingv1.ClusterWorkloadResourceMappingSpec{}, deploymentRESTMapping),
+			binding: &servicebindingv1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: uid,
+				},
+				Spec: servicebindingv1.ServiceBindingSpec{
+					Name: bindingName,
+					Workload: servicebindingv1.ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       "my-workload",
+					},
+					Env: []servicebindingv1.EnvMapping{
+						{
+							Name: "DB_USER",
+							Key:  "username",
+						},
+					},
+					AdoptManualProjections: true,
+				},
+				Status: servicebindingv1.ServiceBindingStatus{
+					Binding: &servicebindingv1.ServiceBindingSecretReference{
+						Name: secretName,
+					},
+				},
+			},
+			workload: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-workload",
+				},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "db",
+									VolumeSource: corev1.VolumeSource{
+										Secret: &corev1.SecretVolumeSource{
+											SecretName: secretName,
+										},
+									},
+								},
+								{
+									Name: "config",
+									VolumeSource: corev1.VolumeSource{
+										Secret: &corev1.SecretVolumeSource{
+											SecretName: "my-config",
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Name: "hello",
+									Env: []corev1.EnvVar{
+										{
+											Name:  "LOG_LEVEL",
+											Value: "debug",
+										},
+										{
+											Name: "DB_USER",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
+													Key: "username",
+												},
+											},
+										},
+										{
+											Name: "DB_PASSWORD",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
+													Key: "password",
+												},
+											},
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "db",
+											ReadOnly:  true,
+											MountPath: "/etc/db",
+										},
+										{
+											Name:      "config",
+											ReadOnly:  true,
+											MountPath: "/etc/config",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-workload",
+					Annotations: map[string]string{
+						"projector.servicebinding.io/adopted-26894874-4719-4802-8f43-8ceed127b4c2": `{"env":[{"name":"DB_PASSWORD","key":"password"}],"kept":["db"]}`,
+						"projector.servicebinding.io/mapping-26894874-4719-4802-8f43-8ceed127b4c2": podSpecableMapping,
+					},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "db",
+									VolumeSource: corev1.VolumeSource{
+										Secret: &corev1.SecretVolumeSource{
+											SecretName: secretName,
+										},
+									},
+								},
+								{
+									Name: "config",
+									VolumeSource: corev1.VolumeSource{
+										Secret: &corev1.SecretVolumeSource{
+											SecretName: "my-config",
+										},
+									},
+								},
+								{
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											DefaultMode: ptr.To(VolumeDefaultMode),
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: secretName,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Name: "hello",
+									Env: []corev1.EnvVar{
+										{
+											Name:  "LOG_LEVEL",
+											Value: "debug",
+										},
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+										{
+											Name: "DB_PASSWORD",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
+													Key: "password",
+												},
+											},
+										},
+										{
+											Name: "DB_USER",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
+													Key: "username",
+												},
+											},
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "db",
+											ReadOnly:  true,
+											MountPath: "/etc/db",
+										},
+										{
+											Name:      "config",
+											ReadOnly:  true,
+											MountPath: "/etc/config",
+										},
+										{
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+											ReadOnly:  true,
+											MountPath: "/bindings/my-binding",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "keep adopted manual projections",
+			mapping: NewStaticMapping(&servicebindingv1.ClusterWorkloadResourceMappingSpec{}, deploymentRESTMapping),
+			binding: &servicebindingv1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: uid,
+				},
+				Spec: servicebindingv1.ServiceBindingSpec{
+					Name: bindingName,
+					Workload: servicebindingv1.ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       "my-workload",
+					},
+				},
+				Status: servicebindingv1.ServiceBindingStatus{
+					Binding: &servicebindingv1.ServiceBindingSecretReference{
+						Name: secretName,
+					},
+				},
+			},
+			workload: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-workload",
+					Annotations: map[string]string{
+						"projector.servicebinding.io/adopted-26894874-4719-4802-8f43-8ceed127b4c2": `{"volumes":["db"],"env":[{"name":"DB_PASSWORD","key":"password"}]}`,
+						"projector.servicebinding.io/mapping-26894874-4719-4802-8f43-8ceed127b4c2": podSpecableMapping,
+					},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											DefaultMode: ptr.To(VolumeDefaultMode),
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: secretName,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Name: "hello",
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+										{
+											Name: "DB_PASSWORD",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
+													Key: "password",
+												},
+											},
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+											ReadOnly:  true,
+											MountPath: "/bindings/my-binding",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-workload",
+					Annotations: map[string]string{
+						"projector.servicebinding.io/adopted-26894874-4719-4802-8f43-8ceed127b4c2": `{"volumes":["db"],"env":[{"name":"DB_PASSWORD","key":"password"}]}`,
+						"projector.servicebinding.io/mapping-26894874-4719-4802-8f43-8ceed127b4c2": podSpecableMapping,
+					},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											DefaultMode: ptr.To(VolumeDefaultMode),
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: secretName,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Name: "hello",
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+										{
+											Name: "DB_PASSWORD",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
+													Key: "password",
+												},
+											},
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+											ReadOnly:  true,
+											MountPath: "/bindings/my-binding",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "conversion error",
+			mapping: NewStaticMapping(
+				&servicebindingv1.ClusterWorkloadResourceMappingSpec{},
+				&meta.RESTMapping{
+					GroupVersionKind: schema.GroupVersionKind{Group: "test", Version: "v1", Kind: "BadMarshalJSON"},
+					Resource:         schema.GroupVersionResource{Group: "test", Version: "v1", Resource: "badmarshaljsons"},
+					Scope:            meta.RESTScopeNamespace,
+				},
+			),
+			workload:    &BadMarshalJSON{},
+			expectedErr: true,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			actual := c.workload.DeepCopyObject()
+			err := New(c.mapping).Project(ctx, c.binding, actual)
+
+			if (err != nil) != c.expectedErr {
+				t.Errorf("Bind() expected err: %v", err)
+			}
+			if c.expectedErr {
+				return
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
 				t.Errorf("Bind() (-expected, +actual): %s", diff)
 			}
 		})
@@ -2909,3 +4090,94 @@ type BadMarshalJSON struct {
 
 func (r *BadMarshalJSON) MarshalJSON() ([]byte, error)   { return nil, fmt.Errorf("bad json marshal") }
 func (r *BadMarshalJSON) DeepCopyObject() runtime.Object { return r }
+
+func TestOrphan(t *testing.T) {
+	uid := types.UID("26894874-4719-4802-8f43-8ceed127b4c2")
+	secretName := "my-secret"
+	podSpecableMapping := `{"versions":[{"version":"*","annotations":".spec.template.metadata.annotations","containers":[{"path":".spec.template.spec.initContainers[*]","name":".name","env":".env","volumeMounts":".volumeMounts"},{"path":".spec.template.spec.containers[*]","name":".name","env":".env","volumeMounts":".volumeMounts"}],"volumes":".spec.template.spec.volumes"}]}`
+
+	deploymentRESTMapping := &meta.RESTMapping{
+		GroupVersionKind: schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+		Resource:         schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"},
+		Scope:            meta.RESTScopeNamespace,
+	}
+
+	binding := &servicebindingv1.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			UID: uid,
+		},
+	}
+
+	tests := []struct {
+		name     string
+		workload runtime.Object
+		expected runtime.Object
+	}{
+		{
+			name: "not projected",
+			workload: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-workload",
+				},
+			},
+			expected: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-workload",
+				},
+			},
+		},
+		{
+			name: "orphan projection",
+			workload: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-workload",
+					Annotations: map[string]string{
+						"projector.servicebinding.io/mapping-26894874-4719-4802-8f43-8ceed127b4c2": podSpecableMapping,
+					},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+							},
+						},
+					},
+				},
+			},
+			expected: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-workload",
+					Annotations: map[string]string{
+						"projector.servicebinding.io/orphaned-26894874-4719-4802-8f43-8ceed127b4c2": podSpecableMapping,
+					},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			actual := c.workload.DeepCopyObject()
+			err := New(NewStaticMapping(&servicebindingv1.ClusterWorkloadResourceMappingSpec{}, deploymentRESTMapping)).(Orphaner).Orphan(ctx, binding, actual)
+
+			if err != nil {
+				t.Errorf("Orphan() unexpected err: %v", err)
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("Orphan() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
@@ -35,6 +35,14 @@ type ServiceBindingProjector interface {
 	IsProjected(ctx context.Context, binding *servicebindingv1.ServiceBinding, workload runtime.Object) bool
 }
 
+// Orphaner is optionally implemented by a ServiceBindingProjector that can release workloads from a ServiceBinding
+// deleted with the orphan deletion policy. Projectors that do not implement it unproject the workload instead.
+type Orphaner interface {
+	// Orphan releases the workload from the ServiceBinding, leaving the projected service in place for a future binding
+	// of the same Secret to adopt.
+	Orphan(ctx context.Context, binding *servicebindingv1.ServiceBinding, workload runtime.Object) error
+}
+
 type MappingSource interface {
 	// LookupRESTMapping returns the RESTMapping for the workload type. The rest mapping contains a GroupVersionResource which can
 	// be used to fetch the workload mapping.
@@ -0,0 +1,104 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resolver
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	servicebindingv1 "github.com/servicebinding/runtime/apis/v1"
+)
+
+// Strategy resolves the binding secret and/or workloads for a subset of service and workload kinds. Strategies are
+// composed with a fallback resolver by NewChain, kinds not handled by any strategy are resolved by the fallback.
+type Strategy interface {
+	// ServiceGVKs returns the service kinds this strategy resolves binding secrets for. An empty version matches every
+	// version of the kind.
+	ServiceGVKs() []schema.GroupVersionKind
+
+	// WorkloadGVKs returns the workload kinds this strategy resolves workloads for. An empty version matches every
+	// version of the kind.
+	WorkloadGVKs() []schema.GroupVersionKind
+
+	// LookupBindingSecret is called for ServiceBindings whose service matches one of the ServiceGVKs.
+	LookupBindingSecret(ctx context.Context, serviceBinding *servicebindingv1.ServiceBinding) (string, error)
+
+	// LookupWorkloads is called for ServiceBindings whose workload matches one of the WorkloadGVKs.
+	LookupWorkloads(ctx context.Context, serviceBinding *servicebindingv1.ServiceBinding) ([]runtime.Object, error)
+}
+
+var _ Resolver = (*chainResolver)(nil)
+
+// NewChain creates a resolver that consults each strategy, in order, for the kinds it handles. The first matching
+// strategy wins. Lookups for kinds that are not handled by a strategy, and all mapping lookups, are delegated to the
+// fallback resolver.
+func NewChain(fallback Resolver, strategies ...Strategy) Resolver {
+	return &chainResolver{
+		fallback:   fallback,
+		strategies: strategies,
+	}
+}
+
+type chainResolver struct {
+	fallback   Resolver
+	strategies []Strategy
+}
+
+func (r *chainResolver) LookupRESTMapping(ctx context.Context, obj runtime.Object) (*meta.RESTMapping, error) {
+	return r.fallback.LookupRESTMapping(ctx, obj)
+}
+
+func (r *chainResolver) LookupWorkloadMapping(ctx context.Context, gvr schema.GroupVersionResource) (*servicebindingv1.ClusterWorkloadResourceMappingSpec, error) {
+	return r.fallback.LookupWorkloadMapping(ctx, gvr)
+}
+
+func (r *chainResolver) LookupBindingSecret(ctx context.Context, serviceBinding *servicebindingv1.ServiceBinding) (string, error) {
+	serviceRef := serviceBinding.Spec.Service
+	gvk := schema.FromAPIVersionAndKind(serviceRef.APIVersion, serviceRef.Kind)
+	for _, s := range r.strategies {
+		if matchesGVK(gvk, s.ServiceGVKs()) {
+			return s.LookupBindingSecret(ctx, serviceBinding)
+		}
+	}
+	return r.fallback.LookupBindingSecret(ctx, serviceBinding)
+}
+
+func (r *chainResolver) LookupWorkloads(ctx context.Context, serviceBinding *servicebindingv1.ServiceBinding) ([]runtime.Object, error) {
+	workloadRef := serviceBinding.Spec.Workload
+	gvk := schema.FromAPIVersionAndKind(workloadRef.APIVersion, workloadRef.Kind)
+	for _, s := range r.strategies {
+		if matchesGVK(gvk, s.WorkloadGVKs()) {
+			return s.LookupWorkloads(ctx, serviceBinding)
+		}
+	}
+	return r.fallback.LookupWorkloads(ctx, serviceBinding)
+}
+
+func matchesGVK(gvk schema.GroupVersionKind, candidates []schema.GroupVersionKind) bool {
+	for _, c := range candidates {
+		if c.GroupKind() != gvk.GroupKind() {
+			continue
+		}
+		if c.Version == "" || c.Version == gvk.Version {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,309 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resolver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	servicebindingv1 "github.com/servicebinding/runtime/apis/v1"
+	"github.com/servicebinding/runtime/resolver"
+)
+
+type staticStrategy struct {
+	serviceGVKs  []schema.GroupVersionKind
+	workloadGVKs []schema.GroupVersionKind
+	secretName   string
+	workloads    []runtime.Object
+}
+
+func (s *staticStrategy) ServiceGVKs() []schema.GroupVersionKind {
+	return s.serviceGVKs
+}
+
+func (s *staticStrategy) WorkloadGVKs() []schema.GroupVersionKind {
+	return s.workloadGVKs
+}
+
+func (s *staticStrategy) LookupBindingSecret(ctx context.Context, serviceBinding *servicebindingv1.ServiceBinding) (string, error) {
+	return s.secretName, nil
+}
+
+func (s *staticStrategy) LookupWorkloads(ctx context.Context, serviceBinding *servicebindingv1.ServiceBinding) ([]runtime.Object, error) {
+	return s.workloads, nil
+}
+
+func TestChainResolver_LookupBindingSecret(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(corev1.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1.AddToScheme(scheme))
+
+	strategy := &staticStrategy{
+		serviceGVKs: []schema.GroupVersionKind{
+			{Group: "service.local", Kind: "MyService"},
+			{Group: "other.local", Version: "v1", Kind: "OtherService"},
+		},
+		secretName: "strategy-secret",
+	}
+
+	tests := []struct {
+		name           string
+		givenObjects   []client.Object
+		serviceBinding *servicebindingv1.ServiceBinding
+		expected       string
+		expectedErr    bool
+	}{
+		{
+			name: "handled by strategy for any version",
+			serviceBinding: &servicebindingv1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-namespace",
+				},
+				Spec: servicebindingv1.ServiceBindingSpec{
+					Service: servicebindingv1.ServiceBindingServiceReference{
+						APIVersion: "service.local/v1beta1",
+						Kind:       "MyService",
+						Name:       "my-service",
+					},
+				},
+			},
+			expected: "strategy-secret",
+		},
+		{
+			name: "handled by strategy for a specific version",
+			serviceBinding: &servicebindingv1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-namespace",
+				},
+				Spec: servicebindingv1.ServiceBindingSpec{
+					Service: servicebindingv1.ServiceBindingServiceReference{
+						APIVersion: "other.local/v1",
+						Kind:       "OtherService",
+						Name:       "my-service",
+					},
+				},
+			},
+			expected: "strategy-secret",
+		},
+		{
+			name: "unhandled version falls through",
+			givenObjects: []client.Object{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "other.local/v2",
+						"kind":       "OtherService",
+						"metadata": map[string]interface{}{
+							"namespace": "my-namespace",
+							"name":      "my-service",
+						},
+						"status": map[string]interface{}{
+							"binding": map[string]interface{}{
+								"name": "cluster-secret",
+							},
+						},
+					},
+				},
+			},
+			serviceBinding: &servicebindingv1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-namespace",
+				},
+				Spec: servicebindingv1.ServiceBindingSpec{
+					Service: servicebindingv1.ServiceBindingServiceReference{
+						APIVersion: "other.local/v2",
+						Kind:       "OtherService",
+						Name:       "my-service",
+					},
+				},
+			},
+			expected: "cluster-secret",
+		},
+		{
+			name: "direct secret falls through",
+			serviceBinding: &servicebindingv1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-namespace",
+				},
+				Spec: servicebindingv1.ServiceBindingSpec{
+					Service: servicebindingv1.ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-secret",
+					},
+				},
+			},
+			expected: "my-secret",
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			client := fakeclient.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(c.givenObjects...).
+				Build()
+			resolver := resolver.NewChain(resolver.New(client), strategy)
+
+			actual, err := resolver.LookupBindingSecret(ctx, c.serviceBinding)
+
+			if (err != nil) != c.expectedErr {
+				t.Errorf("LookupBindingSecret() expected err: %v", err)
+			}
+			if c.expectedErr {
+				return
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("LookupBindingSecret() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestChainResolver_LookupWorkloads(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(appsv1.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1.AddToScheme(scheme))
+
+	strategyWorkload := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "workload.local/v1",
+			"kind":       "MyWorkload",
+			"metadata": map[string]interface{}{
+				"namespace": "my-namespace",
+				"name":      "my-workload",
+			},
+		},
+	}
+	strategy := &staticStrategy{
+		workloadGVKs: []schema.GroupVersionKind{
+			{Group: "workload.local", Kind: "MyWorkload"},
+		},
+		workloads: []runtime.Object{strategyWorkload},
+	}
+	first := &staticStrategy{
+		workloadGVKs: []schema.GroupVersionKind{
+			{Group: "workload.local", Kind: "MyWorkload"},
+		},
+		workloads: []runtime.Object{},
+	}
+
+	tests := []struct {
+		name           string
+		strategies     []resolver.Strategy
+		givenObjects   []client.Object
+		serviceBinding *servicebindingv1.ServiceBinding
+		expected       []string
+	}{
+		{
+			name:       "handled by strategy",
+			strategies: []resolver.Strategy{strategy},
+			serviceBinding: &servicebindingv1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-namespace",
+				},
+				Spec: servicebindingv1.ServiceBindingSpec{
+					Workload: servicebindingv1.ServiceBindingWorkloadReference{
+						APIVersion: "workload.local/v1",
+						Kind:       "MyWorkload",
+						Name:       "my-workload",
+					},
+				},
+			},
+			expected: []string{"my-workload"},
+		},
+		{
+			name:       "first matching strategy wins",
+			strategies: []resolver.Strategy{first, strategy},
+			serviceBinding: &servicebindingv1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-namespace",
+				},
+				Spec: servicebindingv1.ServiceBindingSpec{
+					Workload: servicebindingv1.ServiceBindingWorkloadReference{
+						APIVersion: "workload.local/v1",
+						Kind:       "MyWorkload",
+						Name:       "my-workload",
+					},
+				},
+			},
+			expected: []string{},
+		},
+		{
+			name:       "unhandled kind falls through",
+			strategies: []resolver.Strategy{strategy},
+			givenObjects: []client.Object{
+				&appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "my-namespace",
+						Name:      "my-deployment",
+					},
+				},
+			},
+			serviceBinding: &servicebindingv1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-namespace",
+				},
+				Spec: servicebindingv1.ServiceBindingSpec{
+					Workload: servicebindingv1.ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       "my-deployment",
+					},
+				},
+			},
+			expected: []string{"my-deployment"},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			client := fakeclient.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(c.givenObjects...).
+				Build()
+			resolver := resolver.NewChain(resolver.New(client), c.strategies...)
+
+			workloads, err := resolver.LookupWorkloads(ctx, c.serviceBinding)
+			if err != nil {
+				t.Errorf("LookupWorkloads() unexpected err: %v", err)
+				return
+			}
+			actual := []string{}
+			for _, workload := range workloads {
+				actual = append(actual, workload.(metav1.Object).GetName())
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("LookupWorkloads() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
@@ -60,7 +60,7 @@ func (m *clusterResolver) LookupRESTMapping(ctx context.Context, obj runtime.Obj
 func (m *clusterResolver) LookupWorkloadMapping(ctx context.Context, gvr schema.GroupVersionResource) (*servicebindingv1.ClusterWorkloadResourceMappingSpec, error) {
 	wrm := &servicebindingv1.ClusterWorkloadResourceMapping{}
 
-	if err := m.client.Get(ctx, types.NamespacedName{Name: fmt.Sprintf("%s.%s", gvr.Resource, gvr.Group)}, wrm); err != nil {
+	if err := m.client.Get(ctx, types.NamespacedName{Name: mappingName(gvr)}, wrm); err != nil {
 		if !apierrs.IsNotFound(err) {
 			return nil, err
 		}
@@ -137,9 +137,17 @@ func (r *clusterResolver) LookupWorkloads(ctx context.Context, serviceBinding *s
 			}
 			continue
 		}
-		if ls.Matches(labels.Set(workload.GetLabels())) {
-			workloads = append(workloads, workload)
+		if ls != nil && !ls.Matches(labels.Set(workload.GetLabels())) {
+			continue
+		}
+		if workloadRef.Owner != nil && !workloadRef.Owner.Controls(workload) {
+			continue
+		}
+		if ls == nil && workloadRef.Owner == nil {
+			// nothing to select on
+			continue
 		}
+		workloads = append(workloads, workload)
 	}
 
 	return workloads, nil
@@ -821,6 +821,270 @@ func TestClusterResolver_LookupWorkloads(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "list workloads controlled by owner",
+			givenObjects: []client.Object{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "workload.local/v1",
+						"kind":       "MyWorkload",
+						"metadata": map[string]interface{}{
+							"name":      "my-workload-1",
+							"namespace": "my-namespace",
+							"labels": map[string]interface{}{
+								"app": "my",
+							},
+							"ownerReferences": []interface{}{
+								map[string]interface{}{
+									"apiVersion": "app.local/v1",
+									"kind":       "MyApp",
+									"name":       "my-app",
+									"uid":        "my-app-uid",
+									"controller": true,
+								},
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "workload.local/v1",
+						"kind":       "MyWorkload",
+						"metadata": map[string]interface{}{
+							"name":      "my-workload-2",
+							"namespace": "my-namespace",
+							"labels": map[string]interface{}{
+								"app": "not",
+							},
+							"ownerReferences": []interface{}{
+								map[string]interface{}{
+									"apiVersion": "app.local/v2",
+									"kind":       "MyApp",
+									"name":       "my-app",
+									"uid":        "my-app-uid",
+									"controller": true,
+								},
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "workload.local/v1",
+						"kind":       "MyWorkload",
+						"metadata": map[string]interface{}{
+							"name":      "not-controlled",
+							"namespace": "my-namespace",
+							"labels": map[string]interface{}{
+								"app": "my",
+							},
+							"ownerReferences": []interface{}{
+								map[string]interface{}{
+									"apiVersion": "app.local/v1",
+									"kind":       "MyApp",
+									"name":       "my-app",
+									"uid":        "my-app-uid",
+									"controller": false,
+								},
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "workload.local/v1",
+						"kind":       "MyWorkload",
+						"metadata": map[string]interface{}{
+							"name":      "not-my-workload",
+							"namespace": "my-namespace",
+							"labels": map[string]interface{}{
+								"app": "my",
+							},
+							"ownerReferences": []interface{}{
+								map[string]interface{}{
+									"apiVersion": "app.local/v1",
+									"kind":       "MyApp",
+									"name":       "other-app",
+									"uid":        "other-app-uid",
+									"controller": true,
+								},
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "workload.local/v1",
+						"kind":       "MyWorkload",
+						"metadata": map[string]interface{}{
+							"name":      "no-owner",
+							"namespace": "my-namespace",
+							"labels": map[string]interface{}{
+								"app": "my",
+							},
+						},
+					},
+				},
+			},
+			serviceBinding: &servicebindingv1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-namespace",
+					UID:       bindingUID,
+				},
+				Spec: servicebindingv1.ServiceBindingSpec{
+					Workload: servicebindingv1.ServiceBindingWorkloadReference{
+						APIVersion: "workload.local/v1",
+						Kind:       "MyWorkload",
+						Owner: &servicebindingv1.ServiceBindingWorkloadOwnerReference{
+							APIVersion: "app.local/v1",
+							Kind:       "MyApp",
+							Name:       "my-app",
+						},
+					},
+				},
+			},
+			expected: []runtime.Object{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "workload.local/v1",
+						"kind":       "MyWorkload",
+						"metadata": map[string]interface{}{
+							"name":      "my-workload-1",
+							"namespace": "my-namespace",
+							"labels": map[string]interface{}{
+								"app": "my",
+							},
+							"ownerReferences": []interface{}{
+								map[string]interface{}{
+									"apiVersion": "app.local/v1",
+									"kind":       "MyApp",
+									"name":       "my-app",
+									"uid":        "my-app-uid",
+									"controller": true,
+								},
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "workload.local/v1",
+						"kind":       "MyWorkload",
+						"metadata": map[string]interface{}{
+							"name":      "my-workload-2",
+							"namespace": "my-namespace",
+							"labels": map[string]interface{}{
+								"app": "not",
+							},
+							"ownerReferences": []interface{}{
+								map[string]interface{}{
+									"apiVersion": "app.local/v2",
+									"kind":       "MyApp",
+									"name":       "my-app",
+									"uid":        "my-app-uid",
+									"controller": true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "list workloads controlled by owner matching selector",
+			givenObjects: []client.Object{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "workload.local/v1",
+						"kind":       "MyWorkload",
+						"metadata": map[string]interface{}{
+							"name":      "my-workload-1",
+							"namespace": "my-namespace",
+							"labels": map[string]interface{}{
+								"app": "my",
+							},
+							"ownerReferences": []interface{}{
+								map[string]interface{}{
+									"apiVersion": "app.local/v1",
+									"kind":       "MyApp",
+									"name":       "my-app",
+									"uid":        "my-app-uid",
+									"controller": true,
+								},
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "workload.local/v1",
+						"kind":       "MyWorkload",
+						"metadata": map[string]interface{}{
+							"name":      "my-workload-2",
+							"namespace": "my-namespace",
+							"labels": map[string]interface{}{
+								"app": "not",
+							},
+							"ownerReferences": []interface{}{
+								map[string]interface{}{
+									"apiVersion": "app.local/v1",
+									"kind":       "MyApp",
+									"name":       "my-app",
+									"uid":        "my-app-uid",
+									"controller": true,
+								},
+							},
+						},
+					},
+				},
+			},
+			serviceBinding: &servicebindingv1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-namespace",
+					UID:       bindingUID,
+				},
+				Spec: servicebindingv1.ServiceBindingSpec{
+					Workload: servicebindingv1.ServiceBindingWorkloadReference{
+						APIVersion: "workload.local/v1",
+						Kind:       "MyWorkload",
+						Selector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{
+								"app": "my",
+							},
+						},
+						Owner: &servicebindingv1.ServiceBindingWorkloadOwnerReference{
+							APIVersion: "app.local/v1",
+							Kind:       "MyApp",
+							Name:       "my-app",
+						},
+					},
+				},
+			},
+			expected: []runtime.Object{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "workload.local/v1",
+						"kind":       "MyWorkload",
+						"metadata": map[string]interface{}{
+							"name":      "my-workload-1",
+							"namespace": "my-namespace",
+							"labels": map[string]interface{}{
+								"app": "my",
+							},
+							"ownerReferences": []interface{}{
+								map[string]interface{}{
+									"apiVersion": "app.local/v1",
+									"kind":       "MyApp",
+									"name":       "my-app",
+									"uid":        "my-app-uid",
+									"controller": true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, c := range tests {
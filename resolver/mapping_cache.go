@@ -0,0 +1,170 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resolver
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	toolscache "k8s.io/client-go/tools/cache"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	servicebindingv1 "github.com/servicebinding/runtime/apis/v1"
+)
+
+var (
+	mappingCacheHits = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "servicebinding_workload_mapping_cache_hits_total",
+		Help: "Number of workload mapping lookups served from the cache",
+	})
+	mappingCacheMisses = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "servicebinding_workload_mapping_cache_misses_total",
+		Help: "Number of workload mapping lookups resolved from the cluster",
+	})
+)
+
+func init() {
+	metrics.Registry.MustRegister(mappingCacheHits, mappingCacheMisses)
+}
+
+// MappingCache holds defaulted ClusterWorkloadResourceMappingSpecs keyed by the workload's GroupVersionResource. Entries
+// are invalidated when the ClusterWorkloadResourceMapping for the resource is created, updated or deleted. A single
+// cache is shared by every resolver wrapped with it.
+type MappingCache struct {
+	m          sync.RWMutex
+	generation uint64
+	entries    map[schema.GroupVersionResource]*servicebindingv1.ClusterWorkloadResourceMappingSpec
+}
+
+// NewMappingCache creates an empty mapping cache. SetupWithManager must be called for the cache to observe changes to
+// ClusterWorkloadResourceMappings.
+func NewMappingCache() *MappingCache {
+	return &MappingCache{
+		entries: map[schema.GroupVersionResource]*servicebindingv1.ClusterWorkloadResourceMappingSpec{},
+	}
+}
+
+// SetupWithManager registers an informer event handler that invalidates cached entries for changed
+// ClusterWorkloadResourceMappings.
+func (c *MappingCache) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	informer, err := mgr.GetCache().GetInformer(ctx, &servicebindingv1.ClusterWorkloadResourceMapping{})
+	if err != nil {
+		return err
+	}
+	_, err = informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			c.invalidateObject(obj)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			c.invalidateObject(newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			c.invalidateObject(obj)
+		},
+	})
+	return err
+}
+
+// Resolver wraps the resolver so that workload mapping lookups are served from the cache. All other lookups are
+// delegated to the wrapped resolver.
+func (c *MappingCache) Resolver(r Resolver) Resolver {
+	return &cachingResolver{
+		Resolver: r,
+		cache:    c,
+	}
+}
+
+// Invalidate drops the cached entries for the ClusterWorkloadResourceMapping with the given name, in the form
+// `{resource}.{group}`.
+func (c *MappingCache) Invalidate(name string) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	c.generation++
+	for gvr := range c.entries {
+		if mappingName(gvr) == name {
+			delete(c.entries, gvr)
+		}
+	}
+}
+
+func (c *MappingCache) invalidateObject(obj interface{}) {
+	if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	if accessor, err := meta.Accessor(obj); err == nil {
+		c.Invalidate(accessor.GetName())
+		return
+	}
+	// the object is not one we recognize, drop everything to be safe
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.generation++
+	c.entries = map[schema.GroupVersionResource]*servicebindingv1.ClusterWorkloadResourceMappingSpec{}
+}
+
+func (c *MappingCache) get(gvr schema.GroupVersionResource) (*servicebindingv1.ClusterWorkloadResourceMappingSpec, uint64, bool) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+
+	mapping, ok := c.entries[gvr]
+	if !ok {
+		return nil, c.generation, false
+	}
+	return mapping.DeepCopy(), c.generation, true
+}
+
+func (c *MappingCache) set(gvr schema.GroupVersionResource, generation uint64, mapping *servicebindingv1.ClusterWorkloadResourceMappingSpec) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if c.generation != generation {
+		// the cache was invalidated while the mapping was resolved, the value may be stale
+		return
+	}
+	c.entries[gvr] = mapping.DeepCopy()
+}
+
+func mappingName(gvr schema.GroupVersionResource) string {
+	return fmt.Sprintf("%s.%s", gvr.Resource, gvr.Group)
+}
+
+type cachingResolver struct {
+	Resolver
+	cache *MappingCache
+}
+
+func (r *cachingResolver) LookupWorkloadMapping(ctx context.Context, gvr schema.GroupVersionResource) (*servicebindingv1.ClusterWorkloadResourceMappingSpec, error) {
+	mapping, generation, ok := r.cache.get(gvr)
+	if ok {
+		mappingCacheHits.Inc()
+		return mapping, nil
+	}
+	mappingCacheMisses.Inc()
+
+	mapping, err := r.Resolver.LookupWorkloadMapping(ctx, gvr)
+	if err != nil {
+		return nil, err
+	}
+	r.cache.set(gvr, generation, mapping)
+	return mapping, nil
+}
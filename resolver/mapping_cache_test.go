@@ -0,0 +1,141 @@
+/*
+Copyright 2026 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resolver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	servicebindingv1 "github.com/servicebinding/runtime/apis/v1"
+	"github.com/servicebinding/runtime/resolver"
+)
+
+func TestMappingCache(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(servicebindingv1.AddToScheme(scheme))
+
+	deployments := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	cronJobs := schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "cronjobs"}
+
+	mapping := &servicebindingv1.ClusterWorkloadResourceMapping{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "deployments.apps",
+		},
+		Spec: servicebindingv1.ClusterWorkloadResourceMappingSpec{
+			Versions: []servicebindingv1.ClusterWorkloadResourceMappingTemplate{
+				{
+					Version:     "*",
+					Annotations: ".spec.template.metadata.annotations",
+					Containers: []servicebindingv1.ClusterWorkloadResourceMappingContainer{
+						{
+							Path: ".spec.containers[*]",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	ctx := context.TODO()
+	gets := 0
+	c := fakeclient.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mapping).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				gets++
+				return c.Get(ctx, key, obj, opts...)
+			},
+		}).
+		Build()
+	cache := resolver.NewMappingCache()
+	r := cache.Resolver(resolver.New(c))
+
+	expected, err := resolver.New(c).LookupWorkloadMapping(ctx, deployments)
+	if err != nil {
+		t.Fatalf("LookupWorkloadMapping() unexpected err: %v", err)
+	}
+	gets = 0
+
+	// miss
+	actual, err := r.LookupWorkloadMapping(ctx, deployments)
+	if err != nil {
+		t.Fatalf("LookupWorkloadMapping() unexpected err: %v", err)
+	}
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("LookupWorkloadMapping() (-expected, +actual): %s", diff)
+	}
+	if gets != 1 {
+		t.Errorf("expected 1 get on miss, got %d", gets)
+	}
+
+	// hit, mutating the returned value must not affect the cache
+	actual.Versions[0].Annotations = ".mutated"
+	actual, err = r.LookupWorkloadMapping(ctx, deployments)
+	if err != nil {
+		t.Fatalf("LookupWorkloadMapping() unexpected err: %v", err)
+	}
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("LookupWorkloadMapping() (-expected, +actual): %s", diff)
+	}
+	if gets != 1 {
+		t.Errorf("expected no get on hit, got %d", gets-1)
+	}
+
+	// a different resource is cached independently
+	if _, err := r.LookupWorkloadMapping(ctx, cronJobs); err != nil {
+		t.Fatalf("LookupWorkloadMapping() unexpected err: %v", err)
+	}
+	if gets != 2 {
+		t.Errorf("expected 2 gets, got %d", gets)
+	}
+
+	// invalidating an unrelated mapping keeps the entry
+	cache.Invalidate("cronjobs.batch")
+	if _, err := r.LookupWorkloadMapping(ctx, deployments); err != nil {
+		t.Fatalf("LookupWorkloadMapping() unexpected err: %v", err)
+	}
+	if gets != 2 {
+		t.Errorf("expected 2 gets, got %d", gets)
+	}
+
+	// invalidating the mapping drops the entry
+	cache.Invalidate("deployments.apps")
+	if _, err := r.LookupWorkloadMapping(ctx, deployments); err != nil {
+		t.Fatalf("LookupWorkloadMapping() unexpected err: %v", err)
+	}
+	if gets != 3 {
+		t.Errorf("expected 3 gets, got %d", gets)
+	}
+
+	// the cache is shared by every wrapped resolver
+	if _, err := cache.Resolver(resolver.New(c)).LookupWorkloadMapping(ctx, deployments); err != nil {
+		t.Fatalf("LookupWorkloadMapping() unexpected err: %v", err)
+	}
+	if gets != 3 {
+		t.Errorf("expected 3 gets, got %d", gets)
+	}
+}